@@ -14,10 +14,13 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"os/exec"
 	"sort"
 	"strings"
 
@@ -51,6 +54,32 @@ type ImportOptions struct {
 	Filter        []string
 	Plan          bool `json:"-"`
 	Output        string
+	Compress      bool
+	AuditLogFile  string
+	FullSettings  bool
+	SSMDecrypt    bool
+	OnlyTaggable  bool
+	StrictFmt     bool
+	GenerateTests bool
+	// ConfigVarsTfvars writes values of Heroku config vars into tfvars files
+	ConfigVarsTfvars bool
+	// SecretsIncludeValues reads values of AWS Secrets Manager secrets to import their current versions
+	SecretsIncludeValues bool
+	// SkipResourceTypesFile contains resource type patterns to skip, one per line
+	SkipResourceTypesFile string
+	SkipResourceTypes     []string `json:"-"`
+	// ProviderAliases maps region to alias of provider configuration
+	ProviderAliases map[string]string
+	ProviderAlias   string `json:"-"`
+	// GroupBy is resource attribute, resources with same value are written to module of their own
+	GroupBy string
+	// Stream writes resources to stdout as newline-delimited JSON instead of files
+	Stream bool `json:"-"`
+	// WorkspaceBy assigns resources to Terraform Cloud workspaces, "type", "tag:<name>" or resource attribute
+	WorkspaceBy     string
+	TFCOrganization string
+	// TFCReplaceState uploads state to workspaces that already have state, replacing it
+	TFCReplaceState bool
 }
 
 const DefaultPathPattern = "{output}/{provider}/{service}/"
@@ -78,6 +107,15 @@ func newImportCmd() *cobra.Command {
 }
 
 func Import(provider terraformutils.ProviderGenerator, options ImportOptions, args []string) error {
+	if options.AuditLogFile != "" {
+		auditLogFile, err := os.OpenFile(options.AuditLogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		defer auditLogFile.Close()
+		terraformutils.SetAPIAuditLogger(terraformutils.NewAPIAuditLogger(auditLogFile))
+		defer terraformutils.SetAPIAuditLogger(nil)
+	}
 	err := provider.Init(args)
 	if err != nil {
 		return err
@@ -92,7 +130,7 @@ func Import(provider terraformutils.ProviderGenerator, options ImportOptions, ar
 
 	if terraformerstring.ContainsString(options.Resources, "*") {
 		log.Println("Attempting an import of ALL resources in " + provider.GetName())
-		options.Resources = providerServices(provider)
+		options.Resources = wildcardServices(provider)
 	}
 
 	if options.Excludes != nil {
@@ -112,6 +150,21 @@ func Import(provider terraformutils.ProviderGenerator, options ImportOptions, ar
 		options.Resources = localSlice
 	}
 
+	if options.SkipResourceTypesFile != "" {
+		skipFile, err := os.Open(options.SkipResourceTypesFile)
+		if err != nil {
+			return err
+		}
+		patterns, err := terraformutils.LoadSkipResourceTypes(skipFile)
+		skipFile.Close()
+		if err != nil {
+			return err
+		}
+		options.SkipResourceTypes = append(options.SkipResourceTypes, patterns...)
+	}
+	// inline excludes can be resource type patterns too
+	options.SkipResourceTypes = append(options.SkipResourceTypes, options.Excludes...)
+
 	providerWrapper, err := providerwrapper.NewProviderWrapper(provider.GetName(), provider.GetConfig(), options.Verbose)
 	if err != nil {
 		return err
@@ -120,6 +173,12 @@ func Import(provider terraformutils.ProviderGenerator, options ImportOptions, ar
 	defer providerWrapper.Kill()
 
 	for _, service := range options.Resources {
+		if options.Stream {
+			if err := streamServiceResources(service, provider, options, providerWrapper); err != nil {
+				return err
+			}
+			continue
+		}
 		resources, err := buildServiceResources(service, provider, options, providerWrapper)
 		if err != nil {
 			log.Println(err)
@@ -127,6 +186,9 @@ func Import(provider terraformutils.ProviderGenerator, options ImportOptions, ar
 		}
 		plan.ImportedResource[service] = append(plan.ImportedResource[service], resources...)
 	}
+	if options.Stream {
+		return nil
+	}
 	if options.Plan {
 		path := Path(options.PathPattern, provider.GetName(), "terraformer", options.PathOutput)
 		return ExportPlanFile(plan, path, "plan.json")
@@ -134,21 +196,51 @@ func Import(provider terraformutils.ProviderGenerator, options ImportOptions, ar
 	return ImportFromPlan(provider, plan)
 }
 
-func buildServiceResources(service string, provider terraformutils.ProviderGenerator,
-	options ImportOptions, providerWrapper *providerwrapper.ProviderWrapper) ([]terraformutils.Resource, error) {
+// initServiceResources lists resources of service and applies filters which don't need refreshed state
+func initServiceResources(service string, provider terraformutils.ProviderGenerator,
+	options ImportOptions, providerWrapper *providerwrapper.ProviderWrapper) error {
 	log.Println(provider.GetName() + " importing... " + service)
 	err := provider.InitService(service, options.Verbose)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	provider.GetService().ParseFilters(options.Filter)
 	err = provider.GetService().InitResources()
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	if len(options.SkipResourceTypes) > 0 {
+		skipFilter := terraformutils.SkipTypeFilter{Patterns: options.SkipResourceTypes}
+		provider.GetService().SetResources(skipFilter.Filter(provider.GetService().GetResources()))
+	}
+	if options.OnlyTaggable {
+		provider.GetService().SetResources(terraformutils.FilterTaggableResources(provider.GetService().GetResources(), providerWrapper.GetSchema()))
 	}
 
 	provider.GetService().PopulateIgnoreKeys(providerWrapper)
 	provider.GetService().InitialCleanup()
+	return nil
+}
+
+// streamServiceResources writes each resource of service to stdout as soon as it's refreshed
+func streamServiceResources(service string, provider terraformutils.ProviderGenerator,
+	options ImportOptions, providerWrapper *providerwrapper.ProviderWrapper) error {
+	if err := initServiceResources(service, provider, options, providerWrapper); err != nil {
+		log.Println(err)
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resources := terraformutils.RefreshResourcesStream(ctx, provider.GetService().GetResources(), providerWrapper)
+	return provider.StreamResources(ctx, os.Stdout, resources)
+}
+
+func buildServiceResources(service string, provider terraformutils.ProviderGenerator,
+	options ImportOptions, providerWrapper *providerwrapper.ProviderWrapper) ([]terraformutils.Resource, error) {
+	if err := initServiceResources(service, provider, options, providerWrapper); err != nil {
+		return nil, err
+	}
 
 	refreshedResources, err := terraformutils.RefreshResources(provider.GetService().GetResources(), providerWrapper)
 	if err != nil {
@@ -182,6 +274,13 @@ func ImportFromPlan(provider terraformutils.ProviderGenerator, plan *ImportPlan)
 		importedResource = terraformutils.ConnectServices(importedResource, isServicePath, provider.GetResourceConnections())
 	}
 
+	if options.WorkspaceBy != "" {
+		if err := printWorkspaces(provider, options, importedResource); err != nil {
+			return err
+		}
+		warnProviderVersion(provider, importedResource)
+		return nil
+	}
 	if !isServicePath {
 		var compactedResources []terraformutils.Resource
 		for _, resources := range importedResource {
@@ -199,18 +298,127 @@ func ImportFromPlan(provider terraformutils.ProviderGenerator, plan *ImportPlan)
 			}
 		}
 	}
+	warnProviderVersion(provider, importedResource)
 	return nil
 }
 
+// warnProviderVersion logs imported resource types which require newer provider version than installed one
+func warnProviderVersion(provider terraformutils.ProviderGenerator, importedResource map[string][]terraformutils.Resource) {
+	var resources []terraformutils.Resource
+	for _, serviceResources := range importedResource {
+		resources = append(resources, serviceResources...)
+	}
+	checker := terraformutils.NewProviderVersionChecker()
+	warnings := checker.Check(provider.GetName(), providerwrapper.GetProviderVersion(provider.GetName()), resources)
+	for _, warning := range warnings {
+		log.Println("WARNING: " + warning.String())
+	}
+	if len(warnings) > 0 {
+		log.Printf("WARNING: upgrade %s provider, suggested version constraint is \"%s\"", provider.GetName(), checker.SuggestedConstraint(warnings))
+	}
+}
+
+// checkTerraformFmt runs terraform fmt on generated files and warns when they weren't canonically formatted,
+// with strict fmt re-formatted files fail import
+func checkTerraformFmt(path string, isStrict bool) error {
+	files, err := terraformoutput.TerraformFmt(path)
+	if errors.Is(err, exec.ErrNotFound) && !isStrict {
+		log.Println("terraform binary not found, skip terraform fmt")
+		return nil
+	}
+	if err != nil {
+		if isStrict {
+			return err
+		}
+		log.Println(err)
+		return nil
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	log.Println("WARN: generated files weren't canonically formatted, terraform fmt re-formatted " + strings.Join(files, ", "))
+	if isStrict {
+		return fmt.Errorf("generated files weren't canonically formatted: %s", strings.Join(files, ", "))
+	}
+	return nil
+}
+
+func workspaceStrategy(workspaceBy string) func(terraformutils.Resource) string {
+	if workspaceBy == "type" {
+		return terraformutils.WorkspaceByType()
+	}
+	if strings.HasPrefix(workspaceBy, "tag:") {
+		return terraformutils.WorkspaceByTag(strings.TrimPrefix(workspaceBy, "tag:"))
+	}
+	return terraformutils.GroupByAttribute(workspaceBy)
+}
+
+// printWorkspaces assigns resources of all services to workspaces, so state of each workspace is written once
+func printWorkspaces(provider terraformutils.ProviderGenerator, options ImportOptions, importedResource map[string][]terraformutils.Resource) error {
+	serviceNames := make([]string, 0, len(importedResource))
+	for serviceName := range importedResource {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+	var resources []terraformutils.Resource
+	for _, serviceName := range serviceNames {
+		resources = append(resources, importedResource[serviceName]...)
+	}
+	for i := range resources {
+		resources[i].ProviderAlias = options.ProviderAlias
+	}
+	log.Println(provider.GetName() + " save workspaces")
+	assignments := terraformutils.NewWorkspaceSelector(workspaceStrategy(options.WorkspaceBy)).Assign(resources)
+	writer := terraformoutput.WorkspaceWriter{
+		Provider:     provider,
+		Output:       options.Output,
+		Organization: options.TFCOrganization,
+		Hostname:     os.Getenv("TFE_HOSTNAME"),
+		Token:        os.Getenv("TFE_TOKEN"),
+		ReplaceState: options.TFCReplaceState,
+	}
+	return writer.Write(assignments, Path(options.PathPattern, provider.GetName(), "", options.PathOutput))
+}
+
 func printService(provider terraformutils.ProviderGenerator, serviceName string, options ImportOptions, resources []terraformutils.Resource, importedResource map[string][]terraformutils.Resource) error {
 	log.Println(provider.GetName() + " save " + serviceName)
 	// Print HCL files for Resources
 	path := Path(options.PathPattern, provider.GetName(), serviceName, options.PathOutput)
-	err := terraformoutput.OutputHclFiles(resources, provider, path, serviceName, options.Compact, options.Output)
-	if err != nil {
+	for i := range resources {
+		resources[i].ProviderAlias = options.ProviderAlias
+	}
+	groups := map[string][]terraformutils.Resource{}
+	if options.GroupBy != "" {
+		groups = terraformutils.NewResourceGrouper(terraformutils.GroupByAttribute(options.GroupBy)).Group(resources)
+		if err := terraformoutput.OutputModules(groups, provider, path, serviceName, options.Compact, options.Output, options.Compress, options.ProviderAlias); err != nil {
+			return err
+		}
+	} else if err := terraformoutput.OutputHclFiles(resources, provider, path, serviceName, options.Compact, options.Output, options.Compress, options.ProviderAlias); err != nil {
 		return err
 	}
-	tfStateFile, err := terraformutils.PrintTfState(resources)
+	if options.GenerateTests {
+		if options.GroupBy != "" {
+			for name, group := range groups {
+				if err := (terraformoutput.TestGenerator{}).OutputFiles(group, path+"/"+name); err != nil {
+					return err
+				}
+			}
+		} else if err := (terraformoutput.TestGenerator{}).OutputFiles(resources, path); err != nil {
+			return err
+		}
+	}
+	if options.Output == "hcl" && !options.Compress {
+		if err := checkTerraformFmt(path, options.StrictFmt); err != nil {
+			return err
+		}
+	}
+	var tfStateFile []byte
+	var err error
+	if options.GroupBy != "" {
+		tfStateFile, err = terraformutils.PrintModulesTfState(groups)
+	} else {
+		tfStateFile, err = terraformutils.PrintTfState(resources)
+	}
 	if err != nil {
 		return err
 	}
@@ -220,7 +428,7 @@ func printService(provider terraformutils.ProviderGenerator, serviceName string,
 		bucket := terraformoutput.BucketState{
 			Name: options.Bucket,
 		}
-		if err := bucket.BucketUpload(path, tfStateFile); err != nil {
+		if err := bucket.BucketUpload(path, tfStateFile, options.Compress); err != nil {
 			return err
 		}
 		// create Bucket file
@@ -346,6 +554,21 @@ func providerServices(provider terraformutils.ProviderGenerator) []string {
 	return services
 }
 
+// wildcardServices returns services imported with --resources=*, aggregate services are left out
+func wildcardServices(provider terraformutils.ProviderGenerator) []string {
+	p, ok := provider.(terraformutils.AggregateServicesProvider)
+	if !ok {
+		return providerServices(provider)
+	}
+	var services []string
+	for _, service := range providerServices(provider) {
+		if !terraformerstring.ContainsString(p.GetAggregateServices(), service) {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
 func baseProviderFlags(flag *pflag.FlagSet, options *ImportOptions, sampleRes, sampleFilters string) {
 	flag.BoolVarP(&options.Connect, "connect", "c", true, "")
 	flag.BoolVarP(&options.Compact, "compact", "C", false, "")
@@ -358,4 +581,15 @@ func baseProviderFlags(flag *pflag.FlagSet, options *ImportOptions, sampleRes, s
 	flag.StringSliceVarP(&options.Filter, "filter", "f", []string{}, sampleFilters)
 	flag.BoolVarP(&options.Verbose, "verbose", "v", false, "")
 	flag.StringVarP(&options.Output, "output", "O", "hcl", "output format hcl or json")
+	flag.BoolVarP(&options.Compress, "compress", "", false, "gzip resources files")
+	flag.StringVarP(&options.AuditLogFile, "audit-log-file", "", "", "file to write JSON log of all cloud API calls")
+	flag.BoolVarP(&options.OnlyTaggable, "only-taggable", "", false, "skip resource types without tags argument")
+	flag.BoolVarP(&options.StrictFmt, "strict-fmt", "", false, "fail when terraform fmt re-formats generated files")
+	flag.BoolVarP(&options.GenerateTests, "generate-tests", "", false, "generate terraform test files asserting attributes of imported resources")
+	flag.BoolVarP(&options.Stream, "stream", "", false, "write resources to stdout as newline-delimited JSON as soon as they are refreshed instead of files")
+	flag.StringVarP(&options.GroupBy, "group-by", "", "", "attribute to split resources into modules by, e.g. vpc_id or tags.Environment")
+	flag.StringVarP(&options.WorkspaceBy, "workspace-by", "", "", "assign resources to Terraform Cloud workspaces by type, tag:<name> or attribute, token is read from TFE_TOKEN")
+	flag.StringVarP(&options.TFCOrganization, "tfc-organization", "", "", "Terraform Cloud organization of workspaces")
+	flag.BoolVarP(&options.TFCReplaceState, "tfc-replace-state", "", false, "replace state of workspaces that already have state, resources not imported are dropped from it")
+	flag.StringVarP(&options.SkipResourceTypesFile, "skip-resource-types-file", "", "", "file with resource type patterns to skip, one per line, e.g. aws_iam_*")
 }
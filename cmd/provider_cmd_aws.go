@@ -15,6 +15,8 @@ package cmd
 
 import (
 	"log"
+	"strconv"
+	"strings"
 
 	awsterraformer "github.com/GoogleCloudPlatform/terraformer/providers/aws"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
@@ -36,7 +38,7 @@ func newCmdAwsImporter(options ImportOptions) *cobra.Command {
 				globalResources := parseGlobalResources(originalResources)
 				options.Resources = globalResources
 				options.Regions = []string{awsterraformer.GlobalRegion}
-				e := importGlobalResources(options)
+				e := importGlobalResources(options, originalResources)
 				if e != nil {
 					return e
 				}
@@ -48,7 +50,7 @@ func newCmdAwsImporter(options ImportOptions) *cobra.Command {
 						shouldSpecifyPathRegion = true // we should keep global resources away from regional
 					}
 					for _, region := range originalRegions {
-						e := importRegionResources(options, originalPathPattern, region, shouldSpecifyPathRegion)
+						e := importRegionResources(options, originalPathPattern, region, shouldSpecifyPathRegion, originalResources)
 						if e != nil {
 							return e
 						}
@@ -56,7 +58,7 @@ func newCmdAwsImporter(options ImportOptions) *cobra.Command {
 				}
 				return nil
 			}
-			err := importRegionResources(options, options.PathPattern, awsterraformer.NoRegion, false)
+			err := importRegionResources(options, options.PathPattern, awsterraformer.NoRegion, false, originalResources)
 			if err != nil {
 				return err
 			}
@@ -68,6 +70,10 @@ func newCmdAwsImporter(options ImportOptions) *cobra.Command {
 
 	cmd.PersistentFlags().StringVarP(&options.Profile, "profile", "", "default", "prod")
 	cmd.PersistentFlags().StringSliceVarP(&options.Regions, "regions", "", []string{}, "eu-west-1,eu-west-2,us-east-1")
+	cmd.PersistentFlags().StringToStringVarP(&options.ProviderAliases, "provider-alias", "", map[string]string{}, "us-east-1=primary,eu-west-1=eu")
+	cmd.PersistentFlags().BoolVarP(&options.FullSettings, "full-settings", "", false, "import all option settings of Elastic Beanstalk environments, not only the ones differing from defaults")
+	cmd.PersistentFlags().BoolVarP(&options.SSMDecrypt, "ssm-decrypt", "", false, "decrypt values of SecureString SSM parameters into ssm_decrypted.tfvars file")
+	cmd.PersistentFlags().BoolVarP(&options.SecretsIncludeValues, "secrets-include-values", "", false, "import current versions of Secrets Manager secrets, values are replaced by sensitive variables")
 	return cmd
 }
 
@@ -81,9 +87,9 @@ func parseGlobalResources(allResources []string) []string {
 	return globalResources
 }
 
-func importGlobalResources(options ImportOptions) error {
+func importGlobalResources(options ImportOptions, allResources []string) error {
 	if len(options.Resources) > 0 {
-		return importRegionResources(options, options.PathPattern, awsterraformer.GlobalRegion, false)
+		return importRegionResources(options, options.PathPattern, awsterraformer.GlobalRegion, false, allResources)
 	}
 	return nil
 }
@@ -98,9 +104,12 @@ func parseRegionalResources(allResources []string) []string {
 	return localResources
 }
 
-func importRegionResources(options ImportOptions, originalPathPattern string, region string, shouldSpecifyPathRegion bool) error {
+// importRegionResources imports options.Resources of region, allResources are all services of the
+// import across regions, services emitting resources of other services depend on them
+func importRegionResources(options ImportOptions, originalPathPattern string, region string, shouldSpecifyPathRegion bool, allResources []string) error {
 	provider := newAWSProvider()
 	options.PathPattern = originalPathPattern
+	options.ProviderAlias = options.ProviderAliases[region]
 	if region != awsterraformer.GlobalRegion && region != awsterraformer.NoRegion {
 		if shouldSpecifyPathRegion {
 			options.PathPattern += region + "/"
@@ -109,7 +118,7 @@ func importRegionResources(options ImportOptions, originalPathPattern string, re
 	} else {
 		log.Println(provider.GetName() + " importing default region")
 	}
-	err := Import(provider, options, []string{region, options.Profile})
+	err := Import(provider, options, []string{region, options.Profile, strconv.FormatBool(options.FullSettings), strconv.FormatBool(options.SSMDecrypt), strconv.FormatBool(options.SecretsIncludeValues), strings.Join(allResources, ",")})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,54 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	confluent_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/confluent"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/spf13/cobra"
+)
+
+func newCmdConfluentImporter(options ImportOptions) *cobra.Command {
+	cloudAPIKey := ""
+	cloudAPISecret := ""
+	kafkaAPIKey := ""
+	kafkaAPISecret := ""
+	cmd := &cobra.Command{
+		Use:   "confluent",
+		Short: "Import current state to Terraform configuration from Confluent Cloud",
+		Long:  "Import current state to Terraform configuration from Confluent Cloud",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider := newConfluentProvider()
+			err := Import(provider, options, []string{cloudAPIKey, cloudAPISecret, kafkaAPIKey, kafkaAPISecret})
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(listCmd(newConfluentProvider()))
+	baseProviderFlags(cmd.PersistentFlags(), &options, "environment,kafka_cluster", "kafka_cluster=id1:id2")
+	cmd.PersistentFlags().StringVarP(&cloudAPIKey, "cloud-api-key", "", "", "Confluent Cloud API key or env param CONFLUENT_CLOUD_API_KEY")
+	cmd.PersistentFlags().StringVarP(&cloudAPISecret, "cloud-api-secret", "", "", "Confluent Cloud API secret or env param CONFLUENT_CLOUD_API_SECRET")
+	cmd.PersistentFlags().StringVarP(&kafkaAPIKey, "kafka-api-key", "", "", "Kafka API key used to list topics or env param KAFKA_API_KEY")
+	cmd.PersistentFlags().StringVarP(&kafkaAPISecret, "kafka-api-secret", "", "", "Kafka API secret used to list topics or env param KAFKA_API_SECRET")
+	return cmd
+}
+
+func newConfluentProvider() terraformutils.ProviderGenerator {
+	return &confluent_terraforming.ConfluentProvider{}
+}
@@ -0,0 +1,71 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"log"
+
+	consul_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/consul"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/spf13/cobra"
+)
+
+func newCmdConsulImporter(options ImportOptions) *cobra.Command {
+	address := ""
+	token := ""
+	datacenter := ""
+	datacenters := []string{}
+	prefix := ""
+	kvResource := ""
+	cmd := &cobra.Command{
+		Use:   "consul",
+		Short: "Import current state to Terraform configuration from Consul",
+		Long:  "Import current state to Terraform configuration from Consul",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(datacenters) == 0 {
+				datacenters = []string{datacenter}
+			}
+			originalPathPattern := options.PathPattern
+			for _, dc := range datacenters {
+				provider := newConsulProvider()
+				options.PathPattern = originalPathPattern
+				if len(datacenters) > 1 {
+					options.PathPattern += dc + "/"
+					log.Println(provider.GetName() + " importing datacenter " + dc)
+				}
+				err := Import(provider, options, []string{address, token, dc, prefix, kvResource})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(listCmd(newConsulProvider()))
+	baseProviderFlags(cmd.PersistentFlags(), &options, "keys,acl_policy", "acl_policy=id1:id2")
+	cmd.PersistentFlags().StringVarP(&address, "address", "", "", "Consul address or env param CONSUL_HTTP_ADDR")
+	cmd.PersistentFlags().StringVarP(&token, "token", "t", "", "Consul ACL token or env param CONSUL_HTTP_TOKEN")
+	cmd.PersistentFlags().StringVarP(&datacenter, "datacenter", "", "", "Consul datacenter, agent's datacenter by default")
+	cmd.PersistentFlags().StringSliceVarP(&datacenters, "datacenters", "", []string{}, "dc1,dc2, resources of each datacenter are written to directory of their own")
+	cmd.PersistentFlags().StringVarP(&prefix, "consul-prefix", "", "", "KV prefixes to import as consul_keys or consul_key_prefix, comma separated, whole KV store by default")
+	cmd.PersistentFlags().StringVarP(&kvResource, "kv-resource", "", "keys", "keys or key_prefix, service importing KV store, the other one isn't supported")
+	return cmd
+}
+
+func newConsulProvider() terraformutils.ProviderGenerator {
+	return &consul_terraforming.ConsulProvider{}
+}
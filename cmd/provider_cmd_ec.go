@@ -0,0 +1,50 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	ec_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/ec"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/spf13/cobra"
+)
+
+func newCmdECImporter(options ImportOptions) *cobra.Command {
+	endpoint := ""
+	apiKey := ""
+	cmd := &cobra.Command{
+		Use:   "ec",
+		Short: "Import current state to Terraform configuration from Elastic Cloud",
+		Long:  "Import current state to Terraform configuration from Elastic Cloud",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider := newECProvider()
+			err := Import(provider, options, []string{endpoint, apiKey})
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(listCmd(newECProvider()))
+	baseProviderFlags(cmd.PersistentFlags(), &options, "deployment", "deployment=id1:id2")
+	cmd.PersistentFlags().StringVarP(&endpoint, "endpoint", "", "", "Elastic Cloud API endpoint or env param EC_ENDPOINT")
+	cmd.PersistentFlags().StringVarP(&apiKey, "api-key", "", "", "Elastic Cloud API key or env param EC_API_KEY")
+	return cmd
+}
+
+func newECProvider() terraformutils.ProviderGenerator {
+	return &ec_terraforming.ECProvider{}
+}
@@ -14,6 +14,8 @@
 package cmd
 
 import (
+	"strings"
+
 	fastly_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/fastly"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
@@ -21,13 +23,14 @@ import (
 )
 
 func newCmdFastlyImporter(options ImportOptions) *cobra.Command {
+	serviceVersions := []string{}
 	cmd := &cobra.Command{
 		Use:   "fastly",
 		Short: "Import current state to Terraform configuration from Fastly",
 		Long:  "Import current state to Terraform configuration from Fastly",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			provider := newFastlyProvider()
-			err := Import(provider, options, []string{})
+			err := Import(provider, options, []string{strings.Join(serviceVersions, ",")})
 			if err != nil {
 				return err
 			}
@@ -37,6 +40,7 @@ func newCmdFastlyImporter(options ImportOptions) *cobra.Command {
 
 	cmd.AddCommand(listCmd(newFastlyProvider()))
 	baseProviderFlags(cmd.PersistentFlags(), &options, "service_v1", "service_v1=id1:id2:id3")
+	cmd.PersistentFlags().StringSliceVarP(&serviceVersions, "service-version", "", []string{}, "service_id=version pairs, versions of services to import instead of active ones")
 	return cmd
 }
 
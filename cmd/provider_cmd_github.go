@@ -25,6 +25,7 @@ import (
 func newCmdGithubImporter(options ImportOptions) *cobra.Command {
 	token := ""
 	organizations := []string{}
+	users := []string{}
 	cmd := &cobra.Command{
 		Use:   "github",
 		Short: "Import current state to Terraform configuration from GitHub",
@@ -41,6 +42,16 @@ func newCmdGithubImporter(options ImportOptions) *cobra.Command {
 					return err
 				}
 			}
+			for _, user := range users {
+				provider := newGitHubProvider()
+				options.PathPattern = originalPathPattern
+				options.PathPattern = strings.ReplaceAll(options.PathPattern, "{provider}", "{provider}/"+user)
+				log.Println(provider.GetName() + " importing user " + user)
+				err := Import(provider, options, []string{user, token, "user"})
+				if err != nil {
+					return err
+				}
+			}
 			return nil
 		},
 	}
@@ -48,6 +59,7 @@ func newCmdGithubImporter(options ImportOptions) *cobra.Command {
 	baseProviderFlags(cmd.PersistentFlags(), &options, "repository", "repository=id1:id2:id4")
 	cmd.PersistentFlags().StringVarP(&token, "token", "t", "", "YOUR_GITHUB_TOKEN or env param GITHUB_TOKEN")
 	cmd.PersistentFlags().StringSliceVarP(&organizations, "organizations", "", []string{}, "")
+	cmd.PersistentFlags().StringSliceVarP(&users, "users", "", []string{}, "import repositories and SSH keys of users instead of organizations")
 	return cmd
 }
 
@@ -15,6 +15,7 @@ package cmd
 
 import (
 	"log"
+	"strconv"
 	"strings"
 
 	gcp_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/gcp"
@@ -24,6 +25,11 @@ import (
 
 func newCmdGoogleImporter(options ImportOptions) *cobra.Command {
 	providerType := ""
+	includeServiceAgents := false
+	exportIam := false
+	iamGranularity := ""
+	dnsIncludeApexRecords := false
+	networkIncludeAutoCreated := false
 	cmd := &cobra.Command{
 		Use:   "google",
 		Short: "Import current state to Terraform configuration from Google Cloud",
@@ -36,7 +42,7 @@ func newCmdGoogleImporter(options ImportOptions) *cobra.Command {
 					options.PathPattern = originalPathPattern
 					options.PathPattern = strings.ReplaceAll(options.PathPattern, "{provider}/{service}", "{provider}/"+project+"/{service}/"+region)
 					log.Println(provider.GetName() + " importing project " + project + " region " + region)
-					err := Import(provider, options, []string{region, project, providerType})
+					err := Import(provider, options, []string{region, project, providerType, strconv.FormatBool(includeServiceAgents), strconv.FormatBool(exportIam), iamGranularity, strconv.FormatBool(dnsIncludeApexRecords), strconv.FormatBool(networkIncludeAutoCreated)})
 					if err != nil {
 						return err
 					}
@@ -50,6 +56,11 @@ func newCmdGoogleImporter(options ImportOptions) *cobra.Command {
 	cmd.PersistentFlags().StringSliceVarP(&options.Regions, "regions", "z", []string{"global"}, "europe-west1,")
 	cmd.PersistentFlags().StringSliceVarP(&options.Projects, "projects", "", []string{}, "")
 	cmd.PersistentFlags().StringVarP(&providerType, "provider-type", "", "", "beta")
+	cmd.PersistentFlags().BoolVarP(&includeServiceAgents, "include-service-agents", "", false, "Import IAM of Google-managed service agents")
+	cmd.PersistentFlags().BoolVarP(&exportIam, "export-iam", "", false, "Import IAM bindings of Pub/Sub topics")
+	cmd.PersistentFlags().StringVarP(&iamGranularity, "iam-granularity", "", "member", "member (additive), binding (authoritative) or policy")
+	cmd.PersistentFlags().BoolVarP(&dnsIncludeApexRecords, "dns-include-apex-records", "", false, "Import NS and SOA records at Cloud DNS zone apex")
+	cmd.PersistentFlags().BoolVarP(&networkIncludeAutoCreated, "network-include-auto-created", "", false, "Import subnetworks of auto mode networks and default routes")
 	_ = cmd.MarkPersistentFlagRequired("projects")
 	return cmd
 }
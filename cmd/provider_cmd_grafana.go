@@ -0,0 +1,50 @@
+// Copyright 2018 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	grafana_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/grafana"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/spf13/cobra"
+)
+
+func newCmdGrafanaImporter(options ImportOptions) *cobra.Command {
+	url := ""
+	auth := ""
+	orgID := ""
+	cmd := &cobra.Command{
+		Use:   "grafana",
+		Short: "Import current state to Terraform configuration from Grafana",
+		Long:  "Import current state to Terraform configuration from Grafana",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider := newGrafanaProvider()
+			err := Import(provider, options, []string{url, auth, orgID})
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	cmd.AddCommand(listCmd(newGrafanaProvider()))
+	baseProviderFlags(cmd.PersistentFlags(), &options, "dashboards,data_sources", "dashboard=uid1:uid2")
+	cmd.PersistentFlags().StringVarP(&url, "url", "", "", "YOUR_GRAFANA_URL or env param GRAFANA_URL")
+	cmd.PersistentFlags().StringVarP(&auth, "auth", "", "", "API key or user:password, or env param GRAFANA_AUTH")
+	cmd.PersistentFlags().StringVarP(&orgID, "org-id", "", "", "id of organization to import from, or env param GRAFANA_ORG_ID")
+	return cmd
+}
+
+func newGrafanaProvider() terraformutils.ProviderGenerator {
+	return &grafana_terraforming.GrafanaProvider{}
+}
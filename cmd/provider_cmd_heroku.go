@@ -14,6 +14,8 @@
 package cmd
 
 import (
+	"strconv"
+
 	heroku_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/heroku"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
@@ -27,7 +29,7 @@ func newCmdHerokuImporter(options ImportOptions) *cobra.Command {
 		Long:  "Import current state to Terraform configuration from Heroku",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			provider := newHerokuProvider()
-			err := Import(provider, options, []string{})
+			err := Import(provider, options, []string{strconv.FormatBool(options.ConfigVarsTfvars)})
 			if err != nil {
 				return err
 			}
@@ -37,6 +39,7 @@ func newCmdHerokuImporter(options ImportOptions) *cobra.Command {
 
 	cmd.AddCommand(listCmd(newHerokuProvider()))
 	baseProviderFlags(cmd.PersistentFlags(), &options, "app,addon", "app=name1:name2:name3")
+	cmd.PersistentFlags().BoolVarP(&options.ConfigVarsTfvars, "config-vars-tfvars", "", false, "write values of app config vars into <app>_config_vars.auto.tfvars files")
 	return cmd
 }
 
@@ -22,13 +22,14 @@ import (
 )
 
 func newCmdKubernetesImporter(options ImportOptions) *cobra.Command {
+	var context, namespace, labelSelector string
 	cmd := &cobra.Command{
 		Use:   "kubernetes",
 		Short: "Import current state to Terraform configuration from Kubernetes",
 		Long:  "Import current state to Terraform configuration from Kubernetes",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			provider := newKubernetesProvider()
-			err := Import(provider, options, []string{strconv.FormatBool(options.Verbose)})
+			err := Import(provider, options, []string{strconv.FormatBool(options.Verbose), context, namespace, labelSelector})
 			if err != nil {
 				return err
 			}
@@ -38,6 +39,9 @@ func newCmdKubernetesImporter(options ImportOptions) *cobra.Command {
 
 	cmd.AddCommand(listCmd(newKubernetesProvider()))
 	baseProviderFlags(cmd.PersistentFlags(), &options, "configmaps,deployments,services", "deployment=name1:name2:name3")
+	cmd.PersistentFlags().StringVarP(&context, "context", "", "", "kubeconfig context, current context by default")
+	cmd.PersistentFlags().StringVarP(&namespace, "namespace", "", "", "namespace to import, all namespaces by default")
+	cmd.PersistentFlags().StringVarP(&labelSelector, "label-selector", "", "", "app=frontend,tier!=cache")
 	return cmd
 }
 
@@ -14,6 +14,8 @@
 package cmd
 
 import (
+	"strconv"
+
 	newrelic_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/newrelic"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
@@ -21,13 +23,15 @@ import (
 )
 
 func newCmdNewRelicImporter(options ImportOptions) *cobra.Command {
+	accountID := ""
+	modernizeConditions := false
 	cmd := &cobra.Command{
 		Use:   "newrelic",
 		Short: "Import current state to Terraform configuration from New Relic",
 		Long:  "Import current state to Terraform configuration from New Relic",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			provider := newNewRelicProvider()
-			err := Import(provider, options, []string{})
+			err := Import(provider, options, []string{accountID, strconv.FormatBool(modernizeConditions)})
 			if err != nil {
 				return err
 			}
@@ -37,6 +41,8 @@ func newCmdNewRelicImporter(options ImportOptions) *cobra.Command {
 
 	cmd.AddCommand(listCmd(newNewRelicProvider()))
 	baseProviderFlags(cmd.PersistentFlags(), &options, "alert", "dashboard=id1:id2:id4")
+	cmd.PersistentFlags().StringVarP(&accountID, "account-id", "", "", "YOUR_ACCOUNT_ID or env param NEW_RELIC_ACCOUNT_ID")
+	cmd.PersistentFlags().BoolVarP(&modernizeConditions, "modernize-conditions", "", false, "convert deprecated blocks of NRQL alert conditions to their replacements")
 	return cmd
 }
 
@@ -0,0 +1,52 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	okta_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/okta"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/spf13/cobra"
+)
+
+func newCmdOktaImporter(options ImportOptions) *cobra.Command {
+	orgName := ""
+	baseURL := ""
+	apiToken := ""
+	cmd := &cobra.Command{
+		Use:   "okta",
+		Short: "Import current state to Terraform configuration from Okta",
+		Long:  "Import current state to Terraform configuration from Okta",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider := newOktaProvider()
+			err := Import(provider, options, []string{orgName, baseURL, apiToken})
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(listCmd(newOktaProvider()))
+	baseProviderFlags(cmd.PersistentFlags(), &options, "group,group_rule", "group=id1:id2")
+	cmd.PersistentFlags().StringVarP(&orgName, "org-name", "", "", "Okta organization name or env param OKTA_ORG_NAME")
+	cmd.PersistentFlags().StringVarP(&baseURL, "base-url", "", "", "Okta domain, okta.com by default or env param OKTA_BASE_URL")
+	cmd.PersistentFlags().StringVarP(&apiToken, "api-token", "t", "", "Okta API token or env param OKTA_API_TOKEN")
+	return cmd
+}
+
+func newOktaProvider() terraformutils.ProviderGenerator {
+	return &okta_terraforming.OktaProvider{}
+}
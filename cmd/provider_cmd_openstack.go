@@ -28,14 +28,24 @@ func newCmdOpenStackImporter(options ImportOptions) *cobra.Command {
 		Long:  "Import current state to Terraform configuration from OpenStack",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			originalPathPattern := options.PathPattern
-			for _, region := range options.Regions {
-				provider := newOpenStackProvider()
-				options.PathPattern = originalPathPattern
-				options.PathPattern += region + "/"
-				log.Println(provider.GetName() + " importing region " + region)
-				err := Import(provider, options, []string{region})
-				if err != nil {
-					return err
+			projects := options.Projects
+			if len(projects) == 0 {
+				// project of credentials
+				projects = []string{""}
+			}
+			for _, project := range projects {
+				for _, region := range options.Regions {
+					provider := newOpenStackProvider()
+					options.PathPattern = originalPathPattern
+					if project != "" {
+						options.PathPattern += project + "/"
+					}
+					options.PathPattern += region + "/"
+					log.Println(provider.GetName() + " importing project " + project + " region " + region)
+					err := Import(provider, options, []string{region, project})
+					if err != nil {
+						return err
+					}
 				}
 			}
 			return nil
@@ -44,6 +54,7 @@ func newCmdOpenStackImporter(options ImportOptions) *cobra.Command {
 	cmd.AddCommand(listCmd(newOpenStackProvider()))
 	baseProviderFlags(cmd.PersistentFlags(), &options, "compute,networking", "compute_instance_v2=id1:id2:id4")
 	cmd.PersistentFlags().StringSliceVarP(&options.Regions, "regions", "", []string{}, "RegionOne")
+	cmd.PersistentFlags().StringSliceVarP(&options.Projects, "projects", "", []string{}, "project IDs, default is project of credentials")
 	return cmd
 }
 
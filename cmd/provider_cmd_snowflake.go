@@ -0,0 +1,54 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	snowflake_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/snowflake"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/spf13/cobra"
+)
+
+func newCmdSnowflakeImporter(options ImportOptions) *cobra.Command {
+	account := ""
+	region := ""
+	user := ""
+	role := ""
+	cmd := &cobra.Command{
+		Use:   "snowflake",
+		Short: "Import current state to Terraform configuration from Snowflake",
+		Long:  "Import current state to Terraform configuration from Snowflake",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider := newSnowflakeProvider()
+			err := Import(provider, options, []string{account, region, user, role})
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(listCmd(newSnowflakeProvider()))
+	baseProviderFlags(cmd.PersistentFlags(), &options, "database,warehouse", "warehouse=name1:name2")
+	cmd.PersistentFlags().StringVarP(&account, "account", "", "", "Snowflake account name or env param SNOWFLAKE_ACCOUNT")
+	cmd.PersistentFlags().StringVarP(&region, "region", "", "", "Snowflake account region or env param SNOWFLAKE_REGION")
+	cmd.PersistentFlags().StringVarP(&user, "user", "", "", "Snowflake user name or env param SNOWFLAKE_USER")
+	cmd.PersistentFlags().StringVarP(&role, "role", "", "", "Snowflake role used for import or env param SNOWFLAKE_ROLE")
+	return cmd
+}
+
+func newSnowflakeProvider() terraformutils.ProviderGenerator {
+	return &snowflake_terraforming.SnowflakeProvider{}
+}
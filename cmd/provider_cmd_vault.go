@@ -0,0 +1,56 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	vault_terraforming "github.com/GoogleCloudPlatform/terraformer/providers/vault"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/spf13/cobra"
+)
+
+func newCmdVaultImporter(options ImportOptions) *cobra.Command {
+	address := ""
+	token := ""
+	roleID := ""
+	secretID := ""
+	namespace := ""
+	cmd := &cobra.Command{
+		Use:   "vault",
+		Short: "Import current state to Terraform configuration from Vault",
+		Long:  "Import current state to Terraform configuration from Vault",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider := newVaultProvider()
+			err := Import(provider, options, []string{address, token, roleID, secretID, namespace})
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(listCmd(newVaultProvider()))
+	baseProviderFlags(cmd.PersistentFlags(), &options, "policy,auth_backend", "policy=name1:name2")
+	cmd.PersistentFlags().StringVarP(&address, "address", "", "", "Vault address or env param VAULT_ADDR")
+	cmd.PersistentFlags().StringVarP(&token, "token", "t", "", "Vault token or env param VAULT_TOKEN")
+	cmd.PersistentFlags().StringVarP(&roleID, "role-id", "", "", "AppRole role id or env param VAULT_ROLE_ID, used without token")
+	cmd.PersistentFlags().StringVarP(&secretID, "secret-id", "", "", "AppRole secret id or env param VAULT_SECRET_ID")
+	cmd.PersistentFlags().StringVarP(&namespace, "namespace", "", "", "Vault Enterprise namespace or env param VAULT_NAMESPACE")
+	return cmd
+}
+
+func newVaultProvider() terraformutils.ProviderGenerator {
+	return &vault_terraforming.VaultProvider{}
+}
@@ -45,31 +45,41 @@ func providerImporterSubcommands() []func(options ImportOptions) *cobra.Command
 		newCmdAliCloudImporter,
 		newCmdIbmImporter,
 		// Cloud
+		newCmdConfluentImporter,
 		newCmdDigitalOceanImporter,
+		newCmdECImporter,
 		newCmdFastlyImporter,
 		newCmdHerokuImporter,
 		newCmdLinodeImporter,
+		newCmdMongoDBAtlasImporter,
 		newCmdNs1Importer,
 		newCmdOpenStackImporter,
+		newCmdSnowflakeImporter,
 		newCmdVultrImporter,
 		newCmdYandexImporter,
 		// Infrastructure Software
+		newCmdConsulImporter,
 		newCmdKubernetesImporter,
 		newCmdOctopusDeployImporter,
 		newCmdRabbitMQImporter,
+		newCmdVaultImporter,
 		// Network
 		newCmdCloudflareImporter,
 		// VCS
 		newCmdGithubImporter,
+		newCmdGitLabImporter,
 		// Monitoring & System Management
 		newCmdDatadogImporter,
+		newCmdGrafanaImporter,
 		newCmdNewRelicImporter,
+		newCmdPagerDutyImporter,
 		// Community
 		newCmdKeycloakImporter,
 		newCmdLogzioImporter,
 		newCmdCommercetoolsImporter,
 		newCmdMikrotikImporter,
 		newCmdGmailfilterImporter,
+		newCmdOktaImporter,
 	}
 }
 
@@ -83,30 +93,40 @@ func providerGenerators() map[string]func() terraformutils.ProviderGenerator {
 		newAliCloudProvider,
 		newIbmProvider,
 		// Cloud
+		newConfluentProvider,
 		newDigitalOceanProvider,
+		newECProvider,
 		newFastlyProvider,
 		newHerokuProvider,
 		newLinodeProvider,
+		newMongoDBAtlasProvider,
 		newNs1Provider,
 		newOpenStackProvider,
+		newSnowflakeProvider,
 		newVultrProvider,
 		// Infrastructure Software
+		newConsulProvider,
 		newKubernetesProvider,
 		newOctopusDeployProvider,
 		newRabbitMQProvider,
+		newVaultProvider,
 		// Network
 		newCloudflareProvider,
 		// VCS
 		newGitHubProvider,
+		newGitLabProvider,
 		// Monitoring & System Management
 		newDataDogProvider,
+		newGrafanaProvider,
 		newNewRelicProvider,
+		newPagerDutyProvider,
 		// Community
 		newKeycloakProvider,
 		newLogzioProvider,
 		newCommercetoolsProvider,
 		newMikrotikProvider,
 		newGmailfilterProvider,
+		newOktaProvider,
 	} {
 		list[providerGen().GetName()] = providerGen
 	}
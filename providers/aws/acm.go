@@ -16,13 +16,16 @@ package aws
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 )
 
 var acmAllowEmptyValues = []string{}
@@ -33,13 +36,26 @@ type ACMGenerator struct {
 	AWSService
 }
 
-func (g *ACMGenerator) createCertificatesResources(svc *acm.Client) []terraformutils.Resource {
+func (g *ACMGenerator) createCertificatesResources(svc *acm.Client) ([]terraformutils.Resource, []acm.ResourceRecord) {
 	resources := []terraformutils.Resource{}
+	var validationRecords []acm.ResourceRecord
 	p := acm.NewListCertificatesPaginator(svc.ListCertificatesRequest(&acm.ListCertificatesInput{}))
 	for p.Next(context.Background()) {
 		for _, cert := range p.CurrentPage().CertificateSummaryList {
 			certArn := aws.StringValue(cert.CertificateArn)
 			certID := extractCertificateUUID(certArn)
+			output, err := svc.DescribeCertificateRequest(&acm.DescribeCertificateInput{
+				CertificateArn: cert.CertificateArn,
+			}).Send(context.Background())
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			detail := output.Certificate
+			if detail.Status == acm.CertificateStatusExpired || (detail.NotAfter != nil && detail.NotAfter.Before(time.Now())) {
+				log.Printf("WARNING: aws: certificate %s for %s is expired, skipping", certArn, aws.StringValue(cert.DomainName))
+				continue
+			}
 			resources = append(resources, terraformutils.NewResource(
 				certArn,
 				certID+"_"+strings.TrimSuffix(aws.StringValue(cert.DomainName), "."),
@@ -51,18 +67,92 @@ func (g *ACMGenerator) createCertificatesResources(svc *acm.Client) []terraformu
 				acmAllowEmptyValues,
 				acmAdditionalFields,
 			))
+			for _, validation := range detail.DomainValidationOptions {
+				if validation.ValidationMethod == acm.ValidationMethodDns && validation.ResourceRecord != nil {
+					validationRecords = append(validationRecords, *validation.ResourceRecord)
+				}
+			}
 		}
 	}
 
+	if err := p.Err(); err != nil {
+		log.Println(err)
+		return resources, validationRecords
+	}
+	return resources, validationRecords
+}
+
+// createValidationRecordsResources creates CNAME records of DNS validation in hosted zones they
+// belong to, records are shared by names of same domain so they are created once
+func (g *ACMGenerator) createValidationRecordsResources(svc *route53.Client, validationRecords []acm.ResourceRecord) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	zones := map[string]string{}
+	p := route53.NewListHostedZonesPaginator(svc.ListHostedZonesRequest(&route53.ListHostedZonesInput{}))
+	for p.Next(context.Background()) {
+		for _, zone := range p.CurrentPage().HostedZones {
+			if zone.Config != nil && aws.BoolValue(zone.Config.PrivateZone) {
+				continue
+			}
+			zones[aws.StringValue(zone.Name)] = cleanZoneID(aws.StringValue(zone.Id))
+		}
+	}
 	if err := p.Err(); err != nil {
 		log.Println(err)
 		return resources
 	}
+	created := map[string]bool{}
+	for _, record := range validationRecords {
+		recordName := aws.StringValue(record.Name)
+		zoneID := zoneOfRecord(zones, recordName)
+		if zoneID == "" || created[recordName] {
+			continue
+		}
+		created[recordName] = true
+		typeString, _ := record.Type.MarshalValue()
+		resources = append(resources, terraformutils.NewResource(
+			fmt.Sprintf("%s_%s_%s_", zoneID, recordName, typeString),
+			fmt.Sprintf("%s_%s_%s_", zoneID, recordName, typeString),
+			"aws_route53_record",
+			"aws",
+			map[string]string{
+				"name":    strings.TrimSuffix(recordName, "."),
+				"zone_id": zoneID,
+				"type":    typeString,
+			},
+			acmAllowEmptyValues,
+			acmAdditionalFields,
+		))
+	}
 	return resources
 }
 
+// zoneOfRecord returns id of most specific hosted zone record name belongs to
+func zoneOfRecord(zones map[string]string, recordName string) string {
+	zoneID, zoneName := "", ""
+	for name, id := range zones {
+		if (recordName == name || strings.HasSuffix(recordName, "."+name)) && len(name) > len(zoneName) {
+			zoneID, zoneName = id, name
+		}
+	}
+	return zoneID
+}
+
+// isAcmValidationRecord tells if record is CNAME of ACM DNS validation
+func isAcmValidationRecord(record route53.ResourceRecordSet) bool {
+	if record.Type != route53.RRTypeCname || len(record.ResourceRecords) == 0 {
+		return false
+	}
+	for _, value := range record.ResourceRecords {
+		if !strings.HasSuffix(strings.TrimSuffix(aws.StringValue(value.Value), "."), ".acm-validations.aws") {
+			return false
+		}
+	}
+	return true
+}
+
 // Generate TerraformResources from AWS API,
-// create terraform resource for each certificates
+// create terraform resource for each certificates, with route53 in the same import
+// CNAME records of DNS validation are created too
 func (g *ACMGenerator) InitResources() error {
 	config, e := g.generateConfig()
 	if e != nil {
@@ -70,7 +160,11 @@ func (g *ACMGenerator) InitResources() error {
 	}
 	svc := acm.New(config)
 
-	g.Resources = g.createCertificatesResources(svc)
+	certificates, validationRecords := g.createCertificatesResources(svc)
+	g.Resources = certificates
+	if g.isServiceImported("route53") && len(validationRecords) > 0 {
+		g.Resources = append(g.Resources, g.createValidationRecordsResources(route53.New(config), validationRecords)...)
+	}
 	return nil
 }
 
@@ -0,0 +1,301 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+)
+
+var appMeshAllowEmptyValues = []string{"tags."}
+
+type AppMeshGenerator struct {
+	AWSService
+}
+
+// addResource adds resource of mesh, IDs of all App Mesh resources except meshes are their UIDs
+func (g *AppMeshGenerator) addResource(metadata *appmesh.ResourceMetadata, resourceName, resourceType string, attributes map[string]string) {
+	g.Resources = append(g.Resources, terraformutils.NewResource(
+		aws.StringValue(metadata.Uid),
+		resourceName,
+		resourceType,
+		"aws",
+		attributes,
+		appMeshAllowEmptyValues,
+		map[string]interface{}{},
+	))
+}
+
+func (g *AppMeshGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := appmesh.New(config)
+	p := appmesh.NewListMeshesPaginator(svc.ListMeshesRequest(&appmesh.ListMeshesInput{}))
+	for p.Next(context.Background()) {
+		for _, mesh := range p.CurrentPage().Meshes {
+			meshName := aws.StringValue(mesh.MeshName)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				meshName,
+				meshName,
+				"aws_appmesh_mesh",
+				"aws",
+				appMeshAllowEmptyValues,
+			))
+			for _, load := range []func(*appmesh.Client, string) error{
+				g.loadVirtualNodes,
+				g.loadVirtualRouters,
+				g.loadVirtualServices,
+				g.loadVirtualGateways,
+			} {
+				if err := load(svc, meshName); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return p.Err()
+}
+
+func (g *AppMeshGenerator) loadVirtualNodes(svc *appmesh.Client, meshName string) error {
+	p := appmesh.NewListVirtualNodesPaginator(svc.ListVirtualNodesRequest(&appmesh.ListVirtualNodesInput{
+		MeshName: aws.String(meshName),
+	}))
+	for p.Next(context.Background()) {
+		for _, node := range p.CurrentPage().VirtualNodes {
+			output, err := svc.DescribeVirtualNodeRequest(&appmesh.DescribeVirtualNodeInput{
+				MeshName:        node.MeshName,
+				VirtualNodeName: node.VirtualNodeName,
+			}).Send(context.Background())
+			if err != nil {
+				return err
+			}
+			name := aws.StringValue(node.VirtualNodeName)
+			g.addResource(output.VirtualNode.Metadata, meshName+"_"+name, "aws_appmesh_virtual_node", map[string]string{
+				"mesh_name": meshName,
+				"name":      name,
+			})
+		}
+	}
+	return p.Err()
+}
+
+func (g *AppMeshGenerator) loadVirtualRouters(svc *appmesh.Client, meshName string) error {
+	p := appmesh.NewListVirtualRoutersPaginator(svc.ListVirtualRoutersRequest(&appmesh.ListVirtualRoutersInput{
+		MeshName: aws.String(meshName),
+	}))
+	for p.Next(context.Background()) {
+		for _, router := range p.CurrentPage().VirtualRouters {
+			output, err := svc.DescribeVirtualRouterRequest(&appmesh.DescribeVirtualRouterInput{
+				MeshName:          router.MeshName,
+				VirtualRouterName: router.VirtualRouterName,
+			}).Send(context.Background())
+			if err != nil {
+				return err
+			}
+			name := aws.StringValue(router.VirtualRouterName)
+			g.addResource(output.VirtualRouter.Metadata, meshName+"_"+name, "aws_appmesh_virtual_router", map[string]string{
+				"mesh_name": meshName,
+				"name":      name,
+			})
+			if err := g.loadRoutes(svc, meshName, name); err != nil {
+				return err
+			}
+		}
+	}
+	return p.Err()
+}
+
+func (g *AppMeshGenerator) loadRoutes(svc *appmesh.Client, meshName, routerName string) error {
+	p := appmesh.NewListRoutesPaginator(svc.ListRoutesRequest(&appmesh.ListRoutesInput{
+		MeshName:          aws.String(meshName),
+		VirtualRouterName: aws.String(routerName),
+	}))
+	for p.Next(context.Background()) {
+		for _, route := range p.CurrentPage().Routes {
+			output, err := svc.DescribeRouteRequest(&appmesh.DescribeRouteInput{
+				MeshName:          route.MeshName,
+				VirtualRouterName: route.VirtualRouterName,
+				RouteName:         route.RouteName,
+			}).Send(context.Background())
+			if err != nil {
+				return err
+			}
+			name := aws.StringValue(route.RouteName)
+			g.addResource(output.Route.Metadata, meshName+"_"+routerName+"_"+name, "aws_appmesh_route", map[string]string{
+				"mesh_name":           meshName,
+				"virtual_router_name": routerName,
+				"name":                name,
+			})
+		}
+	}
+	return p.Err()
+}
+
+func (g *AppMeshGenerator) loadVirtualServices(svc *appmesh.Client, meshName string) error {
+	p := appmesh.NewListVirtualServicesPaginator(svc.ListVirtualServicesRequest(&appmesh.ListVirtualServicesInput{
+		MeshName: aws.String(meshName),
+	}))
+	for p.Next(context.Background()) {
+		for _, service := range p.CurrentPage().VirtualServices {
+			output, err := svc.DescribeVirtualServiceRequest(&appmesh.DescribeVirtualServiceInput{
+				MeshName:           service.MeshName,
+				VirtualServiceName: service.VirtualServiceName,
+			}).Send(context.Background())
+			if err != nil {
+				return err
+			}
+			name := aws.StringValue(service.VirtualServiceName)
+			g.addResource(output.VirtualService.Metadata, meshName+"_"+name, "aws_appmesh_virtual_service", map[string]string{
+				"mesh_name": meshName,
+				"name":      name,
+			})
+		}
+	}
+	return p.Err()
+}
+
+func (g *AppMeshGenerator) loadVirtualGateways(svc *appmesh.Client, meshName string) error {
+	p := appmesh.NewListVirtualGatewaysPaginator(svc.ListVirtualGatewaysRequest(&appmesh.ListVirtualGatewaysInput{
+		MeshName: aws.String(meshName),
+	}))
+	for p.Next(context.Background()) {
+		for _, gateway := range p.CurrentPage().VirtualGateways {
+			output, err := svc.DescribeVirtualGatewayRequest(&appmesh.DescribeVirtualGatewayInput{
+				MeshName:           gateway.MeshName,
+				VirtualGatewayName: gateway.VirtualGatewayName,
+			}).Send(context.Background())
+			if err != nil {
+				return err
+			}
+			name := aws.StringValue(gateway.VirtualGatewayName)
+			g.addResource(output.VirtualGateway.Metadata, meshName+"_"+name, "aws_appmesh_virtual_gateway", map[string]string{
+				"mesh_name": meshName,
+				"name":      name,
+			})
+			if err := g.loadGatewayRoutes(svc, meshName, name); err != nil {
+				return err
+			}
+		}
+	}
+	return p.Err()
+}
+
+func (g *AppMeshGenerator) loadGatewayRoutes(svc *appmesh.Client, meshName, gatewayName string) error {
+	p := appmesh.NewListGatewayRoutesPaginator(svc.ListGatewayRoutesRequest(&appmesh.ListGatewayRoutesInput{
+		MeshName:           aws.String(meshName),
+		VirtualGatewayName: aws.String(gatewayName),
+	}))
+	for p.Next(context.Background()) {
+		for _, route := range p.CurrentPage().GatewayRoutes {
+			output, err := svc.DescribeGatewayRouteRequest(&appmesh.DescribeGatewayRouteInput{
+				MeshName:           route.MeshName,
+				VirtualGatewayName: route.VirtualGatewayName,
+				GatewayRouteName:   route.GatewayRouteName,
+			}).Send(context.Background())
+			if err != nil {
+				return err
+			}
+			name := aws.StringValue(route.GatewayRouteName)
+			g.addResource(output.GatewayRoute.Metadata, meshName+"_"+gatewayName+"_"+name, "aws_appmesh_gateway_route", map[string]string{
+				"mesh_name":            meshName,
+				"virtual_gateway_name": gatewayName,
+				"name":                 name,
+			})
+		}
+	}
+	return p.Err()
+}
+
+// PostConvertHook links resources of meshes by names, mesh resources are looked up
+// by type, mesh and name as names are only unique inside of mesh. Health checks
+// of listeners are dropped when API returned them without protocol, they aren't configured then
+func (g *AppMeshGenerator) PostConvertHook() error {
+	names := map[string]string{}
+	for _, r := range g.Resources {
+		names[appMeshKey(r.InstanceInfo.Type, r.InstanceState.Attributes["mesh_name"], r.InstanceState.Attributes["name"])] = r.ResourceName
+	}
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type == "aws_appmesh_mesh" {
+			continue
+		}
+		meshName := r.InstanceState.Attributes["mesh_name"]
+		link := func(path, resourceType string) {
+			for _, value := range terraformutils.WalkAndGet(path, g.Resources[i].Item) {
+				name, ok := value.(string)
+				if !ok {
+					continue
+				}
+				if resourceName, exist := names[appMeshKey(resourceType, meshName, name)]; exist {
+					terraformutils.WalkAndOverride(path, name, "${"+resourceType+"."+resourceName+".name}", g.Resources[i].Item)
+				}
+			}
+		}
+		if resourceName, exist := names[appMeshKey("aws_appmesh_mesh", "", meshName)]; exist {
+			g.Resources[i].Item["mesh_name"] = "${aws_appmesh_mesh." + resourceName + ".id}"
+		}
+		switch r.InstanceInfo.Type {
+		case "aws_appmesh_virtual_node", "aws_appmesh_virtual_gateway":
+			removeEmptyHealthChecks(g.Resources[i].Item)
+			link("spec.backend.virtual_service.virtual_service_name", "aws_appmesh_virtual_service")
+		case "aws_appmesh_virtual_service":
+			link("spec.provider.virtual_node.virtual_node_name", "aws_appmesh_virtual_node")
+			link("spec.provider.virtual_router.virtual_router_name", "aws_appmesh_virtual_router")
+		case "aws_appmesh_route":
+			link("virtual_router_name", "aws_appmesh_virtual_router")
+			for _, route := range []string{"http_route", "http2_route", "grpc_route", "tcp_route"} {
+				link("spec."+route+".action.weighted_target.virtual_node", "aws_appmesh_virtual_node")
+			}
+		case "aws_appmesh_gateway_route":
+			link("virtual_gateway_name", "aws_appmesh_virtual_gateway")
+			for _, route := range []string{"http_route", "http2_route", "grpc_route"} {
+				link("spec."+route+".action.target.virtual_service.virtual_service_name", "aws_appmesh_virtual_service")
+			}
+		}
+	}
+	return nil
+}
+
+func appMeshKey(resourceType, meshName, name string) string {
+	if resourceType == "aws_appmesh_mesh" {
+		meshName = ""
+	}
+	return resourceType + "/" + meshName + "/" + name
+}
+
+// removeEmptyHealthChecks drops health_check blocks of listeners without protocol
+func removeEmptyHealthChecks(item map[string]interface{}) {
+	for _, spec := range terraformutils.WalkAndGet("spec", item) {
+		for _, listener := range terraformutils.WalkAndGet("listener", spec) {
+			listenerBlock, ok := listener.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			healthChecks, _ := listenerBlock["health_check"].([]interface{})
+			if len(healthChecks) == 0 {
+				delete(listenerBlock, "health_check")
+				continue
+			}
+			if healthCheck, ok := healthChecks[0].(map[string]interface{}); !ok || healthCheck["protocol"] == nil || healthCheck["protocol"] == "" {
+				delete(listenerBlock, "health_check")
+			}
+		}
+	}
+}
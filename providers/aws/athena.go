@@ -0,0 +1,98 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+)
+
+var athenaAllowEmptyValues = []string{"tags."}
+
+type AthenaGenerator struct {
+	AWSService
+}
+
+func (g *AthenaGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := athena.New(config)
+
+	p := athena.NewListWorkGroupsPaginator(svc.ListWorkGroupsRequest(&athena.ListWorkGroupsInput{}))
+	for p.Next(context.Background()) {
+		for _, workGroup := range p.CurrentPage().WorkGroups {
+			name := aws.StringValue(workGroup.Name)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				name,
+				name,
+				"aws_athena_workgroup",
+				"aws",
+				athenaAllowEmptyValues))
+			if err := g.loadNamedQueries(svc, workGroup.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return p.Err()
+}
+
+// loadNamedQueries fetches queries of workgroup in batches to name resources by query name
+func (g *AthenaGenerator) loadNamedQueries(svc *athena.Client, workGroup *string) error {
+	p := athena.NewListNamedQueriesPaginator(svc.ListNamedQueriesRequest(&athena.ListNamedQueriesInput{
+		WorkGroup: workGroup,
+	}))
+	for p.Next(context.Background()) {
+		ids := p.CurrentPage().NamedQueryIds
+		if len(ids) == 0 {
+			continue
+		}
+		queries, err := svc.BatchGetNamedQueryRequest(&athena.BatchGetNamedQueryInput{
+			NamedQueryIds: ids,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, query := range queries.NamedQueries {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				aws.StringValue(query.NamedQueryId),
+				aws.StringValue(query.WorkGroup)+"_"+aws.StringValue(query.Name),
+				"aws_athena_named_query",
+				"aws",
+				athenaAllowEmptyValues))
+		}
+	}
+	return p.Err()
+}
+
+// PostConvertHook links named queries to their workgroups
+func (g *AthenaGenerator) PostConvertHook() error {
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type != "aws_athena_named_query" {
+			continue
+		}
+		for _, workGroup := range g.Resources {
+			if workGroup.InstanceInfo.Type == "aws_athena_workgroup" &&
+				workGroup.InstanceState.ID == r.InstanceState.Attributes["workgroup"] {
+				r.Item["workgroup"] = "${aws_athena_workgroup." + workGroup.ResourceName + ".name}"
+			}
+		}
+	}
+	return nil
+}
@@ -17,16 +17,24 @@ package aws
 import (
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	"github.com/pkg/errors"
 	"github.com/zclconf/go-cty/cty"
 )
 
 type AWSProvider struct { //nolint
 	terraformutils.Provider
-	region  string
-	profile string
+	region       string
+	profile      string
+	fullSettings bool
+	ssmDecrypt   bool
+	// secretsIncludeValues imports current versions of Secrets Manager secrets
+	secretsIncludeValues bool
+	// importedServices are all services of the import across regions
+	importedServices []string
 }
 
 const GlobalRegion = "aws-global"
@@ -35,18 +43,23 @@ const NoRegion = ""
 // SupportedGlobalResources should be bound to a default region. AWS doesn't specify in which region default services are
 // placed (see  https://docs.aws.amazon.com/general/latest/gr/rande.html), so we shouldn't assume any region as well
 //
-// AWS WAF V2 if added, should not be included in this list since it is a composition of regional and global resources.
+// AWS WAF V2 is a composition of regional and global resources, only its CLOUDFRONT scope belongs to this list.
 var SupportedGlobalResources = []string{
 	"budgets",
 	"cloudfront",
+	"globalaccelerator",
 	"iam",
 	"organization",
 	"route53",
 	"waf",
+	"wafv2_cloudfront",
 }
 
 func (p AWSProvider) GetResourceConnections() map[string]map[string][]string {
 	return map[string]map[string][]string{
+		"acm": {
+			"route53": []string{"zone_id", "zone_id"},
+		},
 		"alb": {
 			"sg":     []string{"security_groups", "id"},
 			"subnet": []string{"subnets", "id"},
@@ -60,16 +73,74 @@ func (p AWSProvider) GetResourceConnections() map[string]map[string][]string {
 			"sg":     []string{"security_groups", "id"},
 			"subnet": []string{"vpc_zone_identifier", "id"},
 		},
+		"batch": {
+			"iam": []string{
+				"service_role", "arn",
+				"compute_resources.instance_role", "arn",
+			},
+			"sg":     []string{"compute_resources.security_group_ids", "id"},
+			"subnet": []string{"compute_resources.subnets", "id"},
+		},
+		"direct_connect": {
+			"transit_gateway": []string{"associated_gateway_id", "id"},
+			"vpn_gateway": []string{
+				"vpn_gateway_id", "id",
+				"associated_gateway_id", "id",
+			},
+		},
 		"ec2_instance": {
-			"sg":     []string{"vpc_security_group_ids", "id"},
-			"subnet": []string{"subnet_id", "id"},
-			"ebs":    []string{"ebs_block_device", "id"},
+			"sg":              []string{"vpc_security_group_ids", "id"},
+			"subnet":          []string{"subnet_id", "id"},
+			"ebs":             []string{"ebs_block_device", "id"},
+			"key_pair":        []string{"key_name", "key_name"},
+			"placement_group": []string{"placement_group", "name"},
+			"dedicated_host":  []string{"host_id", "id"},
 		},
 		"elasticache": {
 			"vpc":    []string{"vpc_id", "id"},
 			"subnet": []string{"subnet_ids", "id"},
 			"sg":     []string{"security_group_ids", "id"},
 		},
+		"cloudfront": {
+			"acm": []string{"viewer_certificate.acm_certificate_arn", "arn"},
+			"lambda": []string{
+				"default_cache_behavior.lambda_function_association.lambda_arn", "qualified_arn",
+				"ordered_cache_behavior.lambda_function_association.lambda_arn", "qualified_arn",
+			},
+			"s3":               []string{"origin.domain_name", "bucket_regional_domain_name"},
+			"wafv2_cloudfront": []string{"web_acl_id", "arn"},
+		},
+		"cloudtrail": {
+			"iam": []string{"cloud_watch_logs_role_arn", "arn"},
+			"kms": []string{"kms_key_id", "arn"},
+			"s3":  []string{"s3_bucket_name", "id"},
+			"sns": []string{"sns_topic_name", "name"},
+		},
+		"cloudwatch": {
+			"lambda": []string{"arn", "arn"},
+			"sqs":    []string{"arn", "arn"},
+			"sfn":    []string{"arn", "id"},
+		},
+		"athena": {
+			"glue": []string{"database", "name"},
+		},
+		"codebuild": {
+			"iam": []string{"service_role", "arn"},
+			"kms": []string{"encryption_key", "arn"},
+		},
+		"codepipeline": {
+			"codebuild":  []string{"stage.action.configuration.ProjectName", "name"},
+			"codecommit": []string{"stage.action.configuration.RepositoryName", "repository_name"},
+			"iam":        []string{"role_arn", "arn"},
+			"kms":        []string{"artifact_store.encryption_key.id", "arn"},
+			"s3":         []string{"artifact_store.location", "id"},
+		},
+		"config": {
+			"iam":    []string{"role_arn", "arn"},
+			"lambda": []string{"source.source_identifier", "arn"},
+			"s3":     []string{"s3_bucket_name", "id"},
+			"sns":    []string{"sns_topic_arn", "id"},
+		},
 		"ebs": {
 			// TF EBS attachment logic doesn't work well with references (doesn't interpolate)
 		},
@@ -78,6 +149,11 @@ func (p AWSProvider) GetResourceConnections() map[string]map[string][]string {
 			"subnet": []string{"network_configuration.subnets", "id"},
 			"sg":     []string{"network_configuration.security_groups", "id"},
 		},
+		"efs": {
+			"kms":    []string{"kms_key_id", "arn"},
+			"sg":     []string{"security_groups", "id"},
+			"subnet": []string{"subnet_id", "id"},
+		},
 		"eks": {
 			"subnet": []string{"vpc_config.subnet_ids", "id"},
 			"sg":     []string{"vpc_config.security_group_ids", "id"},
@@ -86,30 +162,99 @@ func (p AWSProvider) GetResourceConnections() map[string]map[string][]string {
 			"sg":     []string{"security_groups", "id"},
 			"subnet": []string{"subnets", "id"},
 		},
+		"es": {
+			"iam":    []string{"access_policies", "arn"},
+			"sg":     []string{"vpc_options.security_group_ids", "id"},
+			"subnet": []string{"vpc_options.subnet_ids", "id"},
+		},
+		"firehose": {
+			"iam": []string{
+				"extended_s3_configuration.role_arn", "arn",
+				"s3_configuration.role_arn", "arn",
+				"redshift_configuration.role_arn", "arn",
+				"elasticsearch_configuration.role_arn", "arn",
+				"http_endpoint_configuration.role_arn", "arn",
+				"kinesis_source_configuration.role_arn", "arn",
+			},
+			"kinesis": []string{"kinesis_source_configuration.kinesis_stream_arn", "arn"},
+			"s3": []string{
+				"extended_s3_configuration.bucket_arn", "arn",
+				"s3_configuration.bucket_arn", "arn",
+			},
+		},
+		"glue": {
+			"iam": []string{
+				"role_arn", "arn",
+				"role", "arn",
+			},
+		},
 		"igw": {"vpc": []string{"vpc_id", "id"}},
+		"globalaccelerator": {
+			"alb":          []string{"endpoint_configuration.endpoint_id", "id"},
+			"ec2_instance": []string{"endpoint_configuration.endpoint_id", "id"},
+			"eip":          []string{"endpoint_configuration.endpoint_id", "id"},
+		},
 		"msk": {
 			"subnet": []string{"broker_node_group_info.client_subnets", "id"},
 			"sg":     []string{"broker_node_group_info.security_groups", "id"},
+			"kms":    []string{"encryption_info.encryption_at_rest_kms_key_arn", "arn"},
+			"s3":     []string{"logging_info.broker_logs.s3.bucket", "id"},
 		},
 		"nacl": {
 			"subnet": []string{"subnet_ids", "id"},
 			"vpc":    []string{"vpc_id", "id"},
 		},
-		"organization": {
-			"organization": []string{
-				"policy_id", "id",
-				"parent_id", "id",
-				"target_id", "id",
-			},
-		},
 		"rds": {
 			"subnet": []string{"subnet_ids", "id"},
 			"sg":     []string{"vpc_security_group_ids", "id"},
 		},
+		"redshift": {
+			"iam":    []string{"iam_roles", "arn"},
+			"subnet": []string{"subnet_ids", "id"},
+			"sg":     []string{"vpc_security_group_ids", "id"},
+		},
+		"nat": {
+			"subnet": []string{"subnet_id", "id"},
+			"eip":    []string{"allocation_id", "id"},
+		},
 		"route_table": {
 			"route_table": []string{"route_table_id", "id"},
 			"subnet":      []string{"subnet_id", "id"},
 			"vpc":         []string{"vpc_id", "id"},
+			"nat":         []string{"route.nat_gateway_id", "id"},
+			"vpc_peering": []string{"route.vpc_peering_connection_id", "id"},
+			"vpn_gateway": []string{"route.gateway_id", "id"},
+		},
+		"sagemaker": {
+			"iam": []string{
+				"role_arn", "arn",
+				"execution_role_arn", "arn",
+			},
+			"kms": []string{
+				"kms_key_id", "arn",
+				"kms_key_arn", "arn",
+			},
+			"sg": []string{
+				"security_groups", "id",
+				"vpc_config.security_group_ids", "id",
+			},
+			"subnet": []string{
+				"subnet_id", "id",
+				"vpc_config.subnets", "id",
+			},
+		},
+		"secretsmanager": {
+			"kms":    []string{"kms_key_id", "arn"},
+			"lambda": []string{"rotation_lambda_arn", "arn"},
+		},
+		"ses": {
+			"iam":    []string{"kinesis_destination.role_arn", "arn"},
+			"lambda": []string{"lambda_action.function_arn", "arn"},
+			"s3":     []string{"s3_action.bucket_name", "id"},
+			"sns": []string{
+				"sns_action.topic_arn", "id",
+				"sns_destination.topic_arn", "id",
+			},
 		},
 		"sns": {
 			"sns": []string{"topic_arn", "id"},
@@ -123,6 +268,18 @@ func (p AWSProvider) GetResourceConnections() map[string]map[string][]string {
 				"source_security_group_id", "id",
 			},
 		},
+		"spot_fleet": {
+			"iam": []string{
+				"iam_fleet_role", "arn",
+				"launch_specification.iam_instance_profile_arn", "arn",
+			},
+			"key_pair": []string{"launch_specification.key_name", "key_name"},
+			"sg":       []string{"launch_specification.vpc_security_group_ids", "id"},
+			"subnet":   []string{"launch_specification.subnet_id", "id"},
+		},
+		"ssoadmin": {
+			"organization": []string{"target_id", "id"},
+		},
 		"subnet": {"vpc": []string{"vpc_id", "id"}},
 		"transit_gateway": {
 			"vpc":             []string{"vpc_id", "id"},
@@ -130,11 +287,24 @@ func (p AWSProvider) GetResourceConnections() map[string]map[string][]string {
 			"subnet":          []string{"subnet_ids", "id"},
 			"vpn_connection":  []string{"vpn_connection_id", "id"},
 		},
+		"vpc_endpoint": {
+			"vpc":         []string{"vpc_id", "id"},
+			"subnet":      []string{"subnet_ids", "id"},
+			"sg":          []string{"security_group_ids", "id"},
+			"route_table": []string{"route_table_ids", "id"},
+		},
+		"vpc_peering": {
+			"vpc": []string{"vpc_id", "id"},
+		},
 		"vpn_gateway": {"vpc": []string{"vpc_id", "id"}},
 		"vpn_connection": {
 			"customer_gateway": []string{"customer_gateway_id", "id"},
 			"vpn_gateway":      []string{"vpn_gateway_id", "id"},
 		},
+		"wafv2_regional": {
+			"alb":         []string{"resource_arn", "id"},
+			"api_gateway": []string{"resource_arn", "arn"},
+		},
 	}
 }
 
@@ -175,6 +345,18 @@ func (p *AWSProvider) GetBasicConfig() cty.Value {
 func (p *AWSProvider) Init(args []string) error {
 	p.region = args[0]
 	p.profile = args[1]
+	if len(args) > 2 {
+		p.fullSettings, _ = strconv.ParseBool(args[2])
+	}
+	if len(args) > 3 {
+		p.ssmDecrypt, _ = strconv.ParseBool(args[3])
+	}
+	if len(args) > 4 {
+		p.secretsIncludeValues, _ = strconv.ParseBool(args[4])
+	}
+	if len(args) > 5 && args[5] != "" {
+		p.importedServices = strings.Split(args[5], ",")
+	}
 
 	// Terraformer accepts region and profile configuration, so we must detect what env variables to adjust to make Go SDK rely on them. AWS_SDK_LOAD_CONFIG here must be checked to determine correct variable to set.
 	enableSharedConfig, _ := strconv.ParseBool(os.Getenv("AWS_SDK_LOAD_CONFIG"))
@@ -220,6 +402,10 @@ func (p *AWSProvider) InitService(serviceName string, verbose bool) error {
 		"region":                 p.region,
 		"profile":                p.profile,
 		"skip_region_validation": true,
+		"full_settings":          p.fullSettings,
+		"ssm_decrypt":            p.ssmDecrypt,
+		"secrets_include_values": p.secretsIncludeValues,
+		"imported_services":      p.importedServices,
 	})
 	return nil
 }
@@ -231,8 +417,11 @@ func (p *AWSProvider) GetSupportedService() map[string]terraformutils.ServiceGen
 		"acm":               &AwsFacade{service: &ACMGenerator{}},
 		"alb":               &AwsFacade{service: &AlbGenerator{}},
 		"api_gateway":       &AwsFacade{service: &APIGatewayGenerator{}},
+		"athena":            &AwsFacade{service: &AthenaGenerator{}},
+		"appmesh":           &AwsFacade{service: &AppMeshGenerator{}},
 		"appsync":           &AwsFacade{service: &AppSyncGenerator{}},
 		"auto_scaling":      &AwsFacade{service: &AutoScalingGenerator{}},
+		"batch":             &AwsFacade{service: &BatchGenerator{}},
 		"budgets":           &AwsFacade{service: &BudgetsGenerator{}},
 		"cloud9":            &AwsFacade{service: &Cloud9Generator{}},
 		"cloudformation":    &AwsFacade{service: &CloudFormationGenerator{}},
@@ -248,7 +437,9 @@ func (p *AWSProvider) GetSupportedService() map[string]terraformutils.ServiceGen
 		"config":            &AwsFacade{service: &ConfigGenerator{}},
 		"customer_gateway":  &AwsFacade{service: &CustomerGatewayGenerator{}},
 		"datapipeline":      &AwsFacade{service: &DataPipelineGenerator{}},
+		"dedicated_host":    &AwsFacade{service: &DedicatedHostGenerator{}},
 		"devicefarm":        &AwsFacade{service: &DeviceFarmGenerator{}},
+		"direct_connect":    &AwsFacade{service: &DirectConnectGenerator{}},
 		"dynamodb":          &AwsFacade{service: &DynamoDbGenerator{}},
 		"ebs":               &AwsFacade{service: &EbsGenerator{}},
 		"ec2_instance":      &AwsFacade{service: &Ec2Generator{}},
@@ -264,10 +455,14 @@ func (p *AWSProvider) GetSupportedService() map[string]terraformutils.ServiceGen
 		"eni":               &AwsFacade{service: &EniGenerator{}},
 		"es":                &AwsFacade{service: &EsGenerator{}},
 		"firehose":          &AwsFacade{service: &FirehoseGenerator{}},
+		"globalaccelerator": &AwsFacade{service: &GlobalAcceleratorGenerator{}},
 		"glue":              &AwsFacade{service: &GlueGenerator{}},
+		"guardduty":         &AwsFacade{service: &GuardDutyGenerator{}},
 		"iam":               &AwsFacade{service: &IamGenerator{}},
 		"igw":               &AwsFacade{service: &IgwGenerator{}},
+		"inspector":         &AwsFacade{service: &InspectorGenerator{}},
 		"iot":               &AwsFacade{service: &IotGenerator{}},
+		"key_pair":          &AwsFacade{service: &KeyPairGenerator{}},
 		"kinesis":           &AwsFacade{service: &KinesisGenerator{}},
 		"kms":               &AwsFacade{service: &KmsGenerator{}},
 		"lambda":            &AwsFacade{service: &LambdaGenerator{}},
@@ -278,26 +473,35 @@ func (p *AWSProvider) GetSupportedService() map[string]terraformutils.ServiceGen
 		"nacl":              &AwsFacade{service: &NaclGenerator{}},
 		"nat":               &AwsFacade{service: &NatGatewayGenerator{}},
 		"organization":      &AwsFacade{service: &OrganizationGenerator{}},
+		"placement_group":   &AwsFacade{service: &PlacementGroupGenerator{}},
 		"qldb":              &AwsFacade{service: &QLDBGenerator{}},
 		"rds":               &AwsFacade{service: &RDSGenerator{}},
+		"redshift":          &AwsFacade{service: &RedshiftGenerator{}},
 		"resourcegroups":    &AwsFacade{service: &ResourceGroupsGenerator{}},
 		"route53":           &AwsFacade{service: &Route53Generator{}},
 		"route_table":       &AwsFacade{service: &RouteTableGenerator{}},
 		"s3":                &AwsFacade{service: &S3Generator{}},
+		"sagemaker":         &AwsFacade{service: &SageMakerGenerator{}},
 		"secretsmanager":    &AwsFacade{service: &SecretsManagerGenerator{}},
 		"securityhub":       &AwsFacade{service: &SecurityhubGenerator{}},
 		"servicecatalog":    &AwsFacade{service: &ServiceCatalogGenerator{}},
 		"ses":               &AwsFacade{service: &SesGenerator{}},
 		"sfn":               &AwsFacade{service: &SfnGenerator{}},
 		"sg":                &AwsFacade{service: &SecurityGenerator{}},
+		"spot_fleet":        &AwsFacade{service: &SpotFleetGenerator{}},
 		"sqs":               &AwsFacade{service: &SqsGenerator{}},
+		"ssm":               &AwsFacade{service: &SsmGenerator{}},
+		"ssoadmin":          &AwsFacade{service: &SSOAdminGenerator{}},
 		"sns":               &AwsFacade{service: &SnsGenerator{}},
 		"subnet":            &AwsFacade{service: &SubnetGenerator{}},
 		"swf":               &AwsFacade{service: &SWFGenerator{}},
 		"transit_gateway":   &AwsFacade{service: &TransitGatewayGenerator{}},
 		"waf":               &AwsFacade{service: &WafGenerator{}},
 		"waf_regional":      &AwsFacade{service: &WafRegionalGenerator{}},
+		"wafv2_cloudfront":  &AwsFacade{service: &Wafv2Generator{scope: wafv2.ScopeCloudfront}},
+		"wafv2_regional":    &AwsFacade{service: &Wafv2Generator{scope: wafv2.ScopeRegional}},
 		"vpc":               &AwsFacade{service: &VpcGenerator{}},
+		"vpc_endpoint":      &AwsFacade{service: &VpcEndpointGenerator{}},
 		"vpc_peering":       &AwsFacade{service: &VpcPeeringConnectionGenerator{}},
 		"vpn_connection":    &AwsFacade{service: &VpnConnectionGenerator{}},
 		"vpn_gateway":       &AwsFacade{service: &VpnGatewayGenerator{}},
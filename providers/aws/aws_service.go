@@ -43,6 +43,9 @@ func (s *AWSService) generateConfig() (aws.Config, error) {
 	if s.Verbose {
 		config.LogLevel = aws.LogDebugWithHTTPBody
 	}
+	if logger := terraformutils.GetAPIAuditLogger(); logger != nil {
+		config.HTTPClient = logger.Doer(config.HTTPClient)
+	}
 
 	creds, e := config.Credentials.Retrieve(context.Background())
 
@@ -78,6 +81,17 @@ func (*AWSService) escapeAwsInterpolation(str string) string {
 	return awsVariable.ReplaceAllString(str, "$$$1")
 }
 
+// isServiceImported tells if service is imported in the same run, in any region
+func (s *AWSService) isServiceImported(serviceName string) bool {
+	services, _ := s.GetArgs()["imported_services"].([]string)
+	for _, service := range services {
+		if service == serviceName || service == "*" {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *AWSService) getAccountNumber(config aws.Config) (*string, error) {
 	stsSvc := sts.New(config)
 	identity, err := stsSvc.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{}).Send(context.Background())
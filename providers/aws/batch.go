@@ -0,0 +1,148 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+)
+
+var batchAllowEmptyValues = []string{"tags."}
+
+type BatchGenerator struct {
+	AWSService
+}
+
+func (g *BatchGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := batch.New(config)
+	if err := g.loadComputeEnvironments(svc); err != nil {
+		return err
+	}
+	if err := g.loadJobQueues(svc); err != nil {
+		return err
+	}
+	return g.loadJobDefinitions(svc)
+}
+
+func (g *BatchGenerator) loadComputeEnvironments(svc *batch.Client) error {
+	p := batch.NewDescribeComputeEnvironmentsPaginator(svc.DescribeComputeEnvironmentsRequest(&batch.DescribeComputeEnvironmentsInput{}))
+	for p.Next(context.Background()) {
+		for _, computeEnvironment := range p.CurrentPage().ComputeEnvironments {
+			name := aws.StringValue(computeEnvironment.ComputeEnvironmentName)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				name,
+				name,
+				"aws_batch_compute_environment",
+				"aws",
+				batchAllowEmptyValues))
+		}
+	}
+	return p.Err()
+}
+
+func (g *BatchGenerator) loadJobQueues(svc *batch.Client) error {
+	p := batch.NewDescribeJobQueuesPaginator(svc.DescribeJobQueuesRequest(&batch.DescribeJobQueuesInput{}))
+	for p.Next(context.Background()) {
+		for _, jobQueue := range p.CurrentPage().JobQueues {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				aws.StringValue(jobQueue.JobQueueArn),
+				aws.StringValue(jobQueue.JobQueueName),
+				"aws_batch_job_queue",
+				"aws",
+				batchAllowEmptyValues))
+		}
+	}
+	return p.Err()
+}
+
+// loadJobDefinitions imports the latest active revision of every job definition, older revisions can't be managed
+func (g *BatchGenerator) loadJobDefinitions(svc *batch.Client) error {
+	latest := map[string]batch.JobDefinition{}
+	var names []string
+	p := batch.NewDescribeJobDefinitionsPaginator(svc.DescribeJobDefinitionsRequest(&batch.DescribeJobDefinitionsInput{
+		Status: aws.String("ACTIVE"),
+	}))
+	for p.Next(context.Background()) {
+		for _, jobDefinition := range p.CurrentPage().JobDefinitions {
+			name := aws.StringValue(jobDefinition.JobDefinitionName)
+			current, exists := latest[name]
+			if !exists {
+				names = append(names, name)
+			}
+			if !exists || aws.Int64Value(jobDefinition.Revision) > aws.Int64Value(current.Revision) {
+				latest[name] = jobDefinition
+			}
+		}
+	}
+	if err := p.Err(); err != nil {
+		return err
+	}
+	for _, name := range names {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			aws.StringValue(latest[name].JobDefinitionArn),
+			name,
+			"aws_batch_job_definition",
+			"aws",
+			batchAllowEmptyValues))
+	}
+	return nil
+}
+
+func (g *BatchGenerator) PostConvertHook() error {
+	computeEnvironments := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "aws_batch_compute_environment" {
+			computeEnvironments[r.InstanceState.Attributes["arn"]] = "${aws_batch_compute_environment." + r.ResourceName + ".arn}"
+		}
+	}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_batch_compute_environment":
+			if !strings.EqualFold(r.InstanceState.Attributes["type"], "MANAGED") {
+				// unmanaged environments don't accept compute resources
+				delete(r.Item, "compute_resources")
+				continue
+			}
+			// desired vCPUs are scaled by Batch
+			r.Item["lifecycle"] = []interface{}{map[string]interface{}{
+				"ignore_changes": []interface{}{"compute_resources[0].desired_vcpus"},
+			}}
+		case "aws_batch_job_queue":
+			if arns, ok := r.Item["compute_environments"].([]interface{}); ok {
+				for i, arn := range arns {
+					if reference, ok := computeEnvironments[fmt.Sprint(arn)]; ok {
+						arns[i] = reference
+					}
+				}
+			}
+		case "aws_batch_job_definition":
+			if containerProperties, ok := r.Item["container_properties"].(string); ok {
+				r.Item["container_properties"] = fmt.Sprintf(`<<CONTAINER_PROPERTIES
+%s
+CONTAINER_PROPERTIES`, g.escapeAwsInterpolation(containerProperties))
+			}
+		}
+	}
+	return nil
+}
@@ -16,14 +16,34 @@ package aws
 
 import (
 	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 )
 
 var cloudFrontAllowEmptyValues = []string{"tags."}
 
+// cloudFrontFunctionsURL is endpoint of CloudFront Functions API, it isn't covered by SDK client
+const cloudFrontFunctionsURL = "https://cloudfront.amazonaws.com/2020-05-31/function"
+
+type cloudFrontFunctionList struct {
+	NextMarker string `xml:"NextMarker"`
+	Items      []struct {
+		Name             string `xml:"Name"`
+		FunctionMetadata struct {
+			FunctionARN string `xml:"FunctionARN"`
+		} `xml:"FunctionMetadata"`
+	} `xml:"Items>FunctionSummary"`
+}
+
 type CloudFrontGenerator struct {
 	AWSService
 }
@@ -34,6 +54,22 @@ func (g *CloudFrontGenerator) InitResources() error {
 		return e
 	}
 	svc := cloudfront.New(config)
+	if err := g.loadDistributions(svc); err != nil {
+		return err
+	}
+	if err := g.loadOriginAccessIdentities(svc); err != nil {
+		return err
+	}
+	if err := g.loadCachePolicies(svc); err != nil {
+		return err
+	}
+	if err := g.loadOriginRequestPolicies(svc); err != nil {
+		return err
+	}
+	return g.loadFunctions(config)
+}
+
+func (g *CloudFrontGenerator) loadDistributions(svc *cloudfront.Client) error {
 	p := cloudfront.NewListDistributionsPaginator(svc.ListDistributionsRequest(&cloudfront.ListDistributionsInput{}))
 	for p.Next(context.Background()) {
 		for _, distribution := range p.CurrentPage().DistributionList.Items {
@@ -54,3 +90,168 @@ func (g *CloudFrontGenerator) InitResources() error {
 	}
 	return p.Err()
 }
+
+func (g *CloudFrontGenerator) loadOriginAccessIdentities(svc *cloudfront.Client) error {
+	p := cloudfront.NewListCloudFrontOriginAccessIdentitiesPaginator(
+		svc.ListCloudFrontOriginAccessIdentitiesRequest(&cloudfront.ListCloudFrontOriginAccessIdentitiesInput{}))
+	for p.Next(context.Background()) {
+		for _, identity := range p.CurrentPage().CloudFrontOriginAccessIdentityList.Items {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				aws.StringValue(identity.Id),
+				aws.StringValue(identity.Id),
+				"aws_cloudfront_origin_access_identity",
+				"aws",
+				cloudFrontAllowEmptyValues,
+			))
+		}
+	}
+	return p.Err()
+}
+
+// Only custom policies are imported, managed ones are owned by AWS
+func (g *CloudFrontGenerator) loadCachePolicies(svc *cloudfront.Client) error {
+	var marker *string
+	for {
+		policies, err := svc.ListCachePoliciesRequest(&cloudfront.ListCachePoliciesInput{
+			Marker: marker,
+			Type:   cloudfront.CachePolicyTypeCustom,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, policy := range policies.CachePolicyList.Items {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				aws.StringValue(policy.CachePolicy.Id),
+				aws.StringValue(policy.CachePolicy.CachePolicyConfig.Name),
+				"aws_cloudfront_cache_policy",
+				"aws",
+				cloudFrontAllowEmptyValues,
+			))
+		}
+		marker = policies.CachePolicyList.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+	return nil
+}
+
+func (g *CloudFrontGenerator) loadOriginRequestPolicies(svc *cloudfront.Client) error {
+	var marker *string
+	for {
+		policies, err := svc.ListOriginRequestPoliciesRequest(&cloudfront.ListOriginRequestPoliciesInput{
+			Marker: marker,
+			Type:   cloudfront.OriginRequestPolicyTypeCustom,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, policy := range policies.OriginRequestPolicyList.Items {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				aws.StringValue(policy.OriginRequestPolicy.Id),
+				aws.StringValue(policy.OriginRequestPolicy.OriginRequestPolicyConfig.Name),
+				"aws_cloudfront_origin_request_policy",
+				"aws",
+				cloudFrontAllowEmptyValues,
+			))
+		}
+		marker = policies.OriginRequestPolicyList.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+	return nil
+}
+
+// loadFunctions adds published CloudFront Functions, API is called with signed requests
+func (g *CloudFrontGenerator) loadFunctions(config aws.Config) error {
+	signer := v4.NewSigner(config.Credentials)
+	marker := ""
+	for {
+		query := url.Values{"Stage": []string{"LIVE"}}
+		if marker != "" {
+			query.Set("Marker", marker)
+		}
+		req, err := http.NewRequest("GET", cloudFrontFunctionsURL+"?"+query.Encode(), nil)
+		if err != nil {
+			return err
+		}
+		if _, err := signer.Sign(context.Background(), req, nil, "cloudfront", "us-east-1", time.Now()); err != nil {
+			return err
+		}
+		resp, err := config.HTTPClient.Do(req)
+		if err != nil {
+			return err
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("aws: CloudFront ListFunctions returned %s: %s", resp.Status, body)
+		}
+		var functions cloudFrontFunctionList
+		if err := xml.Unmarshal(body, &functions); err != nil {
+			return err
+		}
+		for _, function := range functions.Items {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				function.Name,
+				function.Name,
+				"aws_cloudfront_function",
+				"aws",
+				map[string]string{
+					"name": function.Name,
+					"arn":  function.FunctionMetadata.FunctionARN,
+				},
+				cloudFrontAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
+		if functions.NextMarker == "" {
+			return nil
+		}
+		marker = functions.NextMarker
+	}
+}
+
+// PostConvertHook links distributions to origin access identities, policies and functions
+// of their behaviors, Lambda@Edge functions are linked by lambda connection
+func (g *CloudFrontGenerator) PostConvertHook() error {
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type != "aws_cloudfront_distribution" {
+			continue
+		}
+		for _, related := range g.Resources {
+			switch related.InstanceInfo.Type {
+			case "aws_cloudfront_origin_access_identity":
+				terraformutils.WalkAndOverride("origin.s3_origin_config.origin_access_identity",
+					related.InstanceState.Attributes["cloudfront_access_identity_path"],
+					"${aws_cloudfront_origin_access_identity."+related.ResourceName+".cloudfront_access_identity_path}",
+					r.Item)
+			case "aws_cloudfront_cache_policy":
+				g.linkBehaviors("cache_policy_id", related, r.Item)
+			case "aws_cloudfront_origin_request_policy":
+				g.linkBehaviors("origin_request_policy_id", related, r.Item)
+			case "aws_cloudfront_function":
+				for _, behavior := range []string{"default_cache_behavior", "ordered_cache_behavior"} {
+					terraformutils.WalkAndOverride(behavior+".function_association.function_arn",
+						related.InstanceState.Attributes["arn"],
+						"${aws_cloudfront_function."+related.ResourceName+".arn}",
+						r.Item)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (g *CloudFrontGenerator) linkBehaviors(attribute string, policy terraformutils.Resource, item map[string]interface{}) {
+	for _, behavior := range []string{"default_cache_behavior", "ordered_cache_behavior"} {
+		terraformutils.WalkAndOverride(behavior+"."+attribute,
+			policy.InstanceState.ID,
+			"${"+policy.InstanceInfo.Type+"."+policy.ResourceName+".id}",
+			item)
+	}
+}
@@ -48,7 +48,10 @@ func (g *CloudTrailGenerator) InitResources() error {
 		return e
 	}
 	svc := cloudtrail.New(config)
-	output, err := svc.DescribeTrailsRequest(&cloudtrail.DescribeTrailsInput{}).Send(context.Background())
+	// multi-region trails are imported once, from their home region
+	output, err := svc.DescribeTrailsRequest(&cloudtrail.DescribeTrailsInput{
+		IncludeShadowTrails: aws.Bool(false),
+	}).Send(context.Background())
 	if err != nil {
 		return err
 	}
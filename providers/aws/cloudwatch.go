@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchevents"
 )
@@ -103,57 +104,152 @@ func (g *CloudWatchGenerator) createDashboards(cloudwatchSvc *cloudwatch.Client)
 	return nil
 }
 
-func (g *CloudWatchGenerator) createRules(cloudwatcheventsSvc *cloudwatchevents.Client) error {
-	var listRulesNextToken *string
+func (g *CloudWatchGenerator) createEventBuses(cloudwatcheventsSvc *cloudwatchevents.Client) ([]*string, error) {
+	// rules on the default bus are listed without bus name
+	eventBuses := []*string{nil}
+	var nextToken *string
 	for {
-		output, err := cloudwatcheventsSvc.ListRulesRequest(&cloudwatchevents.ListRulesInput{
-			NextToken: listRulesNextToken,
+		output, err := cloudwatcheventsSvc.ListEventBusesRequest(&cloudwatchevents.ListEventBusesInput{
+			NextToken: nextToken,
 		}).Send(context.Background())
 		if err != nil {
-			return err
+			return nil, err
 		}
-		for _, rule := range output.Rules {
+		for _, eventBus := range output.EventBuses {
+			if aws.StringValue(eventBus.Name) == "default" {
+				continue
+			}
 			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
-				*rule.Name,
-				*rule.Name,
-				"aws_cloudwatch_event_rule",
+				*eventBus.Name,
+				*eventBus.Name,
+				"aws_cloudwatch_event_bus",
 				"aws",
 				cloudwatchAllowEmptyValues))
+			eventBuses = append(eventBuses, eventBus.Name)
+		}
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return eventBuses, nil
+}
 
-			var listTargetsNextToken *string
-			for {
-				targetResponse, err := cloudwatcheventsSvc.ListTargetsByRuleRequest(&cloudwatchevents.ListTargetsByRuleInput{
-					Rule:      rule.Name,
-					NextToken: listTargetsNextToken,
-				}).Send(context.Background())
-				if err != nil {
-					return err
-				}
-				for _, target := range targetResponse.Targets {
-					targetRef := *rule.Name + "/" + *target.Id
-					g.Resources = append(g.Resources, terraformutils.NewResource(
-						targetRef,
-						targetRef,
-						"aws_cloudwatch_event_target",
-						"aws",
-						map[string]string{
+func (g *CloudWatchGenerator) createRules(cloudwatcheventsSvc *cloudwatchevents.Client) error {
+	eventBuses, err := g.createEventBuses(cloudwatcheventsSvc)
+	if err != nil {
+		return err
+	}
+	for _, eventBusName := range eventBuses {
+		// resources on custom buses are identified by bus name prefix
+		idPrefix := ""
+		if eventBusName != nil {
+			idPrefix = *eventBusName + "/"
+		}
+		var listRulesNextToken *string
+		for {
+			output, err := cloudwatcheventsSvc.ListRulesRequest(&cloudwatchevents.ListRulesInput{
+				EventBusName: eventBusName,
+				NextToken:    listRulesNextToken,
+			}).Send(context.Background())
+			if err != nil {
+				return err
+			}
+			for _, rule := range output.Rules {
+				ruleRef := idPrefix + *rule.Name
+				g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+					ruleRef,
+					ruleRef,
+					"aws_cloudwatch_event_rule",
+					"aws",
+					cloudwatchAllowEmptyValues))
+
+				var listTargetsNextToken *string
+				for {
+					targetResponse, err := cloudwatcheventsSvc.ListTargetsByRuleRequest(&cloudwatchevents.ListTargetsByRuleInput{
+						EventBusName: eventBusName,
+						Rule:         rule.Name,
+						NextToken:    listTargetsNextToken,
+					}).Send(context.Background())
+					if err != nil {
+						return err
+					}
+					for _, target := range targetResponse.Targets {
+						targetRef := ruleRef + "/" + *target.Id
+						attributes := map[string]string{
 							"rule":      *rule.Name,
 							"target_id": *target.Id,
-						},
-						cloudwatchAllowEmptyValues,
-						map[string]interface{}{}))
-				}
-				listTargetsNextToken = output.NextToken
-				if listTargetsNextToken == nil {
-					break
+						}
+						if eventBusName != nil {
+							attributes["event_bus_name"] = *eventBusName
+						}
+						g.Resources = append(g.Resources, terraformutils.NewResource(
+							targetRef,
+							targetRef,
+							"aws_cloudwatch_event_target",
+							"aws",
+							attributes,
+							cloudwatchAllowEmptyValues,
+							map[string]interface{}{}))
+					}
+					listTargetsNextToken = targetResponse.NextToken
+					if listTargetsNextToken == nil {
+						break
+					}
 				}
 			}
-		}
-		listRulesNextToken = output.NextToken
-		if listRulesNextToken == nil {
-			break
+			listRulesNextToken = output.NextToken
+			if listRulesNextToken == nil {
+				break
+			}
 		}
 	}
 
 	return nil
 }
+
+// PostConvertHook for add event patterns as heredoc, target inputs and input templates stay quoted strings
+// because heredoc adds trailing newline terraform would diff against
+func (g *CloudWatchGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		switch resource.InstanceInfo.Type {
+		case "aws_cloudwatch_event_rule":
+			if val, ok := resource.Item["event_pattern"]; ok {
+				g.Resources[i].Item["event_pattern"] = terraformutils.Heredoc("PATTERN", val.(string))
+			}
+			for _, bus := range g.Resources {
+				if bus.InstanceInfo.Type != "aws_cloudwatch_event_bus" {
+					continue
+				}
+				if resource.InstanceState.Attributes["event_bus_name"] == bus.InstanceState.ID {
+					g.Resources[i].Item["event_bus_name"] = "${aws_cloudwatch_event_bus." + bus.ResourceName + ".name}"
+				}
+			}
+		case "aws_cloudwatch_event_target":
+			if val, ok := resource.Item["input"]; ok {
+				g.Resources[i].Item["input"] = terraformutils.EscapeTemplate(val.(string))
+			}
+			if transformers, ok := resource.Item["input_transformer"].([]interface{}); ok {
+				for _, transformer := range transformers {
+					transformer, ok := transformer.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if template, ok := transformer["input_template"].(string); ok {
+						transformer["input_template"] = terraformutils.EscapeTemplate(template)
+					}
+				}
+			}
+			for _, rule := range g.Resources {
+				if rule.InstanceInfo.Type != "aws_cloudwatch_event_rule" {
+					continue
+				}
+				if resource.InstanceState.Attributes["rule"] == rule.InstanceState.Attributes["name"] &&
+					resource.InstanceState.Attributes["event_bus_name"] == rule.InstanceState.Attributes["event_bus_name"] {
+					g.Resources[i].Item["rule"] = "${aws_cloudwatch_event_rule." + rule.ResourceName + ".name}"
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -16,6 +16,9 @@ package aws
 
 import (
 	"context"
+	"log"
+	"regexp"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/aws/aws-sdk-go-v2/service/codebuild"
@@ -23,6 +26,9 @@ import (
 
 var codebuildAllowEmptyValues = []string{"tags."}
 
+// codebuildSecretEnvRegexp matches names of environment variables which usually carry credentials
+var codebuildSecretEnvRegexp = regexp.MustCompile(`(?i)(token|key|secret|password|passwd|credential)`)
+
 type CodeBuildGenerator struct {
 	AWSService
 }
@@ -30,12 +36,20 @@ type CodeBuildGenerator struct {
 func (g *CodeBuildGenerator) createResources(projectList []string) []terraformutils.Resource {
 	var resources []terraformutils.Resource
 	for _, project := range projectList {
-		resources = append(resources, terraformutils.NewSimpleResource(
+		resource := terraformutils.NewSimpleResource(
 			project,
 			project,
 			"aws_codebuild_project",
 			"aws",
-			codebuildAllowEmptyValues))
+			codebuildAllowEmptyValues)
+		// PARAMETER_STORE and SECRETS_MANAGER environment variables hold only references, PLAINTEXT ones
+		// looking like secrets are redacted in PostConvertHook,
+		// source credentials are never written
+		resource.IgnoreKeys = append(resource.IgnoreKeys,
+			"^source.[0-9]+.auth",
+			"^secondary_sources.[0-9]+.auth",
+		)
+		resources = append(resources, resource)
 	}
 	return resources
 }
@@ -46,10 +60,44 @@ func (g *CodeBuildGenerator) InitResources() error {
 		return e
 	}
 	svc := codebuild.New(config)
-	output, err := svc.ListProjectsRequest(&codebuild.ListProjectsInput{}).Send(context.Background())
-	if err != nil {
-		return err
+	p := codebuild.NewListProjectsPaginator(svc.ListProjectsRequest(&codebuild.ListProjectsInput{}))
+	for p.Next(context.Background()) {
+		g.Resources = append(g.Resources, g.createResources(p.CurrentPage().Projects)...)
+	}
+	return p.Err()
+}
+
+// PostConvertHook replaces values of PLAINTEXT environment variables which look like secrets by sensitive variables
+func (g *CodeBuildGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		environments, ok := r.Item["environment"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, environment := range environments {
+			environment, ok := environment.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			variables, _ := environment["environment_variable"].([]interface{})
+			for _, variable := range variables {
+				variable, ok := variable.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _ := variable["name"].(string)
+				if variableType, _ := variable["type"].(string); variableType != "" && variableType != "PLAINTEXT" {
+					continue
+				}
+				if !codebuildSecretEnvRegexp.MatchString(name) {
+					continue
+				}
+				log.Printf("codebuild: environment variable %s of project %s looks like a secret, replaced by variable", name, r.InstanceState.ID)
+				variable["value"] = g.Resources[i].AddSensitiveVariable(
+					strings.TrimPrefix(r.ResourceName, "tfer--")+"_"+strings.ToLower(name),
+					"Environment variable "+name+" of CodeBuild project "+r.InstanceState.ID)
+			}
+		}
 	}
-	g.Resources = g.createResources(output.Projects)
 	return nil
 }
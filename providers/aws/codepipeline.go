@@ -33,12 +33,15 @@ func (g *CodePipelineGenerator) loadPipelines(svc *codepipeline.Client) error {
 	for p.Next(context.Background()) {
 		for _, pipeline := range p.CurrentPage().Pipelines {
 			resourceName := aws.StringValue(pipeline.Name)
-			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			resource := terraformutils.NewSimpleResource(
 				resourceName,
 				resourceName,
 				"aws_codepipeline",
 				"aws",
-				codepipelineAllowEmptyValues))
+				codepipelineAllowEmptyValues)
+			// GitHub source actions keep OAuth token in action configuration
+			resource.IgnoreKeys = append(resource.IgnoreKeys, "^stage.[0-9]+.action.[0-9]+.configuration.OAuthToken$")
+			g.Resources = append(g.Resources, resource)
 		}
 	}
 	return p.Err()
@@ -49,12 +52,14 @@ func (g *CodePipelineGenerator) loadWebhooks(svc *codepipeline.Client) error {
 	for p.Next(context.Background()) {
 		for _, webhook := range p.CurrentPage().Webhooks {
 			resourceArn := aws.StringValue(webhook.Arn)
-			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			resource := terraformutils.NewSimpleResource(
 				resourceArn,
 				resourceArn,
 				"aws_codepipeline_webhook",
 				"aws",
-				codepipelineAllowEmptyValues))
+				codepipelineAllowEmptyValues)
+			resource.IgnoreKeys = append(resource.IgnoreKeys, "^authentication_configuration.[0-9]+.secret_token$")
+			g.Resources = append(g.Resources, resource)
 		}
 	}
 	return p.Err()
@@ -76,3 +81,20 @@ func (g *CodePipelineGenerator) InitResources() error {
 
 	return nil
 }
+
+func (g *CodePipelineGenerator) PostConvertHook() error {
+	for i, webhook := range g.Resources {
+		if webhook.InstanceInfo.Type != "aws_codepipeline_webhook" {
+			continue
+		}
+		for _, pipeline := range g.Resources {
+			if pipeline.InstanceInfo.Type != "aws_codepipeline" {
+				continue
+			}
+			if pipeline.InstanceState.ID == webhook.InstanceState.Attributes["target_pipeline"] {
+				g.Resources[i].Item["target_pipeline"] = "${aws_codepipeline." + pipeline.ResourceName + ".name}"
+			}
+		}
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ package aws
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/aws/aws-sdk-go-v2/service/configservice"
@@ -126,3 +127,17 @@ func (g *ConfigGenerator) addDeliveryChannels(svc *configservice.Client, configu
 	}
 	return nil
 }
+
+func (g *ConfigGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		if resource.InstanceInfo.Type != "aws_config_config_rule" {
+			continue
+		}
+		if inputParameters, ok := resource.Item["input_parameters"].(string); ok && inputParameters != "" {
+			g.Resources[i].Item["input_parameters"] = fmt.Sprintf(`<<PARAMETERS
+%s
+PARAMETERS`, g.escapeAwsInterpolation(inputParameters))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+var dedicatedHostAllowEmptyValues = []string{"tags."}
+
+type DedicatedHostGenerator struct {
+	AWSService
+}
+
+func (g *DedicatedHostGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := ec2.New(config)
+	p := ec2.NewDescribeHostsPaginator(svc.DescribeHostsRequest(&ec2.DescribeHostsInput{}))
+	for p.Next(context.Background()) {
+		for _, host := range p.CurrentPage().Hosts {
+			if host.State == ec2.AllocationStateReleased || host.State == ec2.AllocationStateReleasedPermanentFailure {
+				continue
+			}
+			id := aws.StringValue(host.HostId)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				id,
+				id,
+				"aws_ec2_host",
+				"aws",
+				dedicatedHostAllowEmptyValues))
+		}
+	}
+	return p.Err()
+}
@@ -0,0 +1,208 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+)
+
+var directConnectAllowEmptyValues = []string{"tags."}
+
+type DirectConnectGenerator struct {
+	AWSService
+}
+
+func (g *DirectConnectGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := directconnect.New(config)
+	account, err := g.getAccountNumber(config)
+	if err != nil {
+		return err
+	}
+
+	if err := g.loadConnections(svc, aws.StringValue(account)); err != nil {
+		return err
+	}
+	if err := g.loadVirtualInterfaces(svc, aws.StringValue(account)); err != nil {
+		return err
+	}
+	return g.loadGateways(svc)
+}
+
+func (g *DirectConnectGenerator) loadConnections(svc *directconnect.Client, account string) error {
+	connections, err := svc.DescribeConnectionsRequest(&directconnect.DescribeConnectionsInput{}).Send(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, connection := range connections.Connections {
+		id := aws.StringValue(connection.ConnectionId)
+		switch connection.ConnectionState {
+		case directconnect.ConnectionStateDeleting, directconnect.ConnectionStateDeleted, directconnect.ConnectionStateRejected:
+			continue
+		}
+		// hosted connections are provisioned by a partner and can't be managed from this account
+		if aws.StringValue(connection.OwnerAccount) != account || aws.StringValue(connection.PartnerName) != "" {
+			log.Printf("skipping hosted Direct Connect connection %s, it is managed by %s", id, aws.StringValue(connection.PartnerName))
+			continue
+		}
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			id,
+			aws.StringValue(connection.ConnectionName)+"_"+id,
+			"aws_dx_connection",
+			"aws",
+			directConnectAllowEmptyValues,
+		))
+	}
+	return nil
+}
+
+func (g *DirectConnectGenerator) loadVirtualInterfaces(svc *directconnect.Client, account string) error {
+	virtualInterfaces, err := svc.DescribeVirtualInterfacesRequest(&directconnect.DescribeVirtualInterfacesInput{}).Send(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, virtualInterface := range virtualInterfaces.VirtualInterfaces {
+		id := aws.StringValue(virtualInterface.VirtualInterfaceId)
+		switch virtualInterface.VirtualInterfaceState {
+		case directconnect.VirtualInterfaceStateDeleting, directconnect.VirtualInterfaceStateDeleted, directconnect.VirtualInterfaceStateRejected:
+			continue
+		}
+		if aws.StringValue(virtualInterface.OwnerAccount) != account {
+			log.Printf("skipping hosted Direct Connect virtual interface %s, it belongs to account %s", id, aws.StringValue(virtualInterface.OwnerAccount))
+			continue
+		}
+		var resourceType string
+		switch aws.StringValue(virtualInterface.VirtualInterfaceType) {
+		case "private":
+			resourceType = "aws_dx_private_virtual_interface"
+		case "public":
+			resourceType = "aws_dx_public_virtual_interface"
+		case "transit":
+			resourceType = "aws_dx_transit_virtual_interface"
+		default:
+			continue
+		}
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			id,
+			aws.StringValue(virtualInterface.VirtualInterfaceName)+"_"+id,
+			resourceType,
+			"aws",
+			directConnectAllowEmptyValues,
+		))
+	}
+	return nil
+}
+
+func (g *DirectConnectGenerator) loadGateways(svc *directconnect.Client) error {
+	var nextToken *string
+	for {
+		gateways, err := svc.DescribeDirectConnectGatewaysRequest(&directconnect.DescribeDirectConnectGatewaysInput{
+			NextToken: nextToken,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, gateway := range gateways.DirectConnectGateways {
+			if gateway.DirectConnectGatewayState != directconnect.DirectConnectGatewayStateAvailable {
+				continue
+			}
+			id := aws.StringValue(gateway.DirectConnectGatewayId)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				id,
+				aws.StringValue(gateway.DirectConnectGatewayName)+"_"+id,
+				"aws_dx_gateway",
+				"aws",
+				directConnectAllowEmptyValues,
+			))
+			if err := g.loadGatewayAssociations(svc, gateway.DirectConnectGatewayId); err != nil {
+				return err
+			}
+		}
+		nextToken = gateways.NextToken
+		if nextToken == nil {
+			return nil
+		}
+	}
+}
+
+func (g *DirectConnectGenerator) loadGatewayAssociations(svc *directconnect.Client, gatewayID *string) error {
+	var nextToken *string
+	for {
+		associations, err := svc.DescribeDirectConnectGatewayAssociationsRequest(&directconnect.DescribeDirectConnectGatewayAssociationsInput{
+			DirectConnectGatewayId: gatewayID,
+			NextToken:              nextToken,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, association := range associations.DirectConnectGatewayAssociations {
+			if association.AssociatedGateway == nil || association.AssociationState != directconnect.DirectConnectGatewayAssociationStateAssociated {
+				continue
+			}
+			associatedGatewayID := aws.StringValue(association.AssociatedGateway.Id)
+			// same id format as terraform uses after importing dx_gateway_id/associated_gateway_id
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				"ga-"+aws.StringValue(gatewayID)+associatedGatewayID,
+				aws.StringValue(gatewayID)+"_"+associatedGatewayID,
+				"aws_dx_gateway_association",
+				"aws",
+				map[string]string{
+					"dx_gateway_id":         aws.StringValue(gatewayID),
+					"associated_gateway_id": associatedGatewayID,
+				},
+				directConnectAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
+		nextToken = associations.NextToken
+		if nextToken == nil {
+			return nil
+		}
+	}
+}
+
+// PostConvertHook links virtual interfaces and associations to connections and gateways
+func (g *DirectConnectGenerator) PostConvertHook() error {
+	references := map[string]string{}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_dx_connection", "aws_dx_gateway":
+			references[r.InstanceState.ID] = "${" + r.InstanceInfo.Type + "." + r.ResourceName + ".id}"
+		}
+	}
+	for i, r := range g.Resources {
+		for _, key := range []string{"connection_id", "dx_gateway_id"} {
+			if reference, ok := references[r.InstanceState.Attributes[key]]; ok {
+				r.Item[key] = reference
+			}
+		}
+		// BGP authentication key is a secret
+		if _, ok := r.Item["bgp_auth_key"]; ok {
+			r.Item["bgp_auth_key"] = g.Resources[i].AddSensitiveVariable(
+				strings.TrimPrefix(r.ResourceName, "tfer--")+"_bgp_auth_key",
+				"BGP authentication key of virtual interface "+r.InstanceState.ID)
+		}
+	}
+	return nil
+}
@@ -59,3 +59,25 @@ func (g *EcrGenerator) InitResources() error {
 	}
 	return p.Err()
 }
+
+// PostConvertHook for add repository and lifecycle policies json as heredoc
+func (g *EcrGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		if resource.InstanceInfo.Type != "aws_ecr_repository_policy" && resource.InstanceInfo.Type != "aws_ecr_lifecycle_policy" {
+			continue
+		}
+		if policy, ok := resource.Item["policy"].(string); ok {
+			// cross-account principals are kept verbatim, only template sequences are escaped
+			g.Resources[i].Item["policy"] = terraformutils.Heredoc("POLICY", policy)
+		}
+		for _, repository := range g.Resources {
+			if repository.InstanceInfo.Type != "aws_ecr_repository" {
+				continue
+			}
+			if resource.InstanceState.Attributes["repository"] == repository.InstanceState.ID {
+				g.Resources[i].Item["repository"] = "${aws_ecr_repository." + repository.ResourceName + ".name}"
+			}
+		}
+	}
+	return nil
+}
@@ -41,9 +41,6 @@ func (g *EfsGenerator) InitResources() error {
 	if err := g.loadAccessPoint(svc); err != nil {
 		return err
 	}
-	if err := g.loadMountTarget(svc); err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -58,20 +55,8 @@ func (g *EfsGenerator) loadFileSystem(svc *efs.Client) error {
 				"aws",
 				efsAllowEmptyValues))
 
-			targetsResponse, err := svc.DescribeMountTargetsRequest(&efs.DescribeMountTargetsInput{
-				FileSystemId: fileSystem.FileSystemId,
-			}).Send(context.Background())
-			if err != nil {
+			if err := g.loadMountTarget(svc, fileSystem.FileSystemId); err != nil {
 				fmt.Println(err.Error())
-				continue
-			}
-			for _, mountTarget := range targetsResponse.MountTargets {
-				g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
-					aws.StringValue(mountTarget.MountTargetId),
-					aws.StringValue(mountTarget.MountTargetId),
-					"aws_efs_mount_target",
-					"aws",
-					efsAllowEmptyValues))
 			}
 
 			policyResponse, err := svc.DescribeFileSystemPolicyRequest(&efs.DescribeFileSystemPolicyInput{
@@ -100,20 +85,30 @@ POLICY`, escapedPolicy),
 	return p.Err()
 }
 
-func (g *EfsGenerator) loadMountTarget(svc *efs.Client) error {
-	p := efs.NewDescribeFileSystemsPaginator(svc.DescribeFileSystemsRequest(&efs.DescribeFileSystemsInput{}))
-	for p.Next(context.Background()) {
-		for _, fileSystem := range p.CurrentPage().FileSystems {
-			id := aws.StringValue(fileSystem.FileSystemId)
+func (g *EfsGenerator) loadMountTarget(svc *efs.Client, fileSystemID *string) error {
+	var marker *string
+	for {
+		targetsResponse, err := svc.DescribeMountTargetsRequest(&efs.DescribeMountTargetsInput{
+			FileSystemId: fileSystemID,
+			Marker:       marker,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, mountTarget := range targetsResponse.MountTargets {
+			id := aws.StringValue(mountTarget.MountTargetId)
 			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
 				id,
 				id,
-				"aws_efs_file_system",
+				"aws_efs_mount_target",
 				"aws",
 				efsAllowEmptyValues))
 		}
+		marker = targetsResponse.NextMarker
+		if marker == nil {
+			return nil
+		}
 	}
-	return p.Err()
 }
 
 func (g *EfsGenerator) loadAccessPoint(svc *efs.Client) error {
@@ -131,3 +126,25 @@ func (g *EfsGenerator) loadAccessPoint(svc *efs.Client) error {
 	}
 	return p.Err()
 }
+
+func (g *EfsGenerator) PostConvertHook() error {
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_efs_file_system":
+			// provisioned throughput is only accepted in provisioned mode
+			if r.InstanceState.Attributes["throughput_mode"] != "provisioned" {
+				delete(r.Item, "provisioned_throughput_in_mibps")
+			}
+		case "aws_efs_mount_target", "aws_efs_access_point", "aws_efs_file_system_policy":
+			for _, fileSystem := range g.Resources {
+				if fileSystem.InstanceInfo.Type != "aws_efs_file_system" {
+					continue
+				}
+				if fileSystem.InstanceState.ID == r.InstanceState.Attributes["file_system_id"] {
+					r.Item["file_system_id"] = "${aws_efs_file_system." + fileSystem.ResourceName + ".id}"
+				}
+			}
+		}
+	}
+	return nil
+}
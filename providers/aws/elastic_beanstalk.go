@@ -16,8 +16,10 @@ package aws
 
 import (
 	"context"
+	"sort"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
 )
 
@@ -25,6 +27,8 @@ var beanstalkAllowEmptyValues = []string{"tags."}
 
 type BeanstalkGenerator struct {
 	AWSService
+	// settings holds option settings of each environment keyed by environment id
+	settings map[string][]elasticbeanstalk.ConfigurationOptionSetting
 }
 
 func (g *BeanstalkGenerator) InitResources() error {
@@ -61,19 +65,125 @@ func (g *BeanstalkGenerator) addApplications(client *elasticbeanstalk.Client) er
 }
 
 func (g *BeanstalkGenerator) addEnvironments(client *elasticbeanstalk.Client) error {
-	request := client.DescribeEnvironmentsRequest(&elasticbeanstalk.DescribeEnvironmentsInput{})
-	response, err := request.Send(context.Background())
+	g.settings = map[string][]elasticbeanstalk.ConfigurationOptionSetting{}
+	defaults := map[string]map[string]string{}
+	var nextToken *string
+	for {
+		response, err := client.DescribeEnvironmentsRequest(&elasticbeanstalk.DescribeEnvironmentsInput{
+			NextToken: nextToken,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, environment := range response.Environments {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				*environment.EnvironmentId,
+				*environment.EnvironmentName,
+				"aws_elastic_beanstalk_environment",
+				"aws",
+				beanstalkAllowEmptyValues,
+			))
+			settings, err := g.environmentSettings(client, environment, defaults)
+			if err != nil {
+				return err
+			}
+			g.settings[*environment.EnvironmentId] = settings
+		}
+		nextToken = response.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return nil
+}
+
+// environmentSettings returns option settings of environment, without full_settings
+// only the ones differing from defaults of its solution stack or platform are kept
+func (g *BeanstalkGenerator) environmentSettings(client *elasticbeanstalk.Client, environment elasticbeanstalk.EnvironmentDescription,
+	defaults map[string]map[string]string) ([]elasticbeanstalk.ConfigurationOptionSetting, error) {
+	response, err := client.DescribeConfigurationSettingsRequest(&elasticbeanstalk.DescribeConfigurationSettingsInput{
+		ApplicationName: environment.ApplicationName,
+		EnvironmentName: environment.EnvironmentName,
+	}).Send(context.Background())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	for _, environment := range response.Environments {
-		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
-			*environment.EnvironmentId,
-			*environment.EnvironmentName,
-			"aws_elastic_beanstalk_environment",
-			"aws",
-			beanstalkAllowEmptyValues,
-		))
+	var settings []elasticbeanstalk.ConfigurationOptionSetting
+	for _, configuration := range response.ConfigurationSettings {
+		settings = append(settings, configuration.OptionSettings...)
+	}
+	if fullSettings, _ := g.Args["full_settings"].(bool); fullSettings {
+		return settings, nil
+	}
+
+	stack := aws.StringValue(environment.PlatformArn) + aws.StringValue(environment.SolutionStackName)
+	if _, ok := defaults[stack]; !ok {
+		input := &elasticbeanstalk.DescribeConfigurationOptionsInput{}
+		if environment.PlatformArn != nil {
+			input.PlatformArn = environment.PlatformArn
+		} else {
+			input.SolutionStackName = environment.SolutionStackName
+		}
+		options, err := client.DescribeConfigurationOptionsRequest(input).Send(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		defaults[stack] = map[string]string{}
+		for _, option := range options.Options {
+			defaults[stack][aws.StringValue(option.Namespace)+":"+aws.StringValue(option.Name)] = aws.StringValue(option.DefaultValue)
+		}
+	}
+
+	var changed []elasticbeanstalk.ConfigurationOptionSetting
+	for _, setting := range settings {
+		defaultValue, hasDefault := defaults[stack][aws.StringValue(setting.Namespace)+":"+aws.StringValue(setting.OptionName)]
+		if hasDefault && defaultValue == aws.StringValue(setting.Value) {
+			continue
+		}
+		changed = append(changed, setting)
+	}
+	return changed, nil
+}
+
+// PostConvertHook writes option settings as setting blocks and links environments to applications
+func (g *BeanstalkGenerator) PostConvertHook() error {
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type != "aws_elastic_beanstalk_environment" {
+			continue
+		}
+		for _, application := range g.Resources {
+			if application.InstanceInfo.Type == "aws_elastic_beanstalk_application" &&
+				application.InstanceState.ID == r.InstanceState.Attributes["application"] {
+				r.Item["application"] = "${aws_elastic_beanstalk_application." + application.ResourceName + ".name}"
+			}
+		}
+
+		settings := g.settings[r.InstanceState.ID]
+		if len(settings) == 0 {
+			continue
+		}
+		sort.Slice(settings, func(i, j int) bool {
+			if aws.StringValue(settings[i].Namespace) != aws.StringValue(settings[j].Namespace) {
+				return aws.StringValue(settings[i].Namespace) < aws.StringValue(settings[j].Namespace)
+			}
+			if aws.StringValue(settings[i].OptionName) != aws.StringValue(settings[j].OptionName) {
+				return aws.StringValue(settings[i].OptionName) < aws.StringValue(settings[j].OptionName)
+			}
+			return aws.StringValue(settings[i].ResourceName) < aws.StringValue(settings[j].ResourceName)
+		})
+		var blocks []interface{}
+		for _, setting := range settings {
+			block := map[string]interface{}{
+				"namespace": aws.StringValue(setting.Namespace),
+				"name":      aws.StringValue(setting.OptionName),
+				"value":     aws.StringValue(setting.Value),
+			}
+			if setting.ResourceName != nil {
+				block["resource"] = aws.StringValue(setting.ResourceName)
+			}
+			blocks = append(blocks, block)
+		}
+		r.Item["setting"] = blocks
 	}
 	return nil
 }
@@ -16,6 +16,7 @@ package aws
 
 import (
 	"context"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -41,34 +42,74 @@ func (g *EsGenerator) InitResources() error {
 		return err
 	}
 
+	var names []string
 	for _, domainName := range domainNames.DomainNames {
-		g.Resources = append(g.Resources, terraformutils.NewResource(
-			aws.StringValue(domainName.DomainName),
-			aws.StringValue(domainName.DomainName),
-			"aws_elasticsearch_domain",
-			"aws",
-			map[string]string{
-				"domain_name": aws.StringValue(domainName.DomainName),
-			},
-			esAllowEmptyValues,
-			map[string]interface{}{},
-		))
+		names = append(names, aws.StringValue(domainName.DomainName))
+	}
+	// DescribeElasticsearchDomains accepts at most 5 domain names per call
+	for len(names) > 0 {
+		batch := names
+		if len(batch) > 5 {
+			batch = batch[:5]
+		}
+		names = names[len(batch):]
+		domains, err := svc.DescribeElasticsearchDomainsRequest(&es.DescribeElasticsearchDomainsInput{
+			DomainNames: batch,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, domain := range domains.DomainStatusList {
+			resourceType := "aws_elasticsearch_domain"
+			if strings.HasPrefix(aws.StringValue(domain.ElasticsearchVersion), "OpenSearch_") {
+				resourceType = "aws_opensearch_domain"
+			}
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				aws.StringValue(domain.DomainName),
+				aws.StringValue(domain.DomainName),
+				resourceType,
+				"aws",
+				map[string]string{
+					"domain_name": aws.StringValue(domain.DomainName),
+				},
+				esAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
 	}
 
 	return nil
 }
 
 func (g *EsGenerator) PostConvertHook() error {
-	for _, r := range g.Resources {
-		if r.InstanceInfo.Type != "aws_elasticsearch_domain" {
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type != "aws_elasticsearch_domain" && r.InstanceInfo.Type != "aws_opensearch_domain" {
 			continue
 		}
+		if policy, ok := r.Item["access_policies"].(string); ok && policy != "" {
+			r.Item["access_policies"] = terraformutils.Heredoc("POLICY", policy)
+		}
 		if r.InstanceState.Attributes["cognito_options.0.enabled"] == "false" {
 			delete(r.Item, "cognito_options")
 		}
 		if r.InstanceState.Attributes["cluster_config.0.warm_count"] == "0" {
 			delete(r.Item["cluster_config"].([]interface{})[0].(map[string]interface{}), "warm_count")
 		}
+		if r.InstanceState.Attributes["advanced_security_options.0.internal_user_database_enabled"] == "true" {
+			// master user credentials can't be read back, they have to be set through variables
+			name := strings.TrimPrefix(r.ResourceName, "tfer--")
+			r.Item["advanced_security_options"].([]interface{})[0].(map[string]interface{})["master_user_options"] = []interface{}{
+				map[string]interface{}{
+					"master_user_name": g.Resources[i].AddVariable(terraformutils.Variable{
+						Name:        name + "_master_user_name",
+						Description: "Master user name of domain " + r.InstanceState.ID,
+					}),
+					"master_user_password": g.Resources[i].AddSensitiveVariable(
+						name+"_master_user_password",
+						"Master user password of domain "+r.InstanceState.ID),
+				},
+			}
+		}
 	}
 	return nil
 }
@@ -49,15 +49,19 @@ func (g *FirehoseGenerator) InitResources() error {
 	}
 	svc := firehose.New(config)
 	var streamNames []string
+	var lastStreamName *string
 	for {
-		output, err := svc.ListDeliveryStreamsRequest(&firehose.ListDeliveryStreamsInput{}).Send(context.Background())
+		output, err := svc.ListDeliveryStreamsRequest(&firehose.ListDeliveryStreamsInput{
+			ExclusiveStartDeliveryStreamName: lastStreamName,
+		}).Send(context.Background())
 		if err != nil {
 			return err
 		}
 		streamNames = append(streamNames, output.DeliveryStreamNames...)
-		if !*output.HasMoreDeliveryStreams {
+		if !*output.HasMoreDeliveryStreams || len(output.DeliveryStreamNames) == 0 {
 			break
 		}
+		lastStreamName = &output.DeliveryStreamNames[len(output.DeliveryStreamNames)-1]
 	}
 
 	g.Resources = g.createResources(streamNames)
@@ -0,0 +1,163 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+)
+
+var globalAcceleratorAllowEmptyValues = []string{"tags."}
+
+// GlobalAcceleratorGenerator imports accelerators with their listeners and endpoint groups,
+// Global Accelerator API is only available through us-west-2 endpoint
+type GlobalAcceleratorGenerator struct {
+	AWSService
+}
+
+func (g *GlobalAcceleratorGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	config.Region = "us-west-2"
+	svc := globalaccelerator.New(config)
+
+	var nextToken *string
+	for {
+		accelerators, err := svc.ListAcceleratorsRequest(&globalaccelerator.ListAcceleratorsInput{
+			NextToken: nextToken,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, accelerator := range accelerators.Accelerators {
+			acceleratorArn := aws.StringValue(accelerator.AcceleratorArn)
+			acceleratorName := aws.StringValue(accelerator.Name)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				acceleratorArn,
+				acceleratorName,
+				"aws_globalaccelerator_accelerator",
+				"aws",
+				globalAcceleratorAllowEmptyValues,
+			))
+			if err := g.loadListeners(svc, acceleratorArn, acceleratorName); err != nil {
+				return err
+			}
+		}
+		nextToken = accelerators.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return nil
+}
+
+func (g *GlobalAcceleratorGenerator) loadListeners(svc *globalaccelerator.Client, acceleratorArn, acceleratorName string) error {
+	var nextToken *string
+	for {
+		listeners, err := svc.ListListenersRequest(&globalaccelerator.ListListenersInput{
+			AcceleratorArn: aws.String(acceleratorArn),
+			NextToken:      nextToken,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, listener := range listeners.Listeners {
+			listenerArn := aws.StringValue(listener.ListenerArn)
+			listenerName := acceleratorName + "_" + string(listener.Protocol)
+			if len(listener.PortRanges) > 0 {
+				listenerName += "_" + strconv.FormatInt(aws.Int64Value(listener.PortRanges[0].FromPort), 10)
+			}
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				listenerArn,
+				listenerName,
+				"aws_globalaccelerator_listener",
+				"aws",
+				map[string]string{
+					"accelerator_arn": acceleratorArn,
+				},
+				globalAcceleratorAllowEmptyValues,
+				map[string]interface{}{},
+			))
+			if err := g.loadEndpointGroups(svc, listenerArn, listenerName); err != nil {
+				return err
+			}
+		}
+		nextToken = listeners.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return nil
+}
+
+func (g *GlobalAcceleratorGenerator) loadEndpointGroups(svc *globalaccelerator.Client, listenerArn, listenerName string) error {
+	var nextToken *string
+	for {
+		endpointGroups, err := svc.ListEndpointGroupsRequest(&globalaccelerator.ListEndpointGroupsInput{
+			ListenerArn: aws.String(listenerArn),
+			NextToken:   nextToken,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, endpointGroup := range endpointGroups.EndpointGroups {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				aws.StringValue(endpointGroup.EndpointGroupArn),
+				listenerName+"_"+aws.StringValue(endpointGroup.EndpointGroupRegion),
+				"aws_globalaccelerator_endpoint_group",
+				"aws",
+				map[string]string{
+					"listener_arn": listenerArn,
+				},
+				globalAcceleratorAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
+		nextToken = endpointGroups.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links listeners to accelerators and endpoint groups to listeners,
+// endpoints are linked to load balancers, instances and Elastic IPs by resource connections
+func (g *GlobalAcceleratorGenerator) PostConvertHook() error {
+	names := map[string]string{}
+	for _, r := range g.Resources {
+		names[r.InstanceState.ID] = r.ResourceName
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_globalaccelerator_listener":
+			if resourceName, exist := names[r.InstanceState.Attributes["accelerator_arn"]]; exist {
+				g.Resources[i].Item["accelerator_arn"] = "${aws_globalaccelerator_accelerator." + resourceName + ".id}"
+			}
+		case "aws_globalaccelerator_endpoint_group":
+			if resourceName, exist := names[r.InstanceState.Attributes["listener_arn"]]; exist {
+				g.Resources[i].Item["listener_arn"] = "${aws_globalaccelerator_listener." + resourceName + ".id}"
+			}
+		}
+	}
+	return nil
+}
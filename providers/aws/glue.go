@@ -80,6 +80,21 @@ func (g *GlueGenerator) loadGlueCatalogTable(svc *glue.Client, account *string,
 	return p.Err()
 }
 
+func (g *GlueGenerator) loadGlueJobs(svc *glue.Client) error {
+	var GlueJobAllowEmptyValues = []string{"tags."}
+	p := glue.NewGetJobsPaginator(svc.GetJobsRequest(&glue.GetJobsInput{}))
+	for p.Next(context.Background()) {
+		for _, job := range p.CurrentPage().Jobs {
+			resource := terraformutils.NewSimpleResource(*job.Name, *job.Name,
+				"aws_glue_job",
+				"aws",
+				GlueJobAllowEmptyValues)
+			g.Resources = append(g.Resources, resource)
+		}
+	}
+	return p.Err()
+}
+
 // Generate TerraformResources from AWS API,
 // from each database create 1 TerraformResource.
 // Need only database name as ID for terraform resource
@@ -99,6 +114,9 @@ func (g *GlueGenerator) InitResources() error {
 	if err := g.loadGlueCrawlers(svc); err != nil {
 		return err
 	}
+	if err := g.loadGlueJobs(svc); err != nil {
+		return err
+	}
 	var DatabaseNames []*string
 	if DatabaseNames, err = g.loadGlueCatalogDatabase(svc, account); err != nil {
 		return err
@@ -111,3 +129,22 @@ func (g *GlueGenerator) InitResources() error {
 
 	return nil
 }
+
+// PostConvertHook links tables and crawler targets to catalog databases
+func (g *GlueGenerator) PostConvertHook() error {
+	databases := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "aws_glue_catalog_database" {
+			databases[r.InstanceState.Attributes["name"]] = r.ResourceName
+		}
+	}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_glue_catalog_table", "aws_glue_crawler":
+			if name, ok := databases[r.InstanceState.Attributes["database_name"]]; ok {
+				r.Item["database_name"] = "${aws_glue_catalog_database." + name + ".name}"
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+)
+
+var guarddutyAllowEmptyValues = []string{"tags."}
+
+type GuardDutyGenerator struct {
+	AWSService
+}
+
+func (g *GuardDutyGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := guardduty.New(config)
+	p := guardduty.NewListDetectorsPaginator(svc.ListDetectorsRequest(&guardduty.ListDetectorsInput{}))
+	for p.Next(context.Background()) {
+		for _, detectorID := range p.CurrentPage().DetectorIds {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				detectorID,
+				detectorID,
+				"aws_guardduty_detector",
+				"aws",
+				guarddutyAllowEmptyValues))
+			if err := g.loadMembers(svc, detectorID); err != nil {
+				return err
+			}
+			if err := g.loadInviteAccepter(svc, detectorID); err != nil {
+				return err
+			}
+		}
+	}
+	return p.Err()
+}
+
+// loadMembers imports member accounts, they are only listed in the administrator account
+func (g *GuardDutyGenerator) loadMembers(svc *guardduty.Client, detectorID string) error {
+	p := guardduty.NewListMembersPaginator(svc.ListMembersRequest(&guardduty.ListMembersInput{
+		DetectorId:     aws.String(detectorID),
+		OnlyAssociated: aws.String("false"),
+	}))
+	for p.Next(context.Background()) {
+		for _, member := range p.CurrentPage().Members {
+			accountID := aws.StringValue(member.AccountId)
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				detectorID+":"+accountID,
+				detectorID+"_"+accountID,
+				"aws_guardduty_member",
+				"aws",
+				map[string]string{
+					"detector_id": detectorID,
+					"account_id":  accountID,
+					"email":       aws.StringValue(member.Email),
+				},
+				guarddutyAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
+	}
+	return p.Err()
+}
+
+// loadInviteAccepter imports the accepted invitation of a member account
+func (g *GuardDutyGenerator) loadInviteAccepter(svc *guardduty.Client, detectorID string) error {
+	output, err := svc.GetMasterAccountRequest(&guardduty.GetMasterAccountInput{
+		DetectorId: aws.String(detectorID),
+	}).Send(context.Background())
+	if err != nil {
+		return err
+	}
+	if output.Master == nil || aws.StringValue(output.Master.AccountId) == "" {
+		return nil
+	}
+	g.Resources = append(g.Resources, terraformutils.NewResource(
+		detectorID,
+		detectorID,
+		"aws_guardduty_invite_accepter",
+		"aws",
+		map[string]string{
+			"detector_id":       detectorID,
+			"master_account_id": aws.StringValue(output.Master.AccountId),
+		},
+		guarddutyAllowEmptyValues,
+		map[string]interface{}{},
+	))
+	return nil
+}
+
+func (g *GuardDutyGenerator) PostConvertHook() error {
+	detectors := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "aws_guardduty_detector" {
+			detectors[r.InstanceState.ID] = "${aws_guardduty_detector." + r.ResourceName + ".id}"
+		}
+	}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "aws_guardduty_detector" {
+			continue
+		}
+		if reference, ok := detectors[r.InstanceState.Attributes["detector_id"]]; ok {
+			r.Item["detector_id"] = reference
+		}
+	}
+	return nil
+}
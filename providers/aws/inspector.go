@@ -0,0 +1,147 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector"
+)
+
+// inspectorDescribeLimit is the maximum number of ARNs accepted by Inspector describe calls
+const inspectorDescribeLimit = 10
+
+var inspectorAllowEmptyValues = []string{"tags."}
+
+type InspectorGenerator struct {
+	AWSService
+}
+
+func (g *InspectorGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := inspector.New(config)
+	if err := g.loadAssessmentTargets(svc); err != nil {
+		return err
+	}
+	return g.loadAssessmentTemplates(svc)
+}
+
+func (g *InspectorGenerator) loadAssessmentTargets(svc *inspector.Client) error {
+	var arns []string
+	p := inspector.NewListAssessmentTargetsPaginator(svc.ListAssessmentTargetsRequest(&inspector.ListAssessmentTargetsInput{}))
+	for p.Next(context.Background()) {
+		arns = append(arns, p.CurrentPage().AssessmentTargetArns...)
+	}
+	if err := p.Err(); err != nil {
+		return err
+	}
+	resourceGroups := map[string]bool{}
+	for start := 0; start < len(arns); start += inspectorDescribeLimit {
+		end := start + inspectorDescribeLimit
+		if end > len(arns) {
+			end = len(arns)
+		}
+		output, err := svc.DescribeAssessmentTargetsRequest(&inspector.DescribeAssessmentTargetsInput{
+			AssessmentTargetArns: arns[start:end],
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, target := range output.AssessmentTargets {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				aws.StringValue(target.Arn),
+				aws.StringValue(target.Name),
+				"aws_inspector_assessment_target",
+				"aws",
+				inspectorAllowEmptyValues))
+			// targets without resource group cover all instances of the account
+			resourceGroupArn := aws.StringValue(target.ResourceGroupArn)
+			if resourceGroupArn == "" || resourceGroups[resourceGroupArn] {
+				continue
+			}
+			resourceGroups[resourceGroupArn] = true
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				resourceGroupArn,
+				aws.StringValue(target.Name),
+				"aws_inspector_resource_group",
+				"aws",
+				inspectorAllowEmptyValues))
+		}
+	}
+	return nil
+}
+
+func (g *InspectorGenerator) loadAssessmentTemplates(svc *inspector.Client) error {
+	var arns []string
+	p := inspector.NewListAssessmentTemplatesPaginator(svc.ListAssessmentTemplatesRequest(&inspector.ListAssessmentTemplatesInput{}))
+	for p.Next(context.Background()) {
+		arns = append(arns, p.CurrentPage().AssessmentTemplateArns...)
+	}
+	if err := p.Err(); err != nil {
+		return err
+	}
+	for start := 0; start < len(arns); start += inspectorDescribeLimit {
+		end := start + inspectorDescribeLimit
+		if end > len(arns) {
+			end = len(arns)
+		}
+		output, err := svc.DescribeAssessmentTemplatesRequest(&inspector.DescribeAssessmentTemplatesInput{
+			AssessmentTemplateArns: arns[start:end],
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, template := range output.AssessmentTemplates {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				aws.StringValue(template.Arn),
+				aws.StringValue(template.Name),
+				"aws_inspector_assessment_template",
+				"aws",
+				inspectorAllowEmptyValues))
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links templates to targets and targets to resource groups
+func (g *InspectorGenerator) PostConvertHook() error {
+	references := map[string]string{}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_inspector_assessment_target", "aws_inspector_resource_group":
+			references[r.InstanceState.ID] = "${" + r.InstanceInfo.Type + "." + r.ResourceName + ".arn}"
+		}
+	}
+	for _, r := range g.Resources {
+		var key string
+		switch r.InstanceInfo.Type {
+		case "aws_inspector_assessment_target":
+			key = "resource_group_arn"
+		case "aws_inspector_assessment_template":
+			key = "target_arn"
+		default:
+			continue
+		}
+		if reference, ok := references[r.InstanceState.Attributes[key]]; ok {
+			r.Item[key] = reference
+		}
+	}
+	return nil
+}
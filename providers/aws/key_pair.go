@@ -0,0 +1,68 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+var keyPairAllowEmptyValues = []string{"tags."}
+
+type KeyPairGenerator struct {
+	AWSService
+}
+
+func (g *KeyPairGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := ec2.New(config)
+	output, err := svc.DescribeKeyPairsRequest(&ec2.DescribeKeyPairsInput{}).Send(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, keyPair := range output.KeyPairs {
+		name := aws.StringValue(keyPair.KeyName)
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			name,
+			name,
+			"aws_key_pair",
+			"aws",
+			keyPairAllowEmptyValues))
+	}
+	return nil
+}
+
+// PostConvertHook replaces public key material, EC2 doesn't return it, with a variable declared next to key pairs
+func (g *KeyPairGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		if publicKey, ok := r.Item["public_key"].(string); ok && publicKey != "" {
+			continue
+		}
+		variable := terraformutils.VariableName(r.ResourceName + "_public_key")
+		log.Printf("aws: public key of key pair %s isn't returned by EC2, set variable %s", r.InstanceState.ID, variable)
+		g.Resources[i].Item["public_key"] = g.Resources[i].AddVariable(terraformutils.Variable{
+			Name:        variable,
+			Description: "Public key material of key pair " + r.InstanceState.ID,
+		})
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ package aws
 
 import (
 	"context"
+	"sort"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
@@ -54,3 +55,17 @@ func (g *KinesisGenerator) InitResources() error {
 	}
 	return p.Err()
 }
+
+// PostConvertHook sorts shard level metrics, the API returns them in random order
+func (g *KinesisGenerator) PostConvertHook() error {
+	for _, r := range g.Resources {
+		metrics, ok := r.Item["shard_level_metrics"].([]interface{})
+		if !ok {
+			continue
+		}
+		sort.Slice(metrics, func(i, j int) bool {
+			return metrics[i].(string) < metrics[j].(string)
+		})
+	}
+	return nil
+}
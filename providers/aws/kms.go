@@ -16,8 +16,13 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 )
 
@@ -25,6 +30,8 @@ var kmsAllowEmptyValues = []string{"tags."}
 
 type KmsGenerator struct {
 	AWSService
+	// keyPolicies are indented policies of keys by key id
+	keyPolicies map[string]string
 }
 
 func (g *KmsGenerator) InitResources() error {
@@ -33,6 +40,7 @@ func (g *KmsGenerator) InitResources() error {
 		return e
 	}
 	client := kms.New(config)
+	g.keyPolicies = map[string]string{}
 
 	err := g.addKeys(client)
 	if err != nil {
@@ -42,21 +50,43 @@ func (g *KmsGenerator) InitResources() error {
 	return err
 }
 
+// addKeys adds customer managed keys, AWS managed keys can't be managed by Terraform
 func (g *KmsGenerator) addKeys(client *kms.Client) error {
 	p := kms.NewListKeysPaginator(client.ListKeysRequest(&kms.ListKeysInput{}))
 	for p.Next(context.Background()) {
 		for _, key := range p.CurrentPage().Keys {
+			keyID := aws.StringValue(key.KeyId)
+			description, err := client.DescribeKeyRequest(&kms.DescribeKeyInput{KeyId: key.KeyId}).Send(context.Background())
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			if description.KeyMetadata.KeyManager == kms.KeyManagerTypeAws {
+				continue
+			}
+			tags, err := g.keyTags(client, key.KeyId)
+			if err != nil {
+				log.Println(err)
+			}
+			if policy, err := g.keyPolicy(client, key.KeyId); err == nil {
+				g.keyPolicies[keyID] = policy
+			} else {
+				log.Println(err)
+			}
 			resource := terraformutils.NewResource(
-				*key.KeyId,
-				*key.KeyId,
+				keyID,
+				keyID,
 				"aws_kms_key",
 				"aws",
 				map[string]string{
-					"key_id": *key.KeyId,
+					"key_id": keyID,
 				},
 				kmsAllowEmptyValues,
 				map[string]interface{}{},
 			)
+			if len(tags) > 0 {
+				resource.AdditionalFields["tags"] = tags
+			}
 			resource.SlowQueryRequired = true
 			g.Resources = append(g.Resources, resource)
 		}
@@ -64,10 +94,52 @@ func (g *KmsGenerator) addKeys(client *kms.Client) error {
 	return p.Err()
 }
 
+func (g *KmsGenerator) keyTags(client *kms.Client, keyID *string) (map[string]interface{}, error) {
+	tags := map[string]interface{}{}
+	input := &kms.ListResourceTagsInput{KeyId: keyID}
+	for {
+		output, err := client.ListResourceTagsRequest(input).Send(context.Background())
+		if err != nil {
+			return tags, err
+		}
+		for _, tag := range output.Tags {
+			tags[aws.StringValue(tag.TagKey)] = aws.StringValue(tag.TagValue)
+		}
+		if !aws.BoolValue(output.Truncated) {
+			return tags, nil
+		}
+		input.Marker = output.NextMarker
+	}
+}
+
+// keyPolicy returns default policy of key indented, keys have no other policies
+func (g *KmsGenerator) keyPolicy(client *kms.Client, keyID *string) (string, error) {
+	output, err := client.GetKeyPolicyRequest(&kms.GetKeyPolicyInput{
+		KeyId:      keyID,
+		PolicyName: aws.String("default"),
+	}).Send(context.Background())
+	if err != nil {
+		return "", err
+	}
+	var policy interface{}
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Policy)), &policy); err != nil {
+		return "", err
+	}
+	indented, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(indented), nil
+}
+
+// addAliases adds aliases of customer managed keys, aliases of AWS managed keys are reserved
 func (g *KmsGenerator) addAliases(client *kms.Client) error {
 	p := kms.NewListAliasesPaginator(client.ListAliasesRequest(&kms.ListAliasesInput{}))
 	for p.Next(context.Background()) {
 		for _, alias := range p.CurrentPage().Aliases {
+			if alias.TargetKeyId == nil || strings.HasPrefix(aws.StringValue(alias.AliasName), "alias/aws/") {
+				continue
+			}
 			resource := terraformutils.NewSimpleResource(
 				*alias.AliasName,
 				*alias.AliasName,
@@ -81,3 +153,28 @@ func (g *KmsGenerator) addAliases(client *kms.Client) error {
 	}
 	return p.Err()
 }
+
+// PostConvertHook adds key policy json as heredoc and links aliases to their target keys
+func (g *KmsGenerator) PostConvertHook() error {
+	keys := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "aws_kms_key" {
+			keys[r.InstanceState.ID] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_kms_key":
+			if policy, ok := g.keyPolicies[r.InstanceState.ID]; ok {
+				g.Resources[i].Item["policy"] = fmt.Sprintf(`<<POLICY
+%s
+POLICY`, g.escapeAwsInterpolation(policy))
+			}
+		case "aws_kms_alias":
+			if resourceName, exist := keys[r.InstanceState.Attributes["target_key_id"]]; exist {
+				g.Resources[i].Item["target_key_id"] = "${aws_kms_key." + resourceName + ".key_id}"
+			}
+		}
+	}
+	return nil
+}
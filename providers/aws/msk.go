@@ -37,26 +37,61 @@ func (g *MskGenerator) InitResources() error {
 	p := kafka.NewListClustersPaginator(svc.ListClustersRequest(&kafka.ListClustersInput{}))
 	for p.Next(context.Background()) {
 		for _, clusterInfo := range p.CurrentPage().ClusterInfoList {
-			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			resource := terraformutils.NewSimpleResource(
 				aws.StringValue(clusterInfo.ClusterArn),
 				aws.StringValue(clusterInfo.ClusterName),
 				"aws_msk_cluster",
 				"aws",
 				mskAllowEmptyValues,
-			))
+			)
+			// connection strings are computed by AWS
+			resource.IgnoreKeys = append(resource.IgnoreKeys,
+				"^bootstrap_brokers(.*)",
+				"^zookeeper_connect_string(.*)",
+				"^current_version$",
+			)
+			g.Resources = append(g.Resources, resource)
 		}
 	}
+	if err := p.Err(); err != nil {
+		return err
+	}
 
-	return p.Err()
+	configurations := kafka.NewListConfigurationsPaginator(svc.ListConfigurationsRequest(&kafka.ListConfigurationsInput{}))
+	for configurations.Next(context.Background()) {
+		for _, configuration := range configurations.CurrentPage().Configurations {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				aws.StringValue(configuration.Arn),
+				aws.StringValue(configuration.Name),
+				"aws_msk_configuration",
+				"aws",
+				mskAllowEmptyValues,
+			))
+		}
+	}
+	return configurations.Err()
 }
 
 func (g *MskGenerator) PostConvertHook() error {
 	for _, r := range g.Resources {
-		if r.InstanceInfo.Type != "aws_msk_cluster" {
-			continue
-		}
-		if r.InstanceState.Attributes["configuration_info.0.revision"] == "0" {
-			delete(r.Item, "configuration_info")
+		switch r.InstanceInfo.Type {
+		case "aws_msk_cluster":
+			if r.InstanceState.Attributes["configuration_info.0.revision"] == "0" {
+				delete(r.Item, "configuration_info")
+				continue
+			}
+			for _, configuration := range g.Resources {
+				if configuration.InstanceInfo.Type == "aws_msk_configuration" &&
+					configuration.InstanceState.ID == r.InstanceState.Attributes["configuration_info.0.arn"] {
+					if configurationInfo, ok := r.Item["configuration_info"].([]interface{}); ok && len(configurationInfo) == 1 {
+						configurationInfo[0].(map[string]interface{})["arn"] = "${aws_msk_configuration." + configuration.ResourceName + ".arn}"
+					}
+				}
+			}
+		case "aws_msk_configuration":
+			if properties, ok := r.Item["server_properties"].(string); ok {
+				r.Item["server_properties"] = terraformutils.Heredoc("PROPERTIES", properties)
+			}
 		}
 	}
 	return nil
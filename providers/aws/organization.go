@@ -30,59 +30,55 @@ type OrganizationGenerator struct {
 	AWSService
 }
 
-func (g *OrganizationGenerator) traverseNode(svc *organizations.Client, parentID string) {
-	accountsForParent, err := svc.ListAccountsForParentRequest(
-		&organizations.ListAccountsForParentInput{ParentId: aws.String(parentID)}).Send(context.Background())
-	if err != nil {
-		return
+// traverseNode walks OU tree depth first, so parents are generated before their children
+func (g *OrganizationGenerator) traverseNode(svc *organizations.Client, parentID string) error {
+	accounts := organizations.NewListAccountsForParentPaginator(svc.ListAccountsForParentRequest(
+		&organizations.ListAccountsForParentInput{ParentId: aws.String(parentID)}))
+	for accounts.Next(context.Background()) {
+		for _, account := range accounts.CurrentPage().Accounts {
+			if account.Status == organizations.AccountStatusSuspended {
+				continue
+			}
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				aws.StringValue(account.Id),
+				aws.StringValue(account.Name),
+				"aws_organizations_account",
+				"aws",
+				map[string]string{
+					"id":  aws.StringValue(account.Id),
+					"arn": aws.StringValue(account.Arn),
+				},
+				organizationAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
 	}
-	for _, account := range accountsForParent.Accounts {
-		g.Resources = append(g.Resources, terraformutils.NewResource(
-			aws.StringValue(account.Id),
-			aws.StringValue(account.Name),
-			"aws_organizations_organization",
-			"aws",
-			map[string]string{
-				"id":  aws.StringValue(account.Id),
-				"arn": aws.StringValue(account.Arn),
-			},
-			organizationAllowEmptyValues,
-			map[string]interface{}{},
-		))
-		g.Resources = append(g.Resources, terraformutils.NewResource(
-			aws.StringValue(account.Id),
-			aws.StringValue(account.Name),
-			"aws_organizations_account",
-			"aws",
-			map[string]string{
-				"id":  aws.StringValue(account.Id),
-				"arn": aws.StringValue(account.Arn),
-			},
-			organizationAllowEmptyValues,
-			map[string]interface{}{},
-		))
+	if err := accounts.Err(); err != nil {
+		return err
 	}
 
-	unitsForParent, err := svc.ListOrganizationalUnitsForParentRequest(
-		&organizations.ListOrganizationalUnitsForParentInput{ParentId: aws.String(parentID)}).Send(context.Background())
-	if err != nil {
-		return
-	}
-	for _, unit := range unitsForParent.OrganizationalUnits {
-		g.Resources = append(g.Resources, terraformutils.NewResource(
-			aws.StringValue(unit.Id),
-			aws.StringValue(unit.Name),
-			"aws_organizations_organizational_unit",
-			"aws",
-			map[string]string{
-				"id":  aws.StringValue(unit.Id),
-				"arn": aws.StringValue(unit.Arn),
-			},
-			organizationAllowEmptyValues,
-			map[string]interface{}{},
-		))
-		g.traverseNode(svc, aws.StringValue(unit.Id))
+	units := organizations.NewListOrganizationalUnitsForParentPaginator(svc.ListOrganizationalUnitsForParentRequest(
+		&organizations.ListOrganizationalUnitsForParentInput{ParentId: aws.String(parentID)}))
+	for units.Next(context.Background()) {
+		for _, unit := range units.CurrentPage().OrganizationalUnits {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				aws.StringValue(unit.Id),
+				aws.StringValue(unit.Name),
+				"aws_organizations_organizational_unit",
+				"aws",
+				map[string]string{
+					"id":  aws.StringValue(unit.Id),
+					"arn": aws.StringValue(unit.Arn),
+				},
+				organizationAllowEmptyValues,
+				map[string]interface{}{},
+			))
+			if err := g.traverseNode(svc, aws.StringValue(unit.Id)); err != nil {
+				return err
+			}
+		}
 	}
+	return units.Err()
 }
 
 func (g *OrganizationGenerator) InitResources() error {
@@ -92,14 +88,33 @@ func (g *OrganizationGenerator) InitResources() error {
 	}
 	svc := organizations.New(config)
 
-	roots, err := svc.ListRootsRequest(&organizations.ListRootsInput{}).Send(context.Background())
+	organization, err := svc.DescribeOrganizationRequest(&organizations.DescribeOrganizationInput{}).Send(context.Background())
 	if err != nil {
 		return err
 	}
+	g.Resources = append(g.Resources, terraformutils.NewResource(
+		aws.StringValue(organization.Organization.Id),
+		aws.StringValue(organization.Organization.Id),
+		"aws_organizations_organization",
+		"aws",
+		map[string]string{
+			"id":  aws.StringValue(organization.Organization.Id),
+			"arn": aws.StringValue(organization.Organization.Arn),
+		},
+		organizationAllowEmptyValues,
+		map[string]interface{}{},
+	))
 
-	for _, root := range roots.Roots {
-		nodeID := aws.StringValue(root.Id)
-		g.traverseNode(svc, nodeID)
+	roots := organizations.NewListRootsPaginator(svc.ListRootsRequest(&organizations.ListRootsInput{}))
+	for roots.Next(context.Background()) {
+		for _, root := range roots.CurrentPage().Roots {
+			if err := g.traverseNode(svc, aws.StringValue(root.Id)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := roots.Err(); err != nil {
+		return err
 	}
 
 	p := organizations.NewListPoliciesPaginator(svc.ListPoliciesRequest(&organizations.ListPoliciesInput{
@@ -122,28 +137,70 @@ func (g *OrganizationGenerator) InitResources() error {
 				map[string]interface{}{},
 			))
 
-			targetsForPolicy, err := svc.ListTargetsForPolicyRequest(
-				&organizations.ListTargetsForPolicyInput{PolicyId: policy.Id}).Send(context.Background())
-			if err != nil {
-				fmt.Println(err.Error())
-				continue
+			targets := organizations.NewListTargetsForPolicyPaginator(svc.ListTargetsForPolicyRequest(
+				&organizations.ListTargetsForPolicyInput{PolicyId: policy.Id}))
+			for targets.Next(context.Background()) {
+				for _, target := range targets.CurrentPage().Targets {
+					g.Resources = append(g.Resources, terraformutils.NewResource(
+						aws.StringValue(target.TargetId)+":"+policyID,
+						"pa-"+aws.StringValue(target.TargetId)+":"+policyName,
+						"aws_organizations_policy_attachment",
+						"aws",
+						map[string]string{
+							"policy_id": policyID,
+							"target_id": aws.StringValue(target.TargetId),
+						},
+						organizationAllowEmptyValues,
+						map[string]interface{}{},
+					))
+				}
 			}
-			for _, target := range targetsForPolicy.Targets {
-				g.Resources = append(g.Resources, terraformutils.NewResource(
-					aws.StringValue(target.TargetId)+":"+policyID,
-					"pa-"+aws.StringValue(target.TargetId)+":"+policyName,
-					"aws_organizations_policy_attachment",
-					"aws",
-					map[string]string{
-						"policy_id": policyID,
-						"target_id": aws.StringValue(target.TargetId),
-					},
-					organizationAllowEmptyValues,
-					map[string]interface{}{},
-				))
+			if err := targets.Err(); err != nil {
+				return err
 			}
 		}
 	}
 
 	return p.Err()
 }
+
+// PostConvertHook links the OU tree, policies and attachments and renders policy content as heredoc
+func (g *OrganizationGenerator) PostConvertHook() error {
+	references := map[string]string{}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_organizations_organization":
+			for i := 0; r.InstanceState.Attributes[fmt.Sprintf("roots.%d.id", i)] != ""; i++ {
+				references[r.InstanceState.Attributes[fmt.Sprintf("roots.%d.id", i)]] =
+					fmt.Sprintf("${aws_organizations_organization.%s.roots.%d.id}", r.ResourceName, i)
+			}
+		case "aws_organizations_account", "aws_organizations_organizational_unit", "aws_organizations_policy":
+			references[r.InstanceState.ID] = "${" + r.InstanceInfo.Type + "." + r.ResourceName + ".id}"
+		}
+	}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_organizations_account":
+			// role_name and billing access are only used on account creation and aren't returned by AWS
+			r.Item["lifecycle"] = []interface{}{map[string]interface{}{
+				"ignore_changes": []interface{}{"role_name", "iam_user_access_to_billing"},
+			}}
+			fallthrough
+		case "aws_organizations_organizational_unit":
+			if reference, ok := references[r.InstanceState.Attributes["parent_id"]]; ok {
+				r.Item["parent_id"] = reference
+			}
+		case "aws_organizations_policy":
+			if content, ok := r.Item["content"].(string); ok {
+				r.Item["content"] = terraformutils.Heredoc("POLICY", content)
+			}
+		case "aws_organizations_policy_attachment":
+			for _, key := range []string{"policy_id", "target_id"} {
+				if reference, ok := references[r.InstanceState.Attributes[key]]; ok {
+					r.Item[key] = reference
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+var placementGroupAllowEmptyValues = []string{"tags."}
+
+type PlacementGroupGenerator struct {
+	AWSService
+}
+
+func (g *PlacementGroupGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := ec2.New(config)
+	output, err := svc.DescribePlacementGroupsRequest(&ec2.DescribePlacementGroupsInput{}).Send(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, placementGroup := range output.PlacementGroups {
+		if placementGroup.State == ec2.PlacementGroupStateDeleting || placementGroup.State == ec2.PlacementGroupStateDeleted {
+			continue
+		}
+		name := aws.StringValue(placementGroup.GroupName)
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			name,
+			name,
+			"aws_placement_group",
+			"aws",
+			placementGroupAllowEmptyValues))
+	}
+	return nil
+}
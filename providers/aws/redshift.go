@@ -0,0 +1,134 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+)
+
+var redshiftAllowEmptyValues = []string{"tags."}
+
+type RedshiftGenerator struct {
+	AWSService
+}
+
+func (g *RedshiftGenerator) loadClusters(svc *redshift.Client) error {
+	p := redshift.NewDescribeClustersPaginator(svc.DescribeClustersRequest(&redshift.DescribeClustersInput{}))
+	for p.Next(context.Background()) {
+		for _, cluster := range p.CurrentPage().Clusters {
+			resourceName := aws.StringValue(cluster.ClusterIdentifier)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				resourceName,
+				resourceName,
+				"aws_redshift_cluster",
+				"aws",
+				redshiftAllowEmptyValues,
+			))
+		}
+	}
+	return p.Err()
+}
+
+func (g *RedshiftGenerator) loadParameterGroups(svc *redshift.Client) error {
+	p := redshift.NewDescribeClusterParameterGroupsPaginator(svc.DescribeClusterParameterGroupsRequest(&redshift.DescribeClusterParameterGroupsInput{}))
+	for p.Next(context.Background()) {
+		for _, parameterGroup := range p.CurrentPage().ParameterGroups {
+			resourceName := aws.StringValue(parameterGroup.ParameterGroupName)
+			if strings.HasPrefix(resourceName, "default.") {
+				continue // skip default ParameterGroups like default.redshift-1.0
+			}
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				resourceName,
+				resourceName,
+				"aws_redshift_parameter_group",
+				"aws",
+				redshiftAllowEmptyValues,
+			))
+		}
+	}
+	return p.Err()
+}
+
+func (g *RedshiftGenerator) loadSubnetGroups(svc *redshift.Client) error {
+	p := redshift.NewDescribeClusterSubnetGroupsPaginator(svc.DescribeClusterSubnetGroupsRequest(&redshift.DescribeClusterSubnetGroupsInput{}))
+	for p.Next(context.Background()) {
+		for _, subnetGroup := range p.CurrentPage().ClusterSubnetGroups {
+			resourceName := aws.StringValue(subnetGroup.ClusterSubnetGroupName)
+			if resourceName == "default" {
+				continue
+			}
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				resourceName,
+				resourceName,
+				"aws_redshift_subnet_group",
+				"aws",
+				redshiftAllowEmptyValues,
+			))
+		}
+	}
+	return p.Err()
+}
+
+// Generate TerraformResources from AWS API,
+// create 1 TerraformResource for each cluster, parameter group and subnet group.
+// Need only identifier or name as ID for terraform resource
+// AWS api support paging
+func (g *RedshiftGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := redshift.New(config)
+
+	if err := g.loadClusters(svc); err != nil {
+		return err
+	}
+	if err := g.loadParameterGroups(svc); err != nil {
+		return err
+	}
+	if err := g.loadSubnetGroups(svc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *RedshiftGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type != "aws_redshift_cluster" {
+			continue
+		}
+		// master password can't be read back, it has to be set through variable
+		g.Resources[i].Item["master_password"] = g.Resources[i].AddSensitiveVariable(
+			strings.TrimPrefix(r.ResourceName, "tfer--")+"_master_password",
+			"Master password of Redshift cluster "+r.InstanceState.ID)
+		for _, group := range g.Resources {
+			switch {
+			case group.InstanceInfo.Type == "aws_redshift_parameter_group" &&
+				group.InstanceState.ID == r.InstanceState.Attributes["cluster_parameter_group_name"]:
+				g.Resources[i].Item["cluster_parameter_group_name"] = "${aws_redshift_parameter_group." + group.ResourceName + ".name}"
+			case group.InstanceInfo.Type == "aws_redshift_subnet_group" &&
+				group.InstanceState.ID == r.InstanceState.Attributes["cluster_subnet_group_name"]:
+				g.Resources[i].Item["cluster_subnet_group_name"] = "${aws_redshift_subnet_group." + group.ResourceName + ".name}"
+			}
+		}
+	}
+	return nil
+}
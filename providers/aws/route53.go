@@ -62,7 +62,7 @@ func (g *Route53Generator) createZonesResources(svc *route53.Client) []terraform
 	return resources
 }
 
-func (Route53Generator) createRecordsResources(svc *route53.Client, zoneID string) []terraformutils.Resource {
+func (g *Route53Generator) createRecordsResources(svc *route53.Client, zoneID string) []terraformutils.Resource {
 	var resources []terraformutils.Resource
 	listParams := &route53.ListResourceRecordSetsInput{
 		HostedZoneId: aws.String(zoneID),
@@ -71,6 +71,9 @@ func (Route53Generator) createRecordsResources(svc *route53.Client, zoneID strin
 	p := route53.NewListResourceRecordSetsPaginator(svc.ListResourceRecordSetsRequest(listParams))
 	for p.Next(context.Background()) {
 		for _, record := range p.CurrentPage().ResourceRecordSets {
+			if g.isServiceImported("acm") && isAcmValidationRecord(record) {
+				continue // created with certificates by acm
+			}
 			recordName := wildcardUnescape(aws.StringValue(record.Name))
 			typeString, _ := record.Type.MarshalValue()
 			resources = append(resources, terraformutils.NewResource(
@@ -125,7 +125,12 @@ POLICY`, policy)
 
 func (g *S3Generator) ParseFilters(rawFilters []string) {
 	g.Filter = []terraformutils.ResourceFilter{}
+	g.FilterChain = terraformutils.FilterChain{}
 	for _, rawFilter := range rawFilters {
+		if chain, ok := terraformutils.ParseFilterChain(rawFilter); ok {
+			g.FilterChain = append(g.FilterChain, chain...)
+			continue
+		}
 		filters := g.ParseFilter(rawFilter)
 		for _, resourceFilter := range filters {
 			g.Filter = append(g.Filter, resourceFilter)
@@ -0,0 +1,150 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+)
+
+var sagemakerAllowEmptyValues = []string{"tags."}
+
+type SageMakerGenerator struct {
+	AWSService
+}
+
+func (g *SageMakerGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := sagemaker.New(config)
+	if err := g.loadNotebookInstances(svc); err != nil {
+		return err
+	}
+	if err := g.loadModels(svc); err != nil {
+		return err
+	}
+	if err := g.loadEndpointConfigs(svc); err != nil {
+		return err
+	}
+	return g.loadEndpoints(svc)
+}
+
+func (g *SageMakerGenerator) loadNotebookInstances(svc *sagemaker.Client) error {
+	p := sagemaker.NewListNotebookInstancesPaginator(svc.ListNotebookInstancesRequest(&sagemaker.ListNotebookInstancesInput{}))
+	for p.Next(context.Background()) {
+		for _, notebookInstance := range p.CurrentPage().NotebookInstances {
+			name := aws.StringValue(notebookInstance.NotebookInstanceName)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				name,
+				name,
+				"aws_sagemaker_notebook_instance",
+				"aws",
+				sagemakerAllowEmptyValues))
+		}
+	}
+	return p.Err()
+}
+
+func (g *SageMakerGenerator) loadModels(svc *sagemaker.Client) error {
+	p := sagemaker.NewListModelsPaginator(svc.ListModelsRequest(&sagemaker.ListModelsInput{}))
+	for p.Next(context.Background()) {
+		for _, model := range p.CurrentPage().Models {
+			name := aws.StringValue(model.ModelName)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				name,
+				name,
+				"aws_sagemaker_model",
+				"aws",
+				sagemakerAllowEmptyValues))
+		}
+	}
+	return p.Err()
+}
+
+func (g *SageMakerGenerator) loadEndpointConfigs(svc *sagemaker.Client) error {
+	p := sagemaker.NewListEndpointConfigsPaginator(svc.ListEndpointConfigsRequest(&sagemaker.ListEndpointConfigsInput{}))
+	for p.Next(context.Background()) {
+		for _, endpointConfig := range p.CurrentPage().EndpointConfigs {
+			name := aws.StringValue(endpointConfig.EndpointConfigName)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				name,
+				name,
+				"aws_sagemaker_endpoint_configuration",
+				"aws",
+				sagemakerAllowEmptyValues))
+		}
+	}
+	return p.Err()
+}
+
+func (g *SageMakerGenerator) loadEndpoints(svc *sagemaker.Client) error {
+	p := sagemaker.NewListEndpointsPaginator(svc.ListEndpointsRequest(&sagemaker.ListEndpointsInput{}))
+	for p.Next(context.Background()) {
+		for _, endpoint := range p.CurrentPage().Endpoints {
+			name := aws.StringValue(endpoint.EndpointName)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				name,
+				name,
+				"aws_sagemaker_endpoint",
+				"aws",
+				sagemakerAllowEmptyValues))
+		}
+	}
+	return p.Err()
+}
+
+// PostConvertHook links endpoint configurations to models and endpoints to endpoint configurations
+func (g *SageMakerGenerator) PostConvertHook() error {
+	models := map[string]string{}
+	endpointConfigs := map[string]string{}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_sagemaker_model":
+			models[r.InstanceState.ID] = "${aws_sagemaker_model." + r.ResourceName + ".name}"
+		case "aws_sagemaker_endpoint_configuration":
+			endpointConfigs[r.InstanceState.ID] = "${aws_sagemaker_endpoint_configuration." + r.ResourceName + ".name}"
+		}
+	}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_sagemaker_endpoint_configuration":
+			variants, ok := r.Item["production_variants"].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, variant := range variants {
+				variant, ok := variant.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if modelName, ok := variant["model_name"].(string); ok {
+					if reference, ok := models[modelName]; ok {
+						variant["model_name"] = reference
+					}
+				}
+			}
+		case "aws_sagemaker_endpoint":
+			if reference, ok := endpointConfigs[r.InstanceState.Attributes["endpoint_config_name"]]; ok {
+				r.Item["endpoint_config_name"] = reference
+			}
+		}
+	}
+	return nil
+}
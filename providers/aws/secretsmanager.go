@@ -16,6 +16,9 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -34,6 +37,7 @@ func (g *SecretsManagerGenerator) InitResources() error {
 		return e
 	}
 	svc := secretsmanager.New(config)
+	includeValues, _ := g.Args["secrets_include_values"].(bool)
 	p := secretsmanager.NewListSecretsPaginator(svc.ListSecretsRequest(&secretsmanager.ListSecretsInput{}))
 	var resources []terraformutils.Resource
 	for p.Next(context.Background()) {
@@ -46,8 +50,86 @@ func (g *SecretsManagerGenerator) InitResources() error {
 				"aws_secretsmanager_secret",
 				"aws",
 				secretsmanagerAllowEmptyValues))
+			if !includeValues {
+				continue
+			}
+			// current version is found without reading secret value
+			output, err := svc.DescribeSecretRequest(&secretsmanager.DescribeSecretInput{
+				SecretId: secret.ARN,
+			}).Send(context.Background())
+			if err != nil {
+				log.Printf("aws: can't describe secret %s: %v", secretName, err)
+				continue
+			}
+			versionID := currentSecretVersion(output.VersionIdsToStages)
+			if versionID == "" {
+				continue
+			}
+			resources = append(resources, terraformutils.NewResource(
+				secretArn+"|"+versionID,
+				secretName+"_current",
+				"aws_secretsmanager_secret_version",
+				"aws",
+				map[string]string{
+					"secret_id":  secretArn,
+					"version_id": versionID,
+				},
+				secretsmanagerAllowEmptyValues,
+				map[string]interface{}{}))
 		}
 	}
 	g.Resources = resources
 	return p.Err()
 }
+
+// currentSecretVersion returns ID of version with AWSCURRENT stage
+func currentSecretVersion(versionIdsToStages map[string][]string) string {
+	for versionID, stages := range versionIdsToStages {
+		for _, stage := range stages {
+			if stage == "AWSCURRENT" {
+				return versionID
+			}
+		}
+	}
+	return ""
+}
+
+// PostConvertHook adds policy json as heredoc, links secret versions to secrets and replaces
+// secret values by sensitive variables, values read by provider on refresh are removed from state
+func (g *SecretsManagerGenerator) PostConvertHook() error {
+	secrets := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "aws_secretsmanager_secret" {
+			secrets[r.InstanceState.ID] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_secretsmanager_secret":
+			if val, ok := r.Item["policy"].(string); ok && val != "" {
+				policy := g.escapeAwsInterpolation(val)
+				g.Resources[i].Item["policy"] = fmt.Sprintf(`<<POLICY
+%s
+POLICY`, policy)
+			}
+		case "aws_secretsmanager_secret_version":
+			if resourceName, exist := secrets[r.InstanceState.Attributes["secret_id"]]; exist {
+				g.Resources[i].Item["secret_id"] = "${aws_secretsmanager_secret." + resourceName + ".id}"
+			}
+			for _, key := range []string{"secret_string", "secret_binary"} {
+				if _, ok := r.Item[key]; ok {
+					g.Resources[i].Item[key] = secretVersionVariable(&g.Resources[i], key)
+				}
+				delete(g.Resources[i].InstanceState.Attributes, key)
+			}
+		}
+	}
+	return nil
+}
+
+// secretVersionVariable declares sensitive variable for value of secret version in resource data files
+// and returns reference to it
+func secretVersionVariable(r *terraformutils.Resource, key string) string {
+	variable := strings.TrimPrefix(r.ResourceName, "tfer--") + "_" + key
+	return r.AddSensitiveVariable(variable, "Value of secret version "+r.InstanceState.ID)
+}
@@ -93,7 +93,7 @@ func (g *SecurityhubGenerator) addMembers(svc *securityhub.Client, accountNumber
 				},
 				securityhubAllowEmptyValues,
 				map[string]interface{}{
-					"depends_on": []string{"${aws_securityhub_account.tfer--" + accountNumber + "}"},
+					"depends_on": []string{"aws_securityhub_account.tfer--" + accountNumber},
 				},
 			))
 		}
@@ -108,14 +108,15 @@ func (g *SecurityhubGenerator) addStandardsSubscription(svc *securityhub.Client,
 	for p.Next(context.Background()) {
 		page := p.CurrentPage()
 		for _, standardsSubscription := range page.StandardsSubscriptions {
-			id := *standardsSubscription.StandardsSubscriptionArn
+			// standards ARNs are regional, keep the one returned for the imported region
+			standardsArn := *standardsSubscription.StandardsArn
 			g.Resources = append(g.Resources, terraformutils.NewResource(
-				id,
-				id,
+				*standardsSubscription.StandardsSubscriptionArn,
+				standardsName(standardsArn),
 				"aws_securityhub_standards_subscription",
 				"aws",
 				map[string]string{
-					"standards_arn": id,
+					"standards_arn": standardsArn,
 				},
 				securityhubAllowEmptyValues,
 				map[string]interface{}{
@@ -126,3 +127,9 @@ func (g *SecurityhubGenerator) addStandardsSubscription(svc *securityhub.Client,
 	}
 	return p.Err()
 }
+
+// standardsName turns arn:aws:securityhub:region::standards/name/v/1.0.0 into name_v_1.0.0
+func standardsName(standardsArn string) string {
+	parts := strings.SplitN(standardsArn, "/", 2)
+	return strings.ReplaceAll(parts[len(parts)-1], "/", "_")
+}
@@ -59,7 +59,8 @@ func (g *SesGenerator) loadDomainIdentities(svc *ses.Client) error {
 		IdentityType: "Domain",
 	}))
 	for p.Next(context.Background()) {
-		for _, identity := range p.CurrentPage().Identities {
+		identities := p.CurrentPage().Identities
+		for _, identity := range identities {
 			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
 				identity,
 				identity,
@@ -67,10 +68,46 @@ func (g *SesGenerator) loadDomainIdentities(svc *ses.Client) error {
 				"aws",
 				sesAllowEmptyValues))
 		}
+		if err := g.loadDomainDkim(svc, identities); err != nil {
+			return err
+		}
 	}
 	return p.Err()
 }
 
+// loadDomainDkim adds Easy DKIM of domain identities which have it enabled
+func (g *SesGenerator) loadDomainDkim(svc *ses.Client, identities []string) error {
+	for start := 0; start < len(identities); start += 100 {
+		end := start + 100
+		if end > len(identities) {
+			end = len(identities)
+		}
+		output, err := svc.GetIdentityDkimAttributesRequest(&ses.GetIdentityDkimAttributesInput{
+			Identities: identities[start:end],
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, identity := range identities[start:end] {
+			if attributes, ok := output.DkimAttributes[identity]; !ok || !aws.BoolValue(attributes.DkimEnabled) {
+				continue
+			}
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				identity,
+				identity,
+				"aws_ses_domain_dkim",
+				"aws",
+				map[string]string{
+					"domain": identity,
+				},
+				sesAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
+
 func (g *SesGenerator) loadMailIdentities(svc *ses.Client) error {
 	p := ses.NewListIdentitiesPaginator(svc.ListIdentitiesRequest(&ses.ListIdentitiesInput{
 		IdentityType: "EmailAddress",
@@ -112,12 +149,35 @@ func (g *SesGenerator) loadConfigurationSets(svc *ses.Client) error {
 	}
 
 	for _, configurationSet := range configurationSets.ConfigurationSets {
+		configurationSetName := aws.StringValue(configurationSet.Name)
 		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
-			aws.StringValue(configurationSet.Name),
-			aws.StringValue(configurationSet.Name),
+			configurationSetName,
+			configurationSetName,
 			"aws_ses_configuration_set",
 			"aws",
 			sesAllowEmptyValues))
+		output, err := svc.DescribeConfigurationSetRequest(&ses.DescribeConfigurationSetInput{
+			ConfigurationSetName:           configurationSet.Name,
+			ConfigurationSetAttributeNames: []ses.ConfigurationSetAttribute{ses.ConfigurationSetAttributeEventDestinations},
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, destination := range output.EventDestinations {
+			destinationName := aws.StringValue(destination.Name)
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				destinationName,
+				configurationSetName+"_"+destinationName,
+				"aws_ses_event_destination",
+				"aws",
+				map[string]string{
+					"name":                   destinationName,
+					"configuration_set_name": configurationSetName,
+				},
+				sesAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
 	}
 	return nil
 }
@@ -142,8 +202,14 @@ func (g *SesGenerator) loadRuleSets(svc *ses.Client) error {
 		if err != nil {
 			return err
 		}
+		// rules are returned in order of their positions, each one is placed after previous one
+		previousRuleName := ""
 		for _, rule := range rules.Rules {
 			ruleID := ruleSetName + ":" + *rule.Name
+			additionalFields := map[string]interface{}{}
+			if previousRuleName != "" {
+				additionalFields["after"] = previousRuleName
+			}
 			g.Resources = append(g.Resources, terraformutils.NewResource(
 				*rule.Name,
 				ruleID,
@@ -154,8 +220,53 @@ func (g *SesGenerator) loadRuleSets(svc *ses.Client) error {
 					"rule_set_name": ruleSetName,
 				},
 				sesAllowEmptyValues,
-				map[string]interface{}{},
+				additionalFields,
 			))
+			previousRuleName = *rule.Name
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links DKIM to domain identities, event destinations to configuration sets and
+// receipt rules to rule sets and rules they are placed after
+func (g *SesGenerator) PostConvertHook() error {
+	domainIdentities := map[string]string{}
+	configurationSets := map[string]string{}
+	ruleSets := map[string]string{}
+	rules := map[string]string{}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_ses_domain_identity":
+			domainIdentities[r.InstanceState.ID] = r.ResourceName
+		case "aws_ses_configuration_set":
+			configurationSets[r.InstanceState.ID] = r.ResourceName
+		case "aws_ses_receipt_rule_set":
+			ruleSets[r.InstanceState.ID] = r.ResourceName
+		case "aws_ses_receipt_rule":
+			rules[r.InstanceState.Attributes["rule_set_name"]+":"+r.InstanceState.ID] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_ses_domain_dkim":
+			if resourceName, exist := domainIdentities[r.InstanceState.Attributes["domain"]]; exist {
+				g.Resources[i].Item["domain"] = "${aws_ses_domain_identity." + resourceName + ".domain}"
+			}
+		case "aws_ses_event_destination":
+			if resourceName, exist := configurationSets[r.InstanceState.Attributes["configuration_set_name"]]; exist {
+				g.Resources[i].Item["configuration_set_name"] = "${aws_ses_configuration_set." + resourceName + ".name}"
+			}
+		case "aws_ses_receipt_rule":
+			ruleSetName := r.InstanceState.Attributes["rule_set_name"]
+			if after, ok := r.Item["after"].(string); ok {
+				if resourceName, exist := rules[ruleSetName+":"+after]; exist {
+					g.Resources[i].Item["after"] = "${aws_ses_receipt_rule." + resourceName + ".name}"
+				}
+			}
+			if resourceName, exist := ruleSets[ruleSetName]; exist {
+				g.Resources[i].Item["rule_set_name"] = "${aws_ses_receipt_rule_set." + resourceName + ".rule_set_name}"
+			}
 		}
 	}
 	return nil
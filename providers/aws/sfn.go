@@ -56,3 +56,16 @@ func (g *SfnGenerator) InitResources() error {
 
 	return nil
 }
+
+// PostConvertHook for add state machine definition json as heredoc
+func (g *SfnGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		if resource.InstanceInfo.Type != "aws_sfn_state_machine" {
+			continue
+		}
+		if definition, ok := resource.Item["definition"].(string); ok {
+			g.Resources[i].Item["definition"] = terraformutils.Heredoc("DEFINITION", definition)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+var spotFleetAllowEmptyValues = []string{"tags."}
+
+type SpotFleetGenerator struct {
+	AWSService
+}
+
+func (g *SpotFleetGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := ec2.New(config)
+	p := ec2.NewDescribeSpotFleetRequestsPaginator(svc.DescribeSpotFleetRequestsRequest(&ec2.DescribeSpotFleetRequestsInput{}))
+	for p.Next(context.Background()) {
+		for _, spotFleetRequest := range p.CurrentPage().SpotFleetRequestConfigs {
+			// cancelled and failed requests stay visible for a while
+			switch spotFleetRequest.SpotFleetRequestState {
+			case ec2.BatchStateSubmitted, ec2.BatchStateActive, ec2.BatchStateModifying:
+			default:
+				continue
+			}
+			id := aws.StringValue(spotFleetRequest.SpotFleetRequestId)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				id,
+				id,
+				"aws_spot_fleet_request",
+				"aws",
+				spotFleetAllowEmptyValues))
+		}
+	}
+	return p.Err()
+}
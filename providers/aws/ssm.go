@@ -0,0 +1,110 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+)
+
+var ssmAllowEmptyValues = []string{"tags."}
+
+type SsmGenerator struct {
+	AWSService
+	// decryptedValues are values of SecureString parameters by parameter name, read with --ssm-decrypt only
+	decryptedValues map[string]string
+}
+
+func (g *SsmGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := ssm.New(config)
+	decrypt, _ := g.Args["ssm_decrypt"].(bool)
+	g.decryptedValues = map[string]string{}
+	p := ssm.NewDescribeParametersPaginator(svc.DescribeParametersRequest(&ssm.DescribeParametersInput{}))
+	var resources []terraformutils.Resource
+	for p.Next(context.Background()) {
+		for _, parameter := range p.CurrentPage().Parameters {
+			name := aws.StringValue(parameter.Name)
+			resources = append(resources, terraformutils.NewSimpleResource(
+				name,
+				strings.TrimPrefix(name, "/"),
+				"aws_ssm_parameter",
+				"aws",
+				ssmAllowEmptyValues))
+			if !decrypt || parameter.Type != ssm.ParameterTypeSecureString {
+				continue
+			}
+			output, err := svc.GetParameterRequest(&ssm.GetParameterInput{
+				Name:           parameter.Name,
+				WithDecryption: aws.Bool(true),
+			}).Send(context.Background())
+			if err != nil {
+				log.Printf("aws: can't decrypt SSM parameter %s: %v", name, err)
+				continue
+			}
+			g.decryptedValues[name] = aws.StringValue(output.Parameter.Value)
+		}
+	}
+	g.Resources = resources
+	return p.Err()
+}
+
+// ssmTfvarsFile has decrypted values of SecureString parameters with --ssm-decrypt, it is passed with -var-file
+const ssmTfvarsFile = "ssm_decrypted.tfvars"
+
+// PostConvertHook replaces values of SecureString parameters by sensitive variables, decrypted values
+// are written to separate tfvars file with --ssm-decrypt, otherwise variables have to be set and values
+// provider decrypted on refresh are removed from state
+func (g *SsmGenerator) PostConvertHook() error {
+	decrypt, _ := g.Args["ssm_decrypt"].(bool)
+	var tfvars []string
+	tfvarsResource := -1
+	for i, r := range g.Resources {
+		if r.InstanceState.Attributes["type"] != string(ssm.ParameterTypeSecureString) {
+			continue
+		}
+		variable := terraformutils.Variable{
+			Name:        ssmVariableName(r.ResourceName),
+			Description: "Value of SSM parameter " + r.InstanceState.ID,
+			Sensitive:   true,
+		}
+		g.Resources[i].Item["value"] = g.Resources[i].AddVariable(variable)
+		if !decrypt {
+			delete(g.Resources[i].InstanceState.Attributes, "value")
+		}
+		if value, ok := g.decryptedValues[r.InstanceState.ID]; ok {
+			tfvars = append(tfvars, variable.Name+" = "+terraformutils.HCLString(value))
+			if tfvarsResource < 0 {
+				tfvarsResource = i
+			}
+		}
+	}
+	if tfvarsResource >= 0 {
+		g.Resources[tfvarsResource].DataFiles[ssmTfvarsFile] = []byte(strings.Join(tfvars, "\n") + "\n")
+	}
+	return nil
+}
+
+func ssmVariableName(resourceName string) string {
+	return strings.TrimPrefix(resourceName, "tfer--") + "_value"
+}
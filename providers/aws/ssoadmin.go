@@ -0,0 +1,327 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+var ssoAdminAllowEmptyValues = []string{"tags."}
+
+// ssoAdminTargetPrefix is target prefix of SSO Admin JSON API, it isn't covered by SDK client
+const ssoAdminTargetPrefix = "SWBExternalService."
+
+// SSOAdminGenerator imports permission sets of IAM Identity Center instances with their managed policies
+// and account assignments, users and groups of assignments are referenced by their identity store IDs
+type SSOAdminGenerator struct {
+	AWSService
+}
+
+type ssoAdminPermissionSet struct {
+	Name             string
+	PermissionSetArn string
+}
+
+// request calls SSO Admin operation with SigV4 signed JSON request and decodes response into output
+func (g *SSOAdminGenerator) request(config aws.Config, operation string, input, output interface{}) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", fmt.Sprintf("https://sso.%s.amazonaws.com/", config.Region), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", ssoAdminTargetPrefix+operation)
+	signer := v4.NewSigner(config.Credentials)
+	if _, err := signer.Sign(context.Background(), req, bytes.NewReader(body), "sso", config.Region, time.Now()); err != nil {
+		return err
+	}
+	resp, err := config.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("aws: SSO Admin %s returned %s: %s", operation, resp.Status, respBody)
+	}
+	return json.Unmarshal(respBody, output)
+}
+
+// list calls paginated SSO Admin operation and passes each page to handle, pages are decoded into page
+// which is zeroed before each request
+func (g *SSOAdminGenerator) list(config aws.Config, operation string, input map[string]interface{}, page interface{ nextToken() string }, handle func() error) error {
+	for {
+		reflect.ValueOf(page).Elem().Set(reflect.Zero(reflect.TypeOf(page).Elem()))
+		if err := g.request(config, operation, input, page); err != nil {
+			return err
+		}
+		if err := handle(); err != nil {
+			return err
+		}
+		next := page.nextToken()
+		if next == "" {
+			return nil
+		}
+		input["NextToken"] = next
+	}
+}
+
+type ssoAdminInstancesPage struct {
+	Instances []struct {
+		InstanceArn string
+	}
+	NextToken string
+}
+
+func (p *ssoAdminInstancesPage) nextToken() string { return p.NextToken }
+
+type ssoAdminArnsPage struct {
+	PermissionSets []string
+	NextToken      string
+}
+
+func (p *ssoAdminArnsPage) nextToken() string { return p.NextToken }
+
+type ssoAdminAccountsPage struct {
+	AccountIDs []string
+	NextToken  string
+}
+
+func (p *ssoAdminAccountsPage) nextToken() string { return p.NextToken }
+
+type ssoAdminManagedPoliciesPage struct {
+	AttachedManagedPolicies []struct {
+		Arn  string
+		Name string
+	}
+	NextToken string
+}
+
+func (p *ssoAdminManagedPoliciesPage) nextToken() string { return p.NextToken }
+
+type ssoAdminAssignmentsPage struct {
+	AccountAssignments []struct {
+		PrincipalID   string
+		PrincipalType string
+	}
+	NextToken string
+}
+
+func (p *ssoAdminAssignmentsPage) nextToken() string { return p.NextToken }
+
+func (g *SSOAdminGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	var instances []string
+	page := &ssoAdminInstancesPage{}
+	if err := g.list(config, "ListInstances", map[string]interface{}{}, page, func() error {
+		for _, instance := range page.Instances {
+			instances = append(instances, instance.InstanceArn)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, instanceArn := range instances {
+		if err := g.loadAccessControlAttributes(config, instanceArn); err != nil {
+			return err
+		}
+		permissionSets, err := g.loadPermissionSets(config, instanceArn)
+		if err != nil {
+			return err
+		}
+		for _, permissionSet := range permissionSets {
+			if err := g.loadManagedPolicyAttachments(config, instanceArn, permissionSet); err != nil {
+				return err
+			}
+			if err := g.loadAccountAssignments(config, instanceArn, permissionSet); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// loadAccessControlAttributes creates attributes for access control of instance when they are configured
+func (g *SSOAdminGenerator) loadAccessControlAttributes(config aws.Config, instanceArn string) error {
+	var output struct {
+		Status string
+	}
+	err := g.request(config, "DescribeInstanceAccessControlAttributeConfiguration", map[string]interface{}{
+		"InstanceArn": instanceArn,
+	}, &output)
+	if err != nil {
+		if strings.Contains(err.Error(), "ResourceNotFoundException") {
+			return nil
+		}
+		return err
+	}
+	g.Resources = append(g.Resources, terraformutils.NewResource(
+		instanceArn,
+		instanceArn[strings.LastIndex(instanceArn, "/")+1:],
+		"aws_ssoadmin_instance_access_control_attributes",
+		"aws",
+		map[string]string{
+			"instance_arn": instanceArn,
+		},
+		ssoAdminAllowEmptyValues,
+		map[string]interface{}{},
+	))
+	return nil
+}
+
+func (g *SSOAdminGenerator) loadPermissionSets(config aws.Config, instanceArn string) ([]ssoAdminPermissionSet, error) {
+	var permissionSets []ssoAdminPermissionSet
+	page := &ssoAdminArnsPage{}
+	err := g.list(config, "ListPermissionSets", map[string]interface{}{"InstanceArn": instanceArn}, page, func() error {
+		for _, permissionSetArn := range page.PermissionSets {
+			var output struct {
+				PermissionSet ssoAdminPermissionSet
+			}
+			if err := g.request(config, "DescribePermissionSet", map[string]interface{}{
+				"InstanceArn":      instanceArn,
+				"PermissionSetArn": permissionSetArn,
+			}, &output); err != nil {
+				return err
+			}
+			permissionSets = append(permissionSets, output.PermissionSet)
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				permissionSetArn+","+instanceArn,
+				output.PermissionSet.Name,
+				"aws_ssoadmin_permission_set",
+				"aws",
+				map[string]string{
+					"instance_arn": instanceArn,
+					"arn":          permissionSetArn,
+				},
+				ssoAdminAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
+		return nil
+	})
+	return permissionSets, err
+}
+
+func (g *SSOAdminGenerator) loadManagedPolicyAttachments(config aws.Config, instanceArn string, permissionSet ssoAdminPermissionSet) error {
+	page := &ssoAdminManagedPoliciesPage{}
+	return g.list(config, "ListManagedPoliciesInPermissionSet", map[string]interface{}{
+		"InstanceArn":      instanceArn,
+		"PermissionSetArn": permissionSet.PermissionSetArn,
+	}, page, func() error {
+		for _, policy := range page.AttachedManagedPolicies {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				policy.Arn+","+permissionSet.PermissionSetArn+","+instanceArn,
+				permissionSet.Name+"_"+policy.Name,
+				"aws_ssoadmin_managed_policy_attachment",
+				"aws",
+				map[string]string{
+					"instance_arn":       instanceArn,
+					"managed_policy_arn": policy.Arn,
+					"permission_set_arn": permissionSet.PermissionSetArn,
+				},
+				ssoAdminAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
+		return nil
+	})
+}
+
+// loadAccountAssignments creates assignments of permission set in each account it's provisioned to
+func (g *SSOAdminGenerator) loadAccountAssignments(config aws.Config, instanceArn string, permissionSet ssoAdminPermissionSet) error {
+	var accounts []string
+	accountsPage := &ssoAdminAccountsPage{}
+	if err := g.list(config, "ListAccountsForProvisionedPermissionSet", map[string]interface{}{
+		"InstanceArn":      instanceArn,
+		"PermissionSetArn": permissionSet.PermissionSetArn,
+	}, accountsPage, func() error {
+		accounts = append(accounts, accountsPage.AccountIDs...)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, accountID := range accounts {
+		page := &ssoAdminAssignmentsPage{}
+		if err := g.list(config, "ListAccountAssignments", map[string]interface{}{
+			"InstanceArn":      instanceArn,
+			"AccountId":        accountID,
+			"PermissionSetArn": permissionSet.PermissionSetArn,
+		}, page, func() error {
+			for _, assignment := range page.AccountAssignments {
+				g.Resources = append(g.Resources, terraformutils.NewResource(
+					strings.Join([]string{assignment.PrincipalID, assignment.PrincipalType, accountID, "AWS_ACCOUNT", permissionSet.PermissionSetArn, instanceArn}, ","),
+					permissionSet.Name+"_"+accountID+"_"+strings.ToLower(assignment.PrincipalType)+"_"+assignment.PrincipalID,
+					"aws_ssoadmin_account_assignment",
+					"aws",
+					map[string]string{
+						"instance_arn":       instanceArn,
+						"permission_set_arn": permissionSet.PermissionSetArn,
+						"principal_id":       assignment.PrincipalID,
+						"principal_type":     assignment.PrincipalType,
+						"target_id":          accountID,
+						"target_type":        "AWS_ACCOUNT",
+					},
+					ssoAdminAllowEmptyValues,
+					map[string]interface{}{},
+				))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links managed policy attachments and account assignments to permission sets,
+// accounts are linked to organization by resource connections
+func (g *SSOAdminGenerator) PostConvertHook() error {
+	permissionSets := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "aws_ssoadmin_permission_set" {
+			permissionSets[r.InstanceState.Attributes["arn"]] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_ssoadmin_managed_policy_attachment", "aws_ssoadmin_account_assignment":
+			if resourceName, exist := permissionSets[r.InstanceState.Attributes["permission_set_arn"]]; exist {
+				g.Resources[i].Item["permission_set_arn"] = "${aws_ssoadmin_permission_set." + resourceName + ".arn}"
+			}
+		}
+	}
+	return nil
+}
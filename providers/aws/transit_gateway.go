@@ -16,6 +16,7 @@ package aws
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
@@ -28,12 +29,21 @@ var tgwAllowEmptyValues = []string{"tags."}
 
 type TransitGatewayGenerator struct {
 	AWSService
+	// sharedTransitGateways are IDs of transit gateways shared with account through RAM
+	sharedTransitGateways []string
 }
 
-func (g *TransitGatewayGenerator) getTransitGateways(svc *ec2.Client) error {
+func (g *TransitGatewayGenerator) getTransitGateways(svc *ec2.Client, account *string) error {
 	p := ec2.NewDescribeTransitGatewaysPaginator(svc.DescribeTransitGatewaysRequest(&ec2.DescribeTransitGatewaysInput{}))
 	for p.Next(context.Background()) {
 		for _, tgw := range p.CurrentPage().TransitGateways {
+			// transit gateways shared through RAM are managed by the owner account
+			if aws.StringValue(tgw.OwnerId) != aws.StringValue(account) {
+				log.Printf("transit gateway %s is shared by account %s, referenced through data source aws_ec2_transit_gateway",
+					aws.StringValue(tgw.TransitGatewayId), aws.StringValue(tgw.OwnerId))
+				g.sharedTransitGateways = append(g.sharedTransitGateways, aws.StringValue(tgw.TransitGatewayId))
+				continue
+			}
 			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
 				aws.StringValue(tgw.TransitGatewayId),
 				aws.StringValue(tgw.TransitGatewayId),
@@ -46,7 +56,8 @@ func (g *TransitGatewayGenerator) getTransitGateways(svc *ec2.Client) error {
 	return p.Err()
 }
 
-func (g *TransitGatewayGenerator) getTransitGatewayRouteTables(svc *ec2.Client) error {
+func (g *TransitGatewayGenerator) getTransitGatewayRouteTables(svc *ec2.Client) ([]string, error) {
+	var routeTableIDs []string
 	p := ec2.NewDescribeTransitGatewayRouteTablesPaginator(svc.DescribeTransitGatewayRouteTablesRequest(&ec2.DescribeTransitGatewayRouteTablesInput{}))
 	for p.Next(context.Background()) {
 		for _, tgwrt := range p.CurrentPage().TransitGatewayRouteTables {
@@ -61,9 +72,76 @@ func (g *TransitGatewayGenerator) getTransitGatewayRouteTables(svc *ec2.Client)
 					"aws",
 					tgwAllowEmptyValues,
 				))
+				routeTableIDs = append(routeTableIDs, aws.StringValue(tgwrt.TransitGatewayRouteTableId))
 			}
 		}
 	}
+	return routeTableIDs, p.Err()
+}
+
+func (g *TransitGatewayGenerator) getTransitGatewayRoutes(svc *ec2.Client, routeTableID string) error {
+	output, err := svc.SearchTransitGatewayRoutesRequest(&ec2.SearchTransitGatewayRoutesInput{
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+		Filters: []ec2.Filter{{
+			Name:   aws.String("type"),
+			Values: []string{string(ec2.TransitGatewayRouteTypeStatic)},
+		}},
+		MaxResults: aws.Int64(1000),
+	}).Send(context.Background())
+	if err != nil {
+		return err
+	}
+	if aws.BoolValue(output.AdditionalRoutesAvailable) {
+		log.Printf("transit gateway route table %s has more than 1000 static routes, only first 1000 are imported", routeTableID)
+	}
+	for _, route := range output.Routes {
+		routeID := routeTableID + "_" + aws.StringValue(route.DestinationCidrBlock)
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			routeID,
+			routeID,
+			"aws_ec2_transit_gateway_route",
+			"aws",
+			tgwAllowEmptyValues,
+		))
+	}
+	return nil
+}
+
+func (g *TransitGatewayGenerator) getTransitGatewayRouteTableAssociations(svc *ec2.Client, routeTableID string) error {
+	p := ec2.NewGetTransitGatewayRouteTableAssociationsPaginator(svc.GetTransitGatewayRouteTableAssociationsRequest(&ec2.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+	}))
+	for p.Next(context.Background()) {
+		for _, association := range p.CurrentPage().Associations {
+			associationID := routeTableID + "_" + aws.StringValue(association.TransitGatewayAttachmentId)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				associationID,
+				associationID,
+				"aws_ec2_transit_gateway_route_table_association",
+				"aws",
+				tgwAllowEmptyValues,
+			))
+		}
+	}
+	return p.Err()
+}
+
+func (g *TransitGatewayGenerator) getTransitGatewayRouteTablePropagations(svc *ec2.Client, routeTableID string) error {
+	p := ec2.NewGetTransitGatewayRouteTablePropagationsPaginator(svc.GetTransitGatewayRouteTablePropagationsRequest(&ec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+	}))
+	for p.Next(context.Background()) {
+		for _, propagation := range p.CurrentPage().TransitGatewayRouteTablePropagations {
+			propagationID := routeTableID + "_" + aws.StringValue(propagation.TransitGatewayAttachmentId)
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				propagationID,
+				propagationID,
+				"aws_ec2_transit_gateway_route_table_propagation",
+				"aws",
+				tgwAllowEmptyValues,
+			))
+		}
+	}
 	return p.Err()
 }
 
@@ -84,8 +162,8 @@ func (g *TransitGatewayGenerator) getTransitGatewayVpcAttachments(svc *ec2.Clien
 }
 
 // Generate TerraformResources from AWS API,
-// from each customer gateway create 1 TerraformResource.
-// Need CustomerGatewayId as ID for terraform resource
+// from each transit gateway, route table, route, association, propagation and attachment create 1 TerraformResource.
+// Need TransitGatewayId as ID for terraform resource
 func (g *TransitGatewayGenerator) InitResources() error {
 	config, e := g.generateConfig()
 	if e != nil {
@@ -93,15 +171,30 @@ func (g *TransitGatewayGenerator) InitResources() error {
 	}
 	svc := ec2.New(config)
 	g.Resources = []terraformutils.Resource{}
-	err := g.getTransitGateways(svc)
+	account, err := g.getAccountNumber(config)
+	if err != nil {
+		return err
+	}
+	err = g.getTransitGateways(svc, account)
 	if err != nil {
 		log.Println(err)
 	}
 
-	err = g.getTransitGatewayRouteTables(svc)
+	routeTableIDs, err := g.getTransitGatewayRouteTables(svc)
 	if err != nil {
 		log.Println(err)
 	}
+	for _, routeTableID := range routeTableIDs {
+		if err := g.getTransitGatewayRoutes(svc, routeTableID); err != nil {
+			log.Println(err)
+		}
+		if err := g.getTransitGatewayRouteTableAssociations(svc, routeTableID); err != nil {
+			log.Println(err)
+		}
+		if err := g.getTransitGatewayRouteTablePropagations(svc, routeTableID); err != nil {
+			log.Println(err)
+		}
+	}
 
 	err = g.getTransitGatewayVpcAttachments(svc)
 	if err != nil {
@@ -110,3 +203,38 @@ func (g *TransitGatewayGenerator) InitResources() error {
 
 	return nil
 }
+
+// PostConvertHook links route tables, routes, associations and propagations inside transit gateway,
+// transit gateways shared by other accounts are referenced through data sources
+func (g *TransitGatewayGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		for _, transitGatewayID := range g.sharedTransitGateways {
+			if r.InstanceState.Attributes["transit_gateway_id"] != transitGatewayID {
+				continue
+			}
+			name := terraformutils.TfSanitize(transitGatewayID)
+			g.Resources[i].Item["transit_gateway_id"] = "${data.aws_ec2_transit_gateway." + name + ".id}"
+			if g.Resources[i].DataFiles == nil {
+				g.Resources[i].DataFiles = map[string][]byte{}
+			}
+			g.Resources[i].DataFiles["data_transit_gateway_"+name+".tf"] = []byte(fmt.Sprintf(`data "aws_ec2_transit_gateway" "%s" {
+  id = "%s"
+}
+`, name, transitGatewayID))
+		}
+		for _, referenced := range g.Resources {
+			switch referenced.InstanceInfo.Type {
+			case "aws_ec2_transit_gateway":
+				terraformutils.WalkAndOverride("transit_gateway_id", referenced.InstanceState.ID,
+					"${aws_ec2_transit_gateway."+referenced.ResourceName+".id}", r.Item)
+			case "aws_ec2_transit_gateway_route_table":
+				terraformutils.WalkAndOverride("transit_gateway_route_table_id", referenced.InstanceState.ID,
+					"${aws_ec2_transit_gateway_route_table."+referenced.ResourceName+".id}", r.Item)
+			case "aws_ec2_transit_gateway_vpc_attachment":
+				terraformutils.WalkAndOverride("transit_gateway_attachment_id", referenced.InstanceState.ID,
+					"${aws_ec2_transit_gateway_vpc_attachment."+referenced.ResourceName+".id}", r.Item)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+var vpcEndpointAllowEmptyValues = []string{"tags."}
+
+type VpcEndpointGenerator struct {
+	AWSService
+}
+
+func (g *VpcEndpointGenerator) createResources(endpoints *ec2.DescribeVpcEndpointsOutput) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	for _, endpoint := range endpoints.VpcEndpoints {
+		// endpoints managed by other services (e.g. ELB, Transfer) can't be handled by terraform
+		if aws.BoolValue(endpoint.RequesterManaged) {
+			continue
+		}
+		resources = append(resources, terraformutils.NewSimpleResource(
+			aws.StringValue(endpoint.VpcEndpointId),
+			aws.StringValue(endpoint.VpcEndpointId),
+			"aws_vpc_endpoint",
+			"aws",
+			vpcEndpointAllowEmptyValues,
+		))
+	}
+	return resources
+}
+
+// Generate TerraformResources from AWS API,
+// create terraform resource for each VPC endpoint (gateway and interface)
+func (g *VpcEndpointGenerator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	svc := ec2.New(config)
+	p := ec2.NewDescribeVpcEndpointsPaginator(svc.DescribeVpcEndpointsRequest(&ec2.DescribeVpcEndpointsInput{}))
+	for p.Next(context.Background()) {
+		g.Resources = append(g.Resources, g.createResources(p.CurrentPage())...)
+	}
+	return p.Err()
+}
+
+// PostConvertHook for add policy json as heredoc
+func (g *VpcEndpointGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		if policy, ok := resource.Item["policy"].(string); ok {
+			g.Resources[i].Item["policy"] = terraformutils.Heredoc("POLICY", policy)
+		}
+	}
+	return nil
+}
@@ -16,6 +16,8 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"log"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 
@@ -25,20 +27,49 @@ import (
 
 var peeringAllowEmptyValues = []string{"tags."}
 
+const crossAccountPeeringComment = `peer_owner_id and peer_vpc_id belong to another account %s and aren't managed here,
+the peering must be accepted in that account.`
+
 type VpcPeeringConnectionGenerator struct {
 	AWSService
+	accountID string
 }
 
 func (g *VpcPeeringConnectionGenerator) createResources(peerings *ec2.DescribeVpcPeeringConnectionsOutput) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
 	for _, peering := range peerings.VpcPeeringConnections {
-		resources = append(resources, terraformutils.NewSimpleResource(
-			aws.StringValue(peering.VpcPeeringConnectionId),
-			aws.StringValue(peering.VpcPeeringConnectionId),
+		peeringID := aws.StringValue(peering.VpcPeeringConnectionId)
+		requesterOwnerID := aws.StringValue(peering.RequesterVpcInfo.OwnerId)
+		accepterOwnerID := aws.StringValue(peering.AccepterVpcInfo.OwnerId)
+		if requesterOwnerID != g.accountID {
+			// peering was requested by another account, only the accepter side can be managed from here
+			log.Printf("vpc_peering: %s requested by account %s, importing as accepter", peeringID, requesterOwnerID)
+			resources = append(resources, terraformutils.NewResource(
+				peeringID,
+				peeringID,
+				"aws_vpc_peering_connection_accepter",
+				"aws",
+				map[string]string{
+					"vpc_peering_connection_id": peeringID,
+				},
+				peeringAllowEmptyValues,
+				map[string]interface{}{},
+			))
+			continue
+		}
+		resource := terraformutils.NewSimpleResource(
+			peeringID,
+			peeringID,
 			"aws_vpc_peering_connection",
 			"aws",
 			peeringAllowEmptyValues,
-		))
+		)
+		if accepterOwnerID != g.accountID {
+			// peer_owner_id and peer_vpc_id stay as literals, they belong to another account
+			log.Printf("vpc_peering: %s peers with account %s, peer side kept as literals", peeringID, accepterOwnerID)
+			resource.Comment = fmt.Sprintf(crossAccountPeeringComment, accepterOwnerID)
+		}
+		resources = append(resources, resource)
 	}
 
 	return resources
@@ -51,6 +82,11 @@ func (g *VpcPeeringConnectionGenerator) InitResources() error {
 	if e != nil {
 		return e
 	}
+	accountID, e := g.getAccountNumber(config)
+	if e != nil {
+		return e
+	}
+	g.accountID = aws.StringValue(accountID)
 	svc := ec2.New(config)
 	p := ec2.NewDescribeVpcPeeringConnectionsPaginator(svc.DescribeVpcPeeringConnectionsRequest(&ec2.DescribeVpcPeeringConnectionsInput{}))
 	for p.Next(context.Background()) {
@@ -58,3 +94,20 @@ func (g *VpcPeeringConnectionGenerator) InitResources() error {
 	}
 	return p.Err()
 }
+
+// PostConvertHook keeps options of each peering side on resource of account owning it,
+// accepter resources accept connection on apply
+func (g *VpcPeeringConnectionGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_vpc_peering_connection_accepter":
+			g.Resources[i].Item["auto_accept"] = true
+			delete(g.Resources[i].Item, "requester")
+		case "aws_vpc_peering_connection":
+			if peerOwnerID := r.InstanceState.Attributes["peer_owner_id"]; peerOwnerID != "" && peerOwnerID != g.accountID {
+				delete(g.Resources[i].Item, "accepter")
+			}
+		}
+	}
+	return nil
+}
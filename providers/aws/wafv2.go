@@ -0,0 +1,288 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+)
+
+var wafv2AllowEmptyValues = []string{"tags."}
+
+// Wafv2Generator imports WAFv2 resources of a single scope,
+// CLOUDFRONT resources are only available through us-east-1 endpoint
+type Wafv2Generator struct {
+	AWSService
+	scope wafv2.Scope
+}
+
+func (g *Wafv2Generator) InitResources() error {
+	config, e := g.generateConfig()
+	if e != nil {
+		return e
+	}
+	if g.scope == wafv2.ScopeCloudfront {
+		config.Region = "us-east-1"
+	}
+	svc := wafv2.New(config)
+
+	if err := g.loadWebACL(svc); err != nil {
+		return err
+	}
+	if err := g.loadIPSet(svc); err != nil {
+		return err
+	}
+	if err := g.loadRegexPatternSets(svc); err != nil {
+		return err
+	}
+	if err := g.loadRuleGroups(svc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *Wafv2Generator) createResource(id, name, arn, resourceType string) terraformutils.Resource {
+	return terraformutils.NewResource(
+		id,
+		name+"_"+id[0:8],
+		resourceType,
+		"aws",
+		map[string]string{
+			"name":  name,
+			"scope": string(g.scope),
+			"arn":   arn,
+		},
+		wafv2AllowEmptyValues,
+		map[string]interface{}{},
+	)
+}
+
+func (g *Wafv2Generator) loadWebACL(svc *wafv2.Client) error {
+	var nextMarker *string
+	for {
+		output, err := svc.ListWebACLsRequest(&wafv2.ListWebACLsInput{
+			Scope:      g.scope,
+			NextMarker: nextMarker,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, acl := range output.WebACLs {
+			g.Resources = append(g.Resources, g.createResource(
+				aws.StringValue(acl.Id),
+				aws.StringValue(acl.Name),
+				aws.StringValue(acl.ARN),
+				"aws_wafv2_web_acl"))
+			if g.scope == wafv2.ScopeRegional {
+				if err := g.loadWebACLAssociations(svc, acl); err != nil {
+					return err
+				}
+			}
+		}
+		nextMarker = output.NextMarker
+		if nextMarker == nil || len(output.WebACLs) == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+// CLOUDFRONT web ACLs are associated by web_acl_id of aws_cloudfront_distribution
+func (g *Wafv2Generator) loadWebACLAssociations(svc *wafv2.Client, acl wafv2.WebACLSummary) error {
+	for _, resourceType := range []wafv2.ResourceType{wafv2.ResourceTypeApplicationLoadBalancer, wafv2.ResourceTypeApiGateway} {
+		output, err := svc.ListResourcesForWebACLRequest(&wafv2.ListResourcesForWebACLInput{
+			WebACLArn:    acl.ARN,
+			ResourceType: resourceType,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, resourceArn := range output.ResourceArns {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				aws.StringValue(acl.ARN)+","+resourceArn,
+				aws.StringValue(acl.Name)+"_"+resourceArn,
+				"aws_wafv2_web_acl_association",
+				"aws",
+				map[string]string{
+					"web_acl_arn":  aws.StringValue(acl.ARN),
+					"resource_arn": resourceArn,
+				},
+				wafv2AllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
+
+func (g *Wafv2Generator) loadIPSet(svc *wafv2.Client) error {
+	var nextMarker *string
+	for {
+		output, err := svc.ListIPSetsRequest(&wafv2.ListIPSetsInput{
+			Scope:      g.scope,
+			NextMarker: nextMarker,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, IPSet := range output.IPSets {
+			g.Resources = append(g.Resources, g.createResource(
+				aws.StringValue(IPSet.Id),
+				aws.StringValue(IPSet.Name),
+				aws.StringValue(IPSet.ARN),
+				"aws_wafv2_ip_set"))
+		}
+		nextMarker = output.NextMarker
+		if nextMarker == nil || len(output.IPSets) == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+func (g *Wafv2Generator) loadRegexPatternSets(svc *wafv2.Client) error {
+	var nextMarker *string
+	for {
+		output, err := svc.ListRegexPatternSetsRequest(&wafv2.ListRegexPatternSetsInput{
+			Scope:      g.scope,
+			NextMarker: nextMarker,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, regexPatternSet := range output.RegexPatternSets {
+			g.Resources = append(g.Resources, g.createResource(
+				aws.StringValue(regexPatternSet.Id),
+				aws.StringValue(regexPatternSet.Name),
+				aws.StringValue(regexPatternSet.ARN),
+				"aws_wafv2_regex_pattern_set"))
+		}
+		nextMarker = output.NextMarker
+		if nextMarker == nil || len(output.RegexPatternSets) == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+func (g *Wafv2Generator) loadRuleGroups(svc *wafv2.Client) error {
+	var nextMarker *string
+	for {
+		output, err := svc.ListRuleGroupsRequest(&wafv2.ListRuleGroupsInput{
+			Scope:      g.scope,
+			NextMarker: nextMarker,
+		}).Send(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, ruleGroup := range output.RuleGroups {
+			g.Resources = append(g.Resources, g.createResource(
+				aws.StringValue(ruleGroup.Id),
+				aws.StringValue(ruleGroup.Name),
+				aws.StringValue(ruleGroup.ARN),
+				"aws_wafv2_rule_group"))
+		}
+		nextMarker = output.NextMarker
+		if nextMarker == nil || len(output.RuleGroups) == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+// wafv2ReferenceStatements maps referenced resource types to statements referencing them by arn
+var wafv2ReferenceStatements = map[string]string{
+	"aws_wafv2_ip_set":            "ip_set_reference_statement",
+	"aws_wafv2_regex_pattern_set": "regex_pattern_set_reference_statement",
+	"aws_wafv2_rule_group":        "rule_group_reference_statement",
+}
+
+// linkWafv2Statements replaces arn of reference statements with reference, statements nested
+// in and/or/not statements and scope down statements are walked recursively
+func linkWafv2Statements(statements interface{}, referenceStatement, arn, reference string) {
+	list, ok := statements.([]interface{})
+	if !ok {
+		return
+	}
+	for _, statement := range list {
+		statement, ok := statement.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if blocks, ok := statement[referenceStatement].([]interface{}); ok {
+			for _, block := range blocks {
+				if block, ok := block.(map[string]interface{}); ok && block["arn"] == arn {
+					block["arn"] = reference
+				}
+			}
+		}
+		for nested, key := range map[string]string{
+			"and_statement":                "statement",
+			"or_statement":                 "statement",
+			"not_statement":                "statement",
+			"rate_based_statement":         "scope_down_statement",
+			"managed_rule_group_statement": "scope_down_statement",
+		} {
+			blocks, ok := statement[nested].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, block := range blocks {
+				if block, ok := block.(map[string]interface{}); ok {
+					linkWafv2Statements(block[key], referenceStatement, arn, reference)
+				}
+			}
+		}
+	}
+}
+
+// PostConvertHook links rule statements and associations to resources of the same scope
+func (g *Wafv2Generator) PostConvertHook() error {
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "aws_wafv2_web_acl", "aws_wafv2_rule_group":
+			rules, ok := r.Item["rule"].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, referenced := range g.Resources {
+				referenceStatement, ok := wafv2ReferenceStatements[referenced.InstanceInfo.Type]
+				if !ok {
+					continue
+				}
+				for _, rule := range rules {
+					if rule, ok := rule.(map[string]interface{}); ok {
+						linkWafv2Statements(rule["statement"],
+							referenceStatement,
+							referenced.InstanceState.Attributes["arn"],
+							"${"+referenced.InstanceInfo.Type+"."+referenced.ResourceName+".arn}")
+					}
+				}
+			}
+		case "aws_wafv2_web_acl_association":
+			for _, acl := range g.Resources {
+				if acl.InstanceInfo.Type == "aws_wafv2_web_acl" &&
+					acl.InstanceState.Attributes["arn"] == r.InstanceState.Attributes["web_acl_arn"] {
+					r.Item["web_acl_arn"] = "${aws_wafv2_web_acl." + acl.ResourceName + ".arn}"
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -34,6 +34,7 @@ func (g *AnalysisGenerator) listServiceServers() ([]terraformutils.Resource, err
 	ctx := context.Background()
 	AnalysisClient := analysisservices.NewServersClient(g.Args["config"].(authentication.Config).SubscriptionID)
 	AnalysisClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	AnalysisClient.Sender = auditedSender()
 
 	var (
 		servers analysisservices.Servers
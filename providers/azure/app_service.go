@@ -2,25 +2,75 @@ package azure
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
+	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/providerwrapper"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2019-08-01/web"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 )
 
+// secretSettingRegexp matches names of app settings which usually carry credentials
+var secretSettingRegexp = regexp.MustCompile(`(?i)(token|key|secret|password|passwd|pwd|credential|connection_?string)`)
+
+// connectionStringRegexp matches values of app settings which look like connection strings with credentials
+var connectionStringRegexp = regexp.MustCompile(`(?i)(accountkey|sharedaccesskey|password|pwd|sig)=|://[^/\s:@]+:[^/\s@]+@`)
+
+var variableNameRegexp = regexp.MustCompile(`[^a-z0-9_-]`)
+
 type AppServiceGenerator struct {
 	AzureService
 }
 
+func (g AppServiceGenerator) listAppServicePlans() ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+
+	appServicePlansClient := web.NewAppServicePlansClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	appServicePlansClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	appServicePlansClient.Sender = auditedSender()
+	var (
+		plansIterator web.AppServicePlanCollectionIterator
+		err           error
+	)
+	if rg := g.Args["resource_group"].(string); rg != "" {
+		plansIterator, err = appServicePlansClient.ListByResourceGroupComplete(ctx, rg)
+	} else {
+		plansIterator, err = appServicePlansClient.ListComplete(ctx, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for plansIterator.NotDone() {
+		plan := plansIterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*plan.ID,
+			resourceName(*plan.ID),
+			"azurerm_app_service_plan",
+			g.ProviderName,
+			[]string{}))
+
+		if err := plansIterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+
+	return resources, nil
+}
+
 func (g AppServiceGenerator) listApps() ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
 
 	appServiceClient := web.NewAppsClient(g.Args["config"].(authentication.Config).SubscriptionID)
 	appServiceClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	appServiceClient.Sender = auditedSender()
 	var (
 		appsIterator web.AppCollectionIterator
 		err          error
@@ -35,12 +85,15 @@ func (g AppServiceGenerator) listApps() ([]terraformutils.Resource, error) {
 	}
 	for appsIterator.NotDone() {
 		site := appsIterator.Value()
-		resources = append(resources, terraformutils.NewSimpleResource(
+		resource := terraformutils.NewSimpleResource(
 			*site.ID,
-			*site.Name,
-			"azurerm_app_service",
+			resourceName(*site.ID),
+			appServiceResourceType(site),
 			g.ProviderName,
-			[]string{}))
+			[]string{})
+		// site_credential contains deployment password
+		resource.IgnoreKeys = append(resource.IgnoreKeys, "^site_credential\\.")
+		resources = append(resources, resource)
 
 		if err := appsIterator.NextWithContext(ctx); err != nil {
 			log.Println(err)
@@ -51,7 +104,26 @@ func (g AppServiceGenerator) listApps() ([]terraformutils.Resource, error) {
 	return resources, nil
 }
 
+// appServiceResourceType returns azurerm_app_service for azurerm provider 2.x,
+// provider 3.x replaces it with azurerm_linux_web_app and azurerm_windows_web_app
+func appServiceResourceType(site web.Site) string {
+	version := providerwrapper.GetProviderVersion("azurerm")
+	if !strings.HasPrefix(strings.TrimPrefix(version, "~> "), "3.") {
+		return "azurerm_app_service"
+	}
+	if site.Kind != nil && strings.Contains(strings.ToLower(*site.Kind), "linux") {
+		return "azurerm_linux_web_app"
+	}
+	return "azurerm_windows_web_app"
+}
+
 func (g *AppServiceGenerator) InitResources() error {
+	plans, err := g.listAppServicePlans()
+	if err != nil {
+		return err
+	}
+	g.Resources = append(g.Resources, plans...)
+
 	resources, err := g.listApps()
 	if err != nil {
 		return err
@@ -61,3 +133,48 @@ func (g *AppServiceGenerator) InitResources() error {
 
 	return nil
 }
+
+// PostConvertHook links apps to their plans and replaces app settings with credentials and
+// all connection strings by sensitive variables
+func (g *AppServiceGenerator) PostConvertHook() error {
+	plans := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "azurerm_app_service_plan" {
+			plans[strings.ToLower(r.InstanceState.ID)] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type == "azurerm_app_service_plan" {
+			continue
+		}
+		for _, key := range []string{"app_service_plan_id", "service_plan_id"} {
+			if planID, ok := r.Item[key].(string); ok {
+				if name, exist := plans[strings.ToLower(planID)]; exist {
+					g.Resources[i].Item[key] = "${azurerm_app_service_plan." + name + ".id}"
+				}
+			}
+		}
+		if settings, ok := r.Item["app_settings"].(map[string]interface{}); ok {
+			for key, value := range settings {
+				if secretSettingRegexp.MatchString(key) || connectionStringRegexp.MatchString(fmt.Sprint(value)) {
+					settings[key] = redactSetting(&g.Resources[i], "app setting", key)
+				}
+			}
+		}
+		if connectionStrings, ok := r.Item["connection_string"].([]interface{}); ok {
+			for _, connectionString := range connectionStrings {
+				if connectionString, ok := connectionString.(map[string]interface{}); ok {
+					connectionString["value"] = redactSetting(&g.Resources[i], "connection string", fmt.Sprint(connectionString["name"]))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// redactSetting adds sensitive variable for setting value to resource data files and returns reference to it
+func redactSetting(r *terraformutils.Resource, kind, key string) string {
+	variable := strings.TrimPrefix(r.ResourceName, "tfer--") + "_" + variableNameRegexp.ReplaceAllString(strings.ToLower(key), "_")
+	log.Printf("azurerm: %s %s of %s is a secret, set variable %s", kind, key, r.InstanceState.ID, variable)
+	return r.AddSensitiveVariable(variable, "Value of "+kind+" "+key+" of "+r.InstanceState.ID)
+}
@@ -51,11 +51,9 @@ func (p *AzureProvider) setEnvConfig() error {
 		ClientCertPath:           os.Getenv("ARM_CLIENT_CERTIFICATE_PATH"),
 		ClientCertPassword:       os.Getenv("ARM_CLIENT_CERTIFICATE_PASSWORD"),
 
-		/*
-		   // Managed Service Identity Auth
-		   SupportsManagedServiceIdentity bool
-		   MsiEndpoint                    string
-		*/
+		// Managed Service Identity Auth
+		SupportsManagedServiceIdentity: os.Getenv("ARM_USE_MSI") == "true",
+		MsiEndpoint:                    os.Getenv("ARM_MSI_ENDPOINT"),
 	}
 
 	if builder.Environment == "" {
@@ -63,7 +61,7 @@ func (p *AzureProvider) setEnvConfig() error {
 	}
 	config, err := builder.Build()
 	if err != nil {
-		return nil
+		return err
 	}
 	p.config = *config
 
@@ -148,6 +146,9 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 		"app_service": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
+		"cdn": {
+			"resource_group": []string{"resource_group_name", "name"},
+		},
 		"cosmosdb": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
@@ -163,6 +164,12 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 		"dns": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
+		"eventhub": {
+			"resource_group": []string{"resource_group_name", "name"},
+		},
+		"frontdoor": {
+			"resource_group": []string{"resource_group_name", "name"},
+		},
 		"keyvault": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
@@ -170,7 +177,9 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
 		"network_interface": {
-			"resource_group": []string{"resource_group_name", "name"},
+			"resource_group":  []string{"resource_group_name", "name"},
+			"virtual_network": []string{"ip_configuration.subnet_id", "id"},
+			"public_ip":       []string{"ip_configuration.public_ip_address_id", "id"},
 		},
 		"network_security_group": {
 			"resource_group": []string{"resource_group_name", "name"},
@@ -187,6 +196,12 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 		"scaleset": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
+		"servicebus": {
+			"resource_group": []string{"resource_group_name", "name"},
+		},
+		"spring_cloud": {
+			"resource_group": []string{"resource_group_name", "name"},
+		},
 		"storage_account": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
@@ -198,10 +213,12 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 			"storage_account": []string{"storage_account_name", "name"},
 		},
 		"virtual_machine": {
-			"resource_group": []string{"resource_group_name", "name"},
+			"resource_group":    []string{"resource_group_name", "name"},
+			"network_interface": []string{"network_interface_ids", "id"},
 		},
 		"virtual_network": {
-			"resource_group": []string{"resource_group_name", "name"},
+			"resource_group":         []string{"resource_group_name", "name"},
+			"network_security_group": []string{"network_security_group_id", "id"},
 		},
 	}
 }
@@ -210,11 +227,14 @@ func (p *AzureProvider) GetSupportedService() map[string]terraformutils.ServiceG
 	return map[string]terraformutils.ServiceGenerator{
 		"analysis":                             &AnalysisGenerator{},
 		"app_service":                          &AppServiceGenerator{},
+		"cdn":                                  &CDNGenerator{},
 		"cosmosdb":                             &CosmosDBGenerator{},
 		"container":                            &ContainerGenerator{},
 		"database":                             &DatabasesGenerator{},
 		"disk":                                 &DiskGenerator{},
 		"dns":                                  &DNSGenerator{},
+		"eventhub":                             &EventHubGenerator{},
+		"frontdoor":                            &FrontDoorGenerator{},
 		"keyvault":                             &KeyVaultGenerator{},
 		"load_balancer":                        &LoadBalancerGenerator{},
 		"network_interface":                    &NetworkInterfaceGenerator{},
@@ -226,6 +246,8 @@ func (p *AzureProvider) GetSupportedService() map[string]terraformutils.ServiceG
 		"scaleset":                             &ScaleSetGenerator{},
 		"security_center_contact":              &SecurityCenterContactGenerator{},
 		"security_center_subscription_pricing": &SecurityCenterSubscriptionPricingGenerator{},
+		"servicebus":                           &ServiceBusGenerator{},
+		"spring_cloud":                         &SpringCloudGenerator{},
 		"storage_account":                      &StorageAccountGenerator{},
 		"storage_blob":                         &StorageBlobGenerator{},
 		"storage_container":                    &StorageContainerGenerator{},
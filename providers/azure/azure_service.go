@@ -15,9 +15,19 @@
 package azure
 
 import (
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 )
 
 type AzureService struct { //nolint
 	terraformutils.Service
 }
+
+// auditedSender returns sender writing calls to audit log, nil (autorest default sender) when audit log is disabled
+func auditedSender() autorest.Sender {
+	logger := terraformutils.GetAPIAuditLogger()
+	if logger == nil {
+		return nil
+	}
+	return logger.Doer(autorest.CreateSender())
+}
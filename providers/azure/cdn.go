@@ -0,0 +1,161 @@
+// Copyright 2021 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2019-04-15/cdn"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+type CDNGenerator struct {
+	AzureService
+}
+
+func (g *CDNGenerator) listProfiles() ([]cdn.Profile, error) {
+	ctx := context.Background()
+	profilesClient := cdn.NewProfilesClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	profilesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	profilesClient.Sender = auditedSender()
+
+	var (
+		iterator cdn.ProfileListResultIterator
+		err      error
+	)
+	if rg := g.Args["resource_group"].(string); rg != "" {
+		iterator, err = profilesClient.ListByResourceGroupComplete(ctx, rg)
+	} else {
+		iterator, err = profilesClient.ListComplete(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var profiles []cdn.Profile
+	for iterator.NotDone() {
+		profiles = append(profiles, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return profiles, err
+		}
+	}
+	return profiles, nil
+}
+
+func (g *CDNGenerator) listEndpoints(resourceGroupName, profileName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	endpointsClient := cdn.NewEndpointsClient(subscriptionID)
+	endpointsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	endpointsClient.Sender = auditedSender()
+	customDomainsClient := cdn.NewCustomDomainsClient(subscriptionID)
+	customDomainsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	customDomainsClient.Sender = auditedSender()
+
+	iterator, err := endpointsClient.ListByProfileComplete(ctx, resourceGroupName, profileName)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		endpoint := iterator.Value()
+		endpointName := profileName + "_" + *endpoint.Name
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*endpoint.ID,
+			endpointName,
+			"azurerm_cdn_endpoint",
+			g.ProviderName,
+			[]string{}))
+
+		domains, err := customDomainsClient.ListByEndpointComplete(ctx, resourceGroupName, profileName, *endpoint.Name)
+		if err != nil {
+			return resources, err
+		}
+		for domains.NotDone() {
+			domain := domains.Value()
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*domain.ID,
+				endpointName+"_"+*domain.Name,
+				"azurerm_cdn_endpoint_custom_domain",
+				g.ProviderName,
+				[]string{}))
+			if err := domains.NextWithContext(ctx); err != nil {
+				return resources, err
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *CDNGenerator) InitResources() error {
+	profiles, err := g.listProfiles()
+	if err != nil {
+		return err
+	}
+	for _, profile := range profiles {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			*profile.ID,
+			*profile.Name,
+			"azurerm_cdn_profile",
+			g.ProviderName,
+			[]string{}))
+
+		id, err := ParseAzureResourceID(*profile.ID)
+		if err != nil {
+			return err
+		}
+		endpoints, err := g.listEndpoints(id.ResourceGroup, *profile.Name)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, endpoints...)
+	}
+	return nil
+}
+
+// PostConvertHook orders delivery rules by their priority, the API doesn't guarantee it
+func (g *CDNGenerator) PostConvertHook() error {
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type != "azurerm_cdn_endpoint" {
+			continue
+		}
+		rules, ok := r.Item["delivery_rule"].([]interface{})
+		if !ok {
+			continue
+		}
+		sort.SliceStable(rules, func(i, j int) bool {
+			return deliveryRuleOrder(rules[i]) < deliveryRuleOrder(rules[j])
+		})
+	}
+	return nil
+}
+
+func deliveryRuleOrder(rule interface{}) int {
+	if rule, ok := rule.(map[string]interface{}); ok {
+		if order, ok := rule["order"].(string); ok {
+			if value, err := strconv.Atoi(order); err == nil {
+				return value
+			}
+		}
+	}
+	return 0
+}
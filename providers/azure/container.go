@@ -35,6 +35,7 @@ func (g *ContainerGenerator) listAndAddForContainerGroup() ([]terraformutils.Res
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	ContainerGroupsClient := containerinstance.NewContainerGroupsClient(subscriptionID)
 	ContainerGroupsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	ContainerGroupsClient.Sender = auditedSender()
 
 	var (
 		containerGroupIterator containerinstance.ContainerGroupListResultIterator
@@ -73,6 +74,7 @@ func (g *ContainerGenerator) listRegistryWebhooks(resourceGroupName string, regi
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	WebhooksClient := containerregistry.NewWebhooksClient(subscriptionID)
 	WebhooksClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	WebhooksClient.Sender = auditedSender()
 
 	webhookIterator, err := WebhooksClient.ListComplete(ctx, resourceGroupName, registryName)
 	if err != nil {
@@ -101,6 +103,7 @@ func (g *ContainerGenerator) listAndAddForContainerRegistry() ([]terraformutils.
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	ContainerRegistriesClient := containerregistry.NewRegistriesClient(subscriptionID)
 	ContainerRegistriesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	ContainerRegistriesClient.Sender = auditedSender()
 
 	var (
 		containerRegistryIterator containerregistry.RegistryListResultIterator
@@ -35,6 +35,7 @@ func (g *CosmosDBGenerator) listSQLDatabasesAndContainersBehind(resourceGroupNam
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	SQLResourcesClient := documentdb.NewSQLResourcesClient(subscriptionID, subscriptionID)
 	SQLResourcesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	SQLResourcesClient.Sender = auditedSender()
 
 	sqlDatabases, err := SQLResourcesClient.ListSQLDatabases(ctx, resourceGroupName, accountName)
 	if err != nil {
@@ -87,6 +88,7 @@ func (g *CosmosDBGenerator) listTables(resourceGroupName string, accountName str
 	// https://github.com/Azure/azure-sdk-for-go/blob/v44.0.0/services/cosmos-db/mgmt/2020-03-01/documentdb/tableresources.go#L35
 	TableResourcesClient := documentdb.NewTableResourcesClient(subscriptionID, subscriptionID)
 	TableResourcesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	TableResourcesClient.Sender = auditedSender()
 
 	tables, err := TableResourcesClient.ListTables(ctx, resourceGroupName, accountName)
 	if err != nil {
@@ -114,6 +116,7 @@ func (g *CosmosDBGenerator) listAndAddForDatabaseAccounts() ([]terraformutils.Re
 	// https://github.com/Azure/azure-sdk-for-go/blob/v44.0.0/services/cosmos-db/mgmt/2020-03-01/documentdb/databaseaccounts.go#L35
 	DatabaseAccountsClient := documentdb.NewDatabaseAccountsClient(subscriptionID, subscriptionID)
 	DatabaseAccountsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	DatabaseAccountsClient.Sender = auditedSender()
 
 	var (
 		accounts documentdb.DatabaseAccountsListResult
@@ -39,6 +39,7 @@ func (g *DatabasesGenerator) getMariaDBServers() ([]mariadb.Server, error) {
 
 	Client := mariadb.NewServersClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	var (
 		Servers mariadb.ServerListResult
@@ -83,6 +84,7 @@ func (g *DatabasesGenerator) createMariaDBConfigurationResources(servers []maria
 
 	Client := mariadb.NewConfigurationsClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -115,6 +117,7 @@ func (g *DatabasesGenerator) createMariaDBDatabaseResources(servers []mariadb.Se
 
 	Client := mariadb.NewDatabasesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -147,6 +150,7 @@ func (g *DatabasesGenerator) createMariaDBFirewallRuleResources(servers []mariad
 
 	Client := mariadb.NewFirewallRulesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
 		if err != nil {
@@ -178,6 +182,7 @@ func (g *DatabasesGenerator) createMariaDBVirtualNetworkRuleResources(servers []
 
 	Client := mariadb.NewVirtualNetworkRulesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -212,6 +217,7 @@ func (g *DatabasesGenerator) getMySQLServers() ([]mysql.Server, error) {
 
 	Client := mysql.NewServersClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	var (
 		Servers mysql.ServerListResult
@@ -257,6 +263,7 @@ func (g *DatabasesGenerator) createMySQLConfigurationResources(servers []mysql.S
 
 	Client := mysql.NewConfigurationsClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -289,6 +296,7 @@ func (g *DatabasesGenerator) createMySQLDatabaseResources(servers []mysql.Server
 
 	Client := mysql.NewDatabasesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -320,6 +328,7 @@ func (g *DatabasesGenerator) createMySQLFirewallRuleResources(servers []mysql.Se
 
 	Client := mysql.NewFirewallRulesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -352,6 +361,7 @@ func (g *DatabasesGenerator) createMySQLVirtualNetworkRuleResources(servers []my
 
 	Client := mysql.NewVirtualNetworkRulesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -389,6 +399,7 @@ func (g *DatabasesGenerator) getPostgreSQLServers() ([]postgresql.Server, error)
 
 	Client := postgresql.NewServersClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	var (
 		Servers postgresql.ServerListResult
@@ -435,6 +446,7 @@ func (g *DatabasesGenerator) createPostgreSQLDatabaseResources(servers []postgre
 
 	Client := postgresql.NewDatabasesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -465,6 +477,7 @@ func (g *DatabasesGenerator) createPostgreSQLConfigurationResources(servers []po
 	Authorizer := g.Args["authorizer"].(autorest.Authorizer)
 	Client := postgresql.NewConfigurationsClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -496,6 +509,7 @@ func (g *DatabasesGenerator) createPostgreSQLFirewallRuleResources(servers []pos
 
 	Client := postgresql.NewFirewallRulesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -527,6 +541,7 @@ func (g *DatabasesGenerator) createPostgreSQLVirtualNetworkRuleResources(servers
 
 	Client := postgresql.NewVirtualNetworkRulesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -559,6 +574,7 @@ func (g *DatabasesGenerator) getSQLServers() ([]sql.Server, error) {
 
 	Client := sql.NewServersClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	var (
 		ServerPages sql.ServerListResultPage
@@ -610,6 +626,7 @@ func (g *DatabasesGenerator) createSQLDatabaseResources(servers []sql.Server) ([
 
 	Client := sql.NewDatabasesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -641,6 +658,7 @@ func (g *DatabasesGenerator) createSQLFirewallRuleResources(servers []sql.Server
 
 	Client := sql.NewFirewallRulesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -672,6 +690,7 @@ func (g *DatabasesGenerator) createSQLVirtualNetworkRuleResources(servers []sql.
 
 	Client := sql.NewVirtualNetworkRulesClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -707,6 +726,7 @@ func (g *DatabasesGenerator) createSQLElasticPoolResources(servers []sql.Server)
 
 	Client := sql.NewElasticPoolsClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -738,6 +758,7 @@ func (g *DatabasesGenerator) createSQLFailoverResources(servers []sql.Server) ([
 
 	Client := sql.NewFailoverGroupsClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -772,6 +793,7 @@ func (g *DatabasesGenerator) createSQLADAdministratorResources(servers []sql.Ser
 
 	Client := sql.NewServerAzureADAdministratorsClient(SubscriptionID)
 	Client.Authorizer = Authorizer
+	Client.Sender = auditedSender()
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
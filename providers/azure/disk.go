@@ -51,6 +51,7 @@ func (g *DiskGenerator) InitResources() error {
 	disksClient := compute.NewDisksClient(g.Args["config"].(authentication.Config).SubscriptionID)
 
 	disksClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	disksClient.Sender = auditedSender()
 
 	var (
 		output compute.DiskListIterator
@@ -35,6 +35,7 @@ func (g *DNSGenerator) listRecordSets(resourceGroupName string, zoneName string,
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	RecordSetsClient := dns.NewRecordSetsClient(subscriptionID)
 	RecordSetsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	RecordSetsClient.Sender = auditedSender()
 
 	recordSetIterator, err := RecordSetsClient.ListAllByDNSZoneComplete(ctx, resourceGroupName, zoneName, top, "")
 	if err != nil {
@@ -81,6 +82,7 @@ func (g *DNSGenerator) listAndAddForDNSZone() ([]terraformutils.Resource, error)
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	DNSZonesClient := dns.NewZonesClient(subscriptionID)
 	DNSZonesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	DNSZonesClient.Sender = auditedSender()
 
 	var pageSize int32 = 50
 
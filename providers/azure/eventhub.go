@@ -0,0 +1,202 @@
+// Copyright 2021 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// Keys and connection strings of authorization rules are secrets, they are never written to HCL
+var eventHubAuthorizationRuleIgnoreKeys = []string{
+	"^primary_key$",
+	"^secondary_key$",
+	"^primary_connection_string(.*)",
+	"^secondary_connection_string(.*)",
+}
+
+type EventHubGenerator struct {
+	AzureService
+}
+
+func (g *EventHubGenerator) listNamespaces() ([]eventhub.EHNamespace, error) {
+	ctx := context.Background()
+	namespacesClient := eventhub.NewNamespacesClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	namespacesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	namespacesClient.Sender = auditedSender()
+
+	var (
+		iterator eventhub.EHNamespaceListResultIterator
+		err      error
+	)
+	if rg := g.Args["resource_group"].(string); rg != "" {
+		iterator, err = namespacesClient.ListByResourceGroupComplete(ctx, rg)
+	} else {
+		iterator, err = namespacesClient.ListComplete(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var namespaces []eventhub.EHNamespace
+	for iterator.NotDone() {
+		namespaces = append(namespaces, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return namespaces, err
+		}
+	}
+	return namespaces, nil
+}
+
+func (g *EventHubGenerator) newAuthorizationRuleResource(rule eventhub.AuthorizationRule, resourceName, resourceType string) terraformutils.Resource {
+	r := terraformutils.NewSimpleResource(
+		*rule.ID,
+		resourceName,
+		resourceType,
+		g.ProviderName,
+		[]string{})
+	r.IgnoreKeys = append(r.IgnoreKeys, eventHubAuthorizationRuleIgnoreKeys...)
+	return r
+}
+
+func (g *EventHubGenerator) listNamespaceAuthorizationRules(resourceGroupName, namespaceName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	namespacesClient := eventhub.NewNamespacesClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	namespacesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	namespacesClient.Sender = auditedSender()
+
+	iterator, err := namespacesClient.ListAuthorizationRulesComplete(ctx, resourceGroupName, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		rule := iterator.Value()
+		resources = append(resources, g.newAuthorizationRuleResource(
+			rule,
+			namespaceName+"_"+*rule.Name,
+			"azurerm_eventhub_namespace_authorization_rule"))
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *EventHubGenerator) listEventHubs(resourceGroupName string, namespace eventhub.EHNamespace) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	eventHubsClient := eventhub.NewEventHubsClient(subscriptionID)
+	eventHubsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	eventHubsClient.Sender = auditedSender()
+	consumerGroupsClient := eventhub.NewConsumerGroupsClient(subscriptionID)
+	consumerGroupsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	consumerGroupsClient.Sender = auditedSender()
+
+	namespaceName := *namespace.Name
+	iterator, err := eventHubsClient.ListByNamespaceComplete(ctx, resourceGroupName, namespaceName, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		hub := iterator.Value()
+		hubName := namespaceName + "_" + *hub.Name
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*hub.ID,
+			hubName,
+			"azurerm_eventhub",
+			g.ProviderName,
+			[]string{}))
+
+		rules, err := eventHubsClient.ListAuthorizationRulesComplete(ctx, resourceGroupName, namespaceName, *hub.Name)
+		if err != nil {
+			return resources, err
+		}
+		for rules.NotDone() {
+			rule := rules.Value()
+			resources = append(resources, g.newAuthorizationRuleResource(
+				rule,
+				hubName+"_"+*rule.Name,
+				"azurerm_eventhub_authorization_rule"))
+			if err := rules.NextWithContext(ctx); err != nil {
+				return resources, err
+			}
+		}
+
+		// Basic namespaces only have the built-in $Default consumer group
+		if namespace.Sku == nil || namespace.Sku.Name != eventhub.Basic {
+			groups, err := consumerGroupsClient.ListByEventHubComplete(ctx, resourceGroupName, namespaceName, *hub.Name, nil, nil)
+			if err != nil {
+				return resources, err
+			}
+			for groups.NotDone() {
+				group := groups.Value()
+				// $Default is created with the event hub and can't be managed
+				if *group.Name != "$Default" {
+					resources = append(resources, terraformutils.NewSimpleResource(
+						*group.ID,
+						hubName+"_"+*group.Name,
+						"azurerm_eventhub_consumer_group",
+						g.ProviderName,
+						[]string{}))
+				}
+				if err := groups.NextWithContext(ctx); err != nil {
+					return resources, err
+				}
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *EventHubGenerator) InitResources() error {
+	namespaces, err := g.listNamespaces()
+	if err != nil {
+		return err
+	}
+	for _, namespace := range namespaces {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			*namespace.ID,
+			*namespace.Name,
+			"azurerm_eventhub_namespace",
+			g.ProviderName,
+			[]string{}))
+
+		id, err := ParseAzureResourceID(*namespace.ID)
+		if err != nil {
+			return err
+		}
+		rules, err := g.listNamespaceAuthorizationRules(id.ResourceGroup, *namespace.Name)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, rules...)
+
+		hubs, err := g.listEventHubs(id.ResourceGroup, namespace)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, hubs...)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/frontdoor/mgmt/2020-01-01/frontdoor"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+type FrontDoorGenerator struct {
+	AzureService
+}
+
+func (g FrontDoorGenerator) createResources(ctx context.Context, iterator frontdoor.ListResultIterator) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	for iterator.NotDone() {
+		frontDoor := iterator.Value()
+		// routing rules, backend pools and health probes are nested blocks of the front door resource
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*frontDoor.ID,
+			*frontDoor.Name,
+			"azurerm_frontdoor",
+			g.ProviderName,
+			[]string{}))
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *FrontDoorGenerator) InitResources() error {
+	ctx := context.Background()
+	frontDoorsClient := frontdoor.NewFrontDoorsClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	frontDoorsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	frontDoorsClient.Sender = auditedSender()
+
+	var (
+		output frontdoor.ListResultIterator
+		err    error
+	)
+	if rg := g.Args["resource_group"].(string); rg != "" {
+		output, err = frontDoorsClient.ListByResourceGroupComplete(ctx, rg)
+	} else {
+		output, err = frontDoorsClient.ListComplete(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	g.Resources, err = g.createResources(ctx, output)
+	return err
+}
@@ -106,3 +106,31 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 
 	return idObj, nil
 }
+
+// resourceName builds readable resource name from resource ID, resource group followed by names
+// of resource and its parents, for example rg-vnet-subnet, names of types are omitted
+func resourceName(id string) string {
+	components := strings.Split(strings.Trim(id, "/"), "/")
+	names := []string{}
+	for i := 0; i+1 < len(components); i += 2 {
+		switch strings.ToLower(components[i]) {
+		case "resourcegroups":
+			names = append(names, components[i+1])
+		case "providers":
+			// providers/<namespace>/<type>/<name>, skip namespace
+			i++
+			if i+2 < len(components) {
+				names = append(names, components[i+2])
+			}
+			i++
+		default:
+			if len(names) > 0 {
+				names = append(names, components[i+1])
+			}
+		}
+	}
+	if len(names) == 0 {
+		return id
+	}
+	return strings.Join(names, "-")
+}
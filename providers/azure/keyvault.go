@@ -77,6 +77,7 @@ func (g *KeyVaultGenerator) InitResources() error {
 	vaultsClient := keyvault.NewVaultsClient(g.Args["config"].(authentication.Config).SubscriptionID)
 
 	vaultsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	vaultsClient.Sender = auditedSender()
 
 	var err error
 	if rg := g.Args["resource_group"].(string); rg != "" {
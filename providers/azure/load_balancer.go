@@ -36,6 +36,7 @@ func (g *LoadBalancerGenerator) listLoadBalancerProbes(resourceGroupName string,
 
 	LoadBalancerProbesClient := network.NewLoadBalancerProbesClient(subscriptionID)
 	LoadBalancerProbesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	LoadBalancerProbesClient.Sender = auditedSender()
 	loadBalancerProbeIterator, err := LoadBalancerProbesClient.ListComplete(ctx, resourceGroupName, loadBalancerName)
 
 	if err != nil {
@@ -80,6 +81,7 @@ func (g *LoadBalancerGenerator) listInboundNatRules(resourceGroupName string, lo
 
 	InboundNatRulesClient := network.NewInboundNatRulesClient(subscriptionID)
 	InboundNatRulesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	InboundNatRulesClient.Sender = auditedSender()
 	InboundNatRuleIterator, err := InboundNatRulesClient.ListComplete(ctx, resourceGroupName, loadBalancerName)
 
 	if err != nil {
@@ -119,6 +121,7 @@ func (g *LoadBalancerGenerator) listLoadBalancerBackendAddressPools(resourceGrou
 
 	LoadBalancerBackendAddressPoolsClient := network.NewLoadBalancerBackendAddressPoolsClient(subscriptionID)
 	LoadBalancerBackendAddressPoolsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	LoadBalancerBackendAddressPoolsClient.Sender = auditedSender()
 	loadBalancerBackendAddressPoolIterator, err := LoadBalancerBackendAddressPoolsClient.ListComplete(ctx, resourceGroupName, loadBalancerName)
 
 	if err != nil {
@@ -157,6 +160,7 @@ func (g *LoadBalancerGenerator) listAndAddForLoadBalancers() ([]terraformutils.R
 
 	LoadBalancersClient := network.NewLoadBalancersClient(subscriptionID)
 	LoadBalancersClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	LoadBalancersClient.Sender = auditedSender()
 
 	var (
 		loadBalancerIterator network.LoadBalancerListResultIterator
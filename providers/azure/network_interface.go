@@ -34,7 +34,7 @@ func (g NetworkInterfaceGenerator) createResources(interfaceListResult network.I
 		networkInterface := interfaceListResult.Value()
 		resources = append(resources, terraformutils.NewSimpleResource(
 			*networkInterface.ID,
-			*networkInterface.Name,
+			resourceName(*networkInterface.ID),
 			"azurerm_network_interface",
 			"azurerm",
 			[]string{}))
@@ -51,6 +51,7 @@ func (g *NetworkInterfaceGenerator) InitResources() error {
 	interfacesClient := network.NewInterfacesClient(g.Args["config"].(authentication.Config).SubscriptionID)
 
 	interfacesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	interfacesClient.Sender = auditedSender()
 	var (
 		output network.InterfaceListResultIterator
 		err    error
@@ -17,6 +17,7 @@ package azure
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-08-01/network"
 	"github.com/Azure/go-autorest/autorest"
@@ -32,12 +33,25 @@ func (g NetworkSecurityGroupGenerator) createResources(securityGroupListResult n
 	var resources []terraformutils.Resource
 	for securityGroupListResult.NotDone() {
 		nsg := securityGroupListResult.Value()
-		resources = append(resources, terraformutils.NewSimpleResource(
+		securityGroup := terraformutils.NewSimpleResource(
 			*nsg.ID,
-			*nsg.Name+"-"+*nsg.ID,
+			resourceName(*nsg.ID),
 			"azurerm_network_security_group",
 			"azurerm",
-			[]string{}))
+			[]string{})
+		// rules are imported as azurerm_network_security_rule, inline rules would conflict with them
+		securityGroup.IgnoreKeys = append(securityGroup.IgnoreKeys, "^security_rule\\.")
+		resources = append(resources, securityGroup)
+		if nsg.SecurityGroupPropertiesFormat != nil && nsg.SecurityRules != nil {
+			for _, rule := range *nsg.SecurityRules {
+				resources = append(resources, terraformutils.NewSimpleResource(
+					*rule.ID,
+					resourceName(*rule.ID),
+					"azurerm_network_security_rule",
+					"azurerm",
+					[]string{}))
+			}
+		}
 		if err := securityGroupListResult.Next(); err != nil {
 			log.Println(err)
 			return resources, err
@@ -50,6 +64,7 @@ func (g *NetworkSecurityGroupGenerator) InitResources() error {
 	ctx := context.Background()
 	securityGroupsClient := network.NewSecurityGroupsClient(g.Args["config"].(authentication.Config).SubscriptionID)
 	securityGroupsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	securityGroupsClient.Sender = auditedSender()
 
 	var (
 		output network.SecurityGroupListResultIterator
@@ -66,3 +81,26 @@ func (g *NetworkSecurityGroupGenerator) InitResources() error {
 	g.Resources, err = g.createResources(output)
 	return err
 }
+
+// PostConvertHook links security rules to their network security groups
+func (g *NetworkSecurityGroupGenerator) PostConvertHook() error {
+	securityGroups := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "azurerm_network_security_group" {
+			securityGroups[strings.ToLower(r.InstanceState.ID)] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type != "azurerm_network_security_rule" {
+			continue
+		}
+		index := strings.LastIndex(strings.ToLower(r.InstanceState.ID), "/securityrules/")
+		if index < 0 {
+			continue
+		}
+		if name, exist := securityGroups[strings.ToLower(r.InstanceState.ID[:index])]; exist {
+			g.Resources[i].Item["network_security_group_name"] = "${azurerm_network_security_group." + name + ".name}"
+		}
+	}
+	return nil
+}
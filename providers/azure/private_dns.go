@@ -35,6 +35,7 @@ func (g *PrivateDNSGenerator) listRecordSets(resourceGroupName string, privateZo
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	RecordSetsClient := privatedns.NewRecordSetsClient(subscriptionID)
 	RecordSetsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	RecordSetsClient.Sender = auditedSender()
 
 	recordSetIterator, err := RecordSetsClient.ListComplete(ctx, resourceGroupName, privateZoneName, top, "")
 	if err != nil {
@@ -79,6 +80,7 @@ func (g *PrivateDNSGenerator) listVirtualNetworkLinks(resourceGroupName string,
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	VirtualNetworkLinksClient := privatedns.NewVirtualNetworkLinksClient(subscriptionID)
 	VirtualNetworkLinksClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	VirtualNetworkLinksClient.Sender = auditedSender()
 
 	virtualNetworkLinkIterator, err := VirtualNetworkLinksClient.ListComplete(ctx, resourceGroupName, privateZoneName, pageSize)
 	if err != nil {
@@ -109,6 +111,7 @@ func (g *PrivateDNSGenerator) listAndAddForPrivateDNSZone() ([]terraformutils.Re
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	PrivateDNSZonesClient := privatedns.NewPrivateZonesClient(subscriptionID)
 	PrivateDNSZonesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	PrivateDNSZonesClient.Sender = auditedSender()
 
 	var pageSize int32 = 50
 
@@ -34,6 +34,7 @@ func (g *PublicIPGenerator) listAndAddForPublicIPAddress() ([]terraformutils.Res
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	PublicIPAddressesClient := network.NewPublicIPAddressesClient(subscriptionID)
 	PublicIPAddressesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	PublicIPAddressesClient.Sender = auditedSender()
 
 	var (
 		publicIPAddressIterator network.PublicIPAddressListResultIterator
@@ -71,6 +72,7 @@ func (g *PublicIPGenerator) listAndAddForPublicIPPrefix() ([]terraformutils.Reso
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
 	PublicIPPrefixesClient := network.NewPublicIPPrefixesClient(subscriptionID)
 	PublicIPPrefixesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	PublicIPPrefixesClient.Sender = auditedSender()
 
 	var (
 		publicIPPrefixIterator network.PublicIPPrefixListResultIterator
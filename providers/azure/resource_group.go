@@ -51,6 +51,7 @@ func (g *ResourceGroupGenerator) InitResources() error {
 	groupsClient := resources.NewGroupsClient(g.Args["config"].(authentication.Config).SubscriptionID)
 
 	groupsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	groupsClient.Sender = auditedSender()
 
 	if rg := g.Args["resource_group"].(string); rg != "" {
 		group, err := groupsClient.Get(ctx, rg)
@@ -79,6 +79,7 @@ func (g *ScaleSetGenerator) InitResources() error {
 	ScaleSetClient := compute.NewVirtualMachineScaleSetsClient(g.Args["config"].(authentication.Config).SubscriptionID)
 
 	ScaleSetClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	ScaleSetClient.Sender = auditedSender()
 
 	if rg := g.Args["resource_group"].(string); rg != "" {
 		var err error
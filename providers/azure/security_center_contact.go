@@ -21,6 +21,7 @@ func (g SecurityCenterContactGenerator) listContacts() ([]terraformutils.Resourc
 
 	securityCenterContactClient := security.NewContactsClient(subscriptionID, "")
 	securityCenterContactClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	securityCenterContactClient.Sender = auditedSender()
 
 	if rg := g.Args["resource_group"].(string); rg != "" {
 		return resources, nil
@@ -21,6 +21,7 @@ func (g SecurityCenterSubscriptionPricingGenerator) listSubscriptionPricing() ([
 
 	securityCenterPricingClient := security.NewPricingsClient(subscriptionID, "")
 	securityCenterPricingClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	securityCenterPricingClient.Sender = auditedSender()
 
 	if rg := g.Args["resource_group"].(string); rg != "" {
 		return resources, nil
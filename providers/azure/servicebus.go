@@ -0,0 +1,213 @@
+// Copyright 2021 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+type ServiceBusGenerator struct {
+	AzureService
+}
+
+func (g *ServiceBusGenerator) listNamespaces() ([]servicebus.SBNamespace, error) {
+	ctx := context.Background()
+	namespacesClient := servicebus.NewNamespacesClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	namespacesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	namespacesClient.Sender = auditedSender()
+
+	var (
+		iterator servicebus.SBNamespaceListResultIterator
+		err      error
+	)
+	if rg := g.Args["resource_group"].(string); rg != "" {
+		iterator, err = namespacesClient.ListByResourceGroupComplete(ctx, rg)
+	} else {
+		iterator, err = namespacesClient.ListComplete(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var namespaces []servicebus.SBNamespace
+	for iterator.NotDone() {
+		namespaces = append(namespaces, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return namespaces, err
+		}
+	}
+	return namespaces, nil
+}
+
+func (g *ServiceBusGenerator) listQueues(resourceGroupName, namespaceName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	queuesClient := servicebus.NewQueuesClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	queuesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	queuesClient.Sender = auditedSender()
+
+	iterator, err := queuesClient.ListByNamespaceComplete(ctx, resourceGroupName, namespaceName, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		queue := iterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*queue.ID,
+			namespaceName+"_"+*queue.Name,
+			"azurerm_servicebus_queue",
+			g.ProviderName,
+			[]string{}))
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *ServiceBusGenerator) listSubscriptionRules(resourceGroupName, namespaceName, topicName, subscriptionName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	rulesClient := servicebus.NewRulesClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	rulesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	rulesClient.Sender = auditedSender()
+
+	iterator, err := rulesClient.ListBySubscriptionsComplete(ctx, resourceGroupName, namespaceName, topicName, subscriptionName, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		rule := iterator.Value()
+		// $Default is created together with the subscription
+		if *rule.Name != "$Default" {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*rule.ID,
+				namespaceName+"_"+topicName+"_"+subscriptionName+"_"+*rule.Name,
+				"azurerm_servicebus_subscription_rule",
+				g.ProviderName,
+				[]string{}))
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *ServiceBusGenerator) listSubscriptions(resourceGroupName, namespaceName, topicName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionsClient := servicebus.NewSubscriptionsClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	subscriptionsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	subscriptionsClient.Sender = auditedSender()
+
+	iterator, err := subscriptionsClient.ListByTopicComplete(ctx, resourceGroupName, namespaceName, topicName, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		subscription := iterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*subscription.ID,
+			namespaceName+"_"+topicName+"_"+*subscription.Name,
+			"azurerm_servicebus_subscription",
+			g.ProviderName,
+			[]string{}))
+
+		rules, err := g.listSubscriptionRules(resourceGroupName, namespaceName, topicName, *subscription.Name)
+		if err != nil {
+			return resources, err
+		}
+		resources = append(resources, rules...)
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *ServiceBusGenerator) listTopics(resourceGroupName, namespaceName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	topicsClient := servicebus.NewTopicsClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	topicsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	topicsClient.Sender = auditedSender()
+
+	iterator, err := topicsClient.ListByNamespaceComplete(ctx, resourceGroupName, namespaceName, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		topic := iterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*topic.ID,
+			namespaceName+"_"+*topic.Name,
+			"azurerm_servicebus_topic",
+			g.ProviderName,
+			[]string{}))
+
+		subscriptions, err := g.listSubscriptions(resourceGroupName, namespaceName, *topic.Name)
+		if err != nil {
+			return resources, err
+		}
+		resources = append(resources, subscriptions...)
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *ServiceBusGenerator) InitResources() error {
+	namespaces, err := g.listNamespaces()
+	if err != nil {
+		return err
+	}
+	for _, namespace := range namespaces {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			*namespace.ID,
+			*namespace.Name,
+			"azurerm_servicebus_namespace",
+			g.ProviderName,
+			[]string{}))
+
+		id, err := ParseAzureResourceID(*namespace.ID)
+		if err != nil {
+			return err
+		}
+		queues, err := g.listQueues(id.ResourceGroup, *namespace.Name)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, queues...)
+
+		// topics and subscriptions are not available in the Basic tier
+		if namespace.Sku != nil && namespace.Sku.Name == servicebus.Basic {
+			continue
+		}
+		topics, err := g.listTopics(id.ResourceGroup, *namespace.Name)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, topics...)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2021 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/appplatform/mgmt/2019-05-01-preview/appplatform"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// springCloudSecretEnvRegexp matches names of environment variables which usually carry credentials
+var springCloudSecretEnvRegexp = regexp.MustCompile(`(?i)(token|key|secret|password|passwd|credential)`)
+
+type SpringCloudGenerator struct {
+	AzureService
+}
+
+func (g *SpringCloudGenerator) listServices() ([]appplatform.ServiceResource, error) {
+	ctx := context.Background()
+	servicesClient := appplatform.NewServicesClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	servicesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	servicesClient.Sender = auditedSender()
+
+	var (
+		iterator appplatform.ServiceResourceListIterator
+		err      error
+	)
+	if rg := g.Args["resource_group"].(string); rg != "" {
+		iterator, err = servicesClient.ListComplete(ctx, rg)
+	} else {
+		iterator, err = servicesClient.ListBySubscriptionComplete(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var services []appplatform.ServiceResource
+	for iterator.NotDone() {
+		services = append(services, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return services, err
+		}
+	}
+	return services, nil
+}
+
+func (g *SpringCloudGenerator) listCertificates(resourceGroupName, serviceName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	certificatesClient := appplatform.NewCertificatesClient(g.Args["config"].(authentication.Config).SubscriptionID)
+	certificatesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	certificatesClient.Sender = auditedSender()
+
+	iterator, err := certificatesClient.ListComplete(ctx, resourceGroupName, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		certificate := iterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*certificate.ID,
+			serviceName+"_"+*certificate.Name,
+			"azurerm_spring_cloud_certificate",
+			g.ProviderName,
+			[]string{}))
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+// deploymentResourceType maps source and runtime of deployment to terraform resource type,
+// jar deployments on Java runtimes and custom containers have resources, other deployments are skipped
+func deploymentResourceType(deployment appplatform.DeploymentResource) (string, bool) {
+	if deployment.Properties == nil || deployment.Properties.Source == nil {
+		return "", false
+	}
+	var runtimeVersion string
+	if deployment.Properties.DeploymentSettings != nil {
+		runtimeVersion = string(deployment.Properties.DeploymentSettings.RuntimeVersion)
+	}
+	switch sourceType := string(deployment.Properties.Source.Type); {
+	case sourceType == "Container":
+		return "azurerm_spring_cloud_container_deployment", true
+	case sourceType == string(appplatform.Jar) && (runtimeVersion == "" || strings.HasPrefix(runtimeVersion, "Java_")):
+		return "azurerm_spring_cloud_java_deployment", true
+	default:
+		return "", false
+	}
+}
+
+func (g *SpringCloudGenerator) listApps(resourceGroupName, serviceName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	appsClient := appplatform.NewAppsClient(subscriptionID)
+	appsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	appsClient.Sender = auditedSender()
+	deploymentsClient := appplatform.NewDeploymentsClient(subscriptionID)
+	deploymentsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	deploymentsClient.Sender = auditedSender()
+
+	iterator, err := appsClient.ListComplete(ctx, resourceGroupName, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		app := iterator.Value()
+		appName := serviceName + "_" + *app.Name
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*app.ID,
+			appName,
+			"azurerm_spring_cloud_app",
+			g.ProviderName,
+			[]string{}))
+
+		deployments, err := deploymentsClient.ListComplete(ctx, resourceGroupName, serviceName, *app.Name, nil)
+		if err != nil {
+			return resources, err
+		}
+		for deployments.NotDone() {
+			deployment := deployments.Value()
+			// JVM options, environment variables, quotas and instance count are attributes of the deployment
+			if resourceType, ok := deploymentResourceType(deployment); ok {
+				resources = append(resources, terraformutils.NewSimpleResource(
+					*deployment.ID,
+					appName+"_"+*deployment.Name,
+					resourceType,
+					g.ProviderName,
+					[]string{}))
+			} else {
+				log.Printf("spring_cloud: deployment %s has no matching terraform resource, skipped", *deployment.ID)
+			}
+			if err := deployments.NextWithContext(ctx); err != nil {
+				return resources, err
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *SpringCloudGenerator) InitResources() error {
+	services, err := g.listServices()
+	if err != nil {
+		return err
+	}
+	for _, service := range services {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			*service.ID,
+			*service.Name,
+			"azurerm_spring_cloud_service",
+			g.ProviderName,
+			[]string{}))
+
+		id, err := ParseAzureResourceID(*service.ID)
+		if err != nil {
+			return err
+		}
+		certificates, err := g.listCertificates(id.ResourceGroup, *service.Name)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, certificates...)
+
+		apps, err := g.listApps(id.ResourceGroup, *service.Name)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, apps...)
+	}
+	return nil
+}
+
+func (g *SpringCloudGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type == "azurerm_spring_cloud_service" {
+			continue
+		}
+		for _, service := range g.Resources {
+			if service.InstanceInfo.Type != "azurerm_spring_cloud_service" {
+				continue
+			}
+			if r.InstanceState.Attributes["service_name"] == service.InstanceState.Attributes["name"] {
+				g.Resources[i].Item["service_name"] = "${azurerm_spring_cloud_service." + service.ResourceName + ".name}"
+			}
+		}
+		if r.InstanceInfo.Type != "azurerm_spring_cloud_java_deployment" && r.InstanceInfo.Type != "azurerm_spring_cloud_container_deployment" {
+			continue
+		}
+		if variables, ok := r.Item["environment_variables"].(map[string]interface{}); ok {
+			for name := range variables {
+				if !springCloudSecretEnvRegexp.MatchString(name) {
+					continue
+				}
+				log.Printf("spring_cloud: environment variable %s of deployment %s looks like a secret, replaced by variable", name, r.InstanceState.ID)
+				variables[name] = g.Resources[i].AddSensitiveVariable(
+					strings.TrimPrefix(r.ResourceName, "tfer--")+"_"+strings.ToLower(name),
+					"Environment variable "+name+" of Spring Cloud deployment "+r.InstanceState.ID)
+			}
+		}
+		for _, app := range g.Resources {
+			if app.InstanceInfo.Type != "azurerm_spring_cloud_app" {
+				continue
+			}
+			if r.InstanceState.Attributes["spring_cloud_app_id"] == app.InstanceState.ID {
+				g.Resources[i].Item["spring_cloud_app_id"] = "${azurerm_spring_cloud_app." + app.ResourceName + ".id}"
+			}
+		}
+	}
+	return nil
+}
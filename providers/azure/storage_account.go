@@ -72,6 +72,7 @@ func (g *StorageAccountGenerator) InitResources() error {
 	ctx := context.Background()
 	accountsClient := storage.NewAccountsClient(g.Args["config"].(authentication.Config).SubscriptionID)
 	accountsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	accountsClient.Sender = auditedSender()
 	if rg := g.Args["resource_group"].(string); rg != "" {
 		output, err := g.createResourcesByResourceGroup(ctx, accountsClient, rg)
 		g.Resources = output
@@ -26,6 +26,7 @@ type StorageBlobGenerator struct {
 func (g StorageBlobGenerator) getAccountPrimaryKey(ctx context.Context, accountName, accountGroupName string) string {
 	storageAccountsClient := storage.NewAccountsClient(g.Args["config"].(authentication.Config).SubscriptionID)
 	storageAccountsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	storageAccountsClient.Sender = auditedSender()
 
 	response, err := storageAccountsClient.ListKeys(ctx, accountGroupName, accountName, "kerb")
 	if err != nil {
@@ -32,6 +32,7 @@ func (g StorageContainerGenerator) ListBlobContainers() ([]terraformutils.Resour
 	var containerResources []terraformutils.Resource
 	blobContainersClient := storage.NewBlobContainersClient(g.Args["config"].(authentication.Config).SubscriptionID)
 	blobContainersClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	blobContainersClient.Sender = auditedSender()
 	ctx := context.Background()
 
 	accounts, err := g.getStorageAccounts()
@@ -78,6 +79,7 @@ func (g *StorageContainerGenerator) getStorageAccounts() ([]storage.Account, err
 	accountsClient := storage.NewAccountsClient(g.Args["config"].(authentication.Config).SubscriptionID)
 
 	accountsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	accountsClient.Sender = auditedSender()
 	var accounts []storage.Account
 	if rg := g.Args["resource_group"].(string); rg != "" {
 		accountsResult, err := accountsClient.ListByResourceGroup(ctx, rg)
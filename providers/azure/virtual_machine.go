@@ -32,24 +32,12 @@ func (g VirtualMachineGenerator) createResources(virtualMachineListResultIterato
 	var resources []terraformutils.Resource
 	for virtualMachineListResultIterator.NotDone() {
 		vm := virtualMachineListResultIterator.Value()
-		var newResource terraformutils.Resource
-		if vm.VirtualMachineProperties.OsProfile.WindowsConfiguration != nil {
-			newResource = terraformutils.NewSimpleResource(
-				*vm.ID,
-				*vm.Name,
-				"azurerm_windows_virtual_machine",
-				"azurerm",
-				[]string{})
-		} else {
-			newResource = terraformutils.NewSimpleResource(
-				*vm.ID,
-				*vm.Name,
-				"azurerm_linux_virtual_machine",
-				"azurerm",
-				[]string{})
-		}
-
-		resources = append(resources, newResource)
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*vm.ID,
+			resourceName(*vm.ID),
+			virtualMachineResourceType(vm),
+			"azurerm",
+			[]string{}))
 		if err := virtualMachineListResultIterator.Next(); err != nil {
 			log.Println(err)
 			return resources, err
@@ -58,11 +46,27 @@ func (g VirtualMachineGenerator) createResources(virtualMachineListResultIterato
 	return resources, nil
 }
 
+// virtualMachineResourceType returns azurerm_linux_virtual_machine or azurerm_windows_virtual_machine,
+// VMs without OS profile (attached from specialized disk) can be managed by azurerm_virtual_machine only
+func virtualMachineResourceType(vm compute.VirtualMachine) string {
+	if vm.VirtualMachineProperties == nil || vm.OsProfile == nil {
+		return "azurerm_virtual_machine"
+	}
+	if vm.OsProfile.WindowsConfiguration != nil {
+		return "azurerm_windows_virtual_machine"
+	}
+	if vm.StorageProfile != nil && vm.StorageProfile.OsDisk != nil && vm.StorageProfile.OsDisk.OsType == compute.Windows {
+		return "azurerm_windows_virtual_machine"
+	}
+	return "azurerm_linux_virtual_machine"
+}
+
 func (g *VirtualMachineGenerator) InitResources() error {
 	ctx := context.Background()
 	vmClient := compute.NewVirtualMachinesClient(g.Args["config"].(authentication.Config).SubscriptionID)
 
 	vmClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	vmClient.Sender = auditedSender()
 
 	var (
 		output compute.VirtualMachineListResultIterator
@@ -17,6 +17,7 @@ package azure
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-08-01/network"
 	"github.com/Azure/go-autorest/autorest"
@@ -32,12 +33,18 @@ func (g VirtualNetworkGenerator) createResources(ctx context.Context, iterator n
 	var resources []terraformutils.Resource
 	for iterator.NotDone() {
 		virtualNetwork := iterator.Value()
-		resources = append(resources, terraformutils.NewSimpleResource(
+		resource := terraformutils.NewSimpleResource(
 			*virtualNetwork.ID,
-			*virtualNetwork.Name,
+			resourceName(*virtualNetwork.ID),
 			"azurerm_virtual_network",
 			"azurerm",
-			[]string{}))
+			[]string{})
+		// subnets are imported as azurerm_subnet, inline subnets would conflict with them
+		resource.IgnoreKeys = append(resource.IgnoreKeys, "^subnet\\.")
+		resources = append(resources, resource)
+		if virtualNetwork.VirtualNetworkPropertiesFormat != nil && virtualNetwork.Subnets != nil {
+			resources = append(resources, g.createSubnetResources(*virtualNetwork.Subnets)...)
+		}
 		if err := iterator.NextWithContext(ctx); err != nil {
 			log.Println(err)
 			return resources, err
@@ -46,11 +53,35 @@ func (g VirtualNetworkGenerator) createResources(ctx context.Context, iterator n
 	return resources, nil
 }
 
+// Create azurerm_subnet for each subnet of virtual network, subnets with network security group
+// get azurerm_subnet_network_security_group_association too
+func (g VirtualNetworkGenerator) createSubnetResources(subnets []network.Subnet) []terraformutils.Resource {
+	var resources []terraformutils.Resource
+	for _, subnet := range subnets {
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*subnet.ID,
+			resourceName(*subnet.ID),
+			"azurerm_subnet",
+			"azurerm",
+			[]string{}))
+		if subnet.SubnetPropertiesFormat != nil && subnet.NetworkSecurityGroup != nil {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*subnet.ID,
+				resourceName(*subnet.ID),
+				"azurerm_subnet_network_security_group_association",
+				"azurerm",
+				[]string{}))
+		}
+	}
+	return resources
+}
+
 func (g *VirtualNetworkGenerator) InitResources() error {
 	ctx := context.Background()
 	virtualNetworkClient := network.NewVirtualNetworksClient(g.Args["config"].(authentication.Config).SubscriptionID)
 
 	virtualNetworkClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	virtualNetworkClient.Sender = auditedSender()
 
 	var (
 		output network.VirtualNetworkListResultIterator
@@ -68,3 +99,34 @@ func (g *VirtualNetworkGenerator) InitResources() error {
 	g.Resources, err = g.createResources(ctx, output)
 	return err
 }
+
+// PostConvertHook links subnets to virtual networks and security group associations to subnets
+func (g *VirtualNetworkGenerator) PostConvertHook() error {
+	networks := map[string]string{}
+	subnets := map[string]string{}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "azurerm_virtual_network":
+			networks[strings.ToLower(r.InstanceState.ID)] = r.ResourceName
+		case "azurerm_subnet":
+			subnets[strings.ToLower(r.InstanceState.ID)] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "azurerm_subnet":
+			index := strings.LastIndex(strings.ToLower(r.InstanceState.ID), "/subnets/")
+			if index < 0 {
+				continue
+			}
+			if name, exist := networks[strings.ToLower(r.InstanceState.ID[:index])]; exist {
+				g.Resources[i].Item["virtual_network_name"] = "${azurerm_virtual_network." + name + ".name}"
+			}
+		case "azurerm_subnet_network_security_group_association":
+			if name, exist := subnets[strings.ToLower(r.InstanceState.ID)]; exist {
+				g.Resources[i].Item["subnet_id"] = "${azurerm_subnet." + name + ".id}"
+			}
+		}
+	}
+	return nil
+}
@@ -56,7 +56,7 @@ func (g *AccessGenerator) InitResources() error {
 		return err
 	}
 
-	zones, err := api.ListZones()
+	zones, err := g.listZones(api)
 	if err != nil {
 		return err
 	}
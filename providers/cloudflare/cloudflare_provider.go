@@ -37,16 +37,35 @@ func (p *CloudflareProvider) GetProviderData(arg ...string) map[string]interface
 }
 
 func (CloudflareProvider) GetResourceConnections() map[string]map[string][]string {
-	return map[string]map[string][]string{}
+	return map[string]map[string][]string{
+		"worker_script": {
+			"workers_kv_namespace": []string{"kv_namespace_binding.namespace_id", "id"},
+		},
+		"worker_route": {
+			"worker_script": []string{"script_name", "name"},
+			"dns":           []string{"zone_id", "id"},
+		},
+		"page_rule": {
+			"dns": []string{"zone_id", "id"},
+		},
+		"zone_settings_override": {
+			"dns": []string{"zone_id", "id"},
+		},
+	}
 }
 
 func (p *CloudflareProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
 	return map[string]terraformutils.ServiceGenerator{
-		"access":         &AccessGenerator{},
-		"dns":            &DNSGenerator{},
-		"firewall":       &FirewallGenerator{},
-		"page_rule":      &PageRulesGenerator{},
-		"account_member": &AccountMemberGenerator{},
+		"access":                 &AccessGenerator{},
+		"dns":                    &DNSGenerator{},
+		"firewall":               &FirewallGenerator{},
+		"page_rule":              &PageRulesGenerator{},
+		"account_member":         &AccountMemberGenerator{},
+		"r2_bucket":              &R2BucketGenerator{},
+		"worker_route":           &WorkerRouteGenerator{},
+		"worker_script":          &WorkerScriptGenerator{},
+		"workers_kv_namespace":   &WorkersKVNamespaceGenerator{},
+		"zone_settings_override": &ZoneSettingsOverrideGenerator{},
 	}
 }
 
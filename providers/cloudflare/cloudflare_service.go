@@ -45,3 +45,15 @@ func (s *CloudflareService) initializeAPI() (*cf.API, error) {
 
 	return cf.New(apiKey, apiEmail, cf.UsingAccount(accountID))
 }
+
+// listZones lists zones of account, filters of zone name, e.g. Type=zone;Name=zone;Value=example.com,
+// restrict zones resources of all services are imported from
+func (s *CloudflareService) listZones(api *cf.API) ([]cf.Zone, error) {
+	names := []string{}
+	for _, filter := range s.Filter {
+		if filter.FieldPath == "zone" && filter.IsApplicable("zone") {
+			names = append(names, filter.AcceptableValues...)
+		}
+	}
+	return api.ListZones(names...)
+}
@@ -17,11 +17,20 @@ package cloudflare
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	cf "github.com/cloudflare/cloudflare-go"
 )
 
+// proxiableRecordTypes are record types Cloudflare can proxy, proxied flag is meaningless for others
+var proxiableRecordTypes = map[string]bool{"A": true, "AAAA": true, "CNAME": true}
+
+// priorityRecordTypes are record types priority is part of
+var priorityRecordTypes = map[string]bool{"MX": true, "SRV": true, "URI": true}
+
+var recordNameReplacer = strings.NewReplacer(".", "_", "-", "_")
+
 type DNSGenerator struct {
 	CloudflareService
 }
@@ -49,18 +58,38 @@ func (*DNSGenerator) createZonesResource(api *cf.API, zoneID string) ([]terrafor
 	return []terraformutils.Resource{resource}, nil
 }
 
-func (*DNSGenerator) createRecordsResources(api *cf.API, zoneID string) ([]terraformutils.Resource, error) {
+// recordTypes returns record types of filters, e.g. Type=record;Name=type;Value=MX:TXT,
+// empty type lists records of all types
+func (g *DNSGenerator) recordTypes() []string {
+	types := []string{}
+	for _, filter := range g.Filter {
+		if filter.FieldPath == "type" && filter.ServiceName == "record" {
+			types = append(types, filter.AcceptableValues...)
+		}
+	}
+	if len(types) == 0 {
+		return []string{""}
+	}
+	return types
+}
+
+func (g *DNSGenerator) createRecordsResources(api *cf.API, zoneID string) ([]terraformutils.Resource, error) {
 	resources := []terraformutils.Resource{}
-	records, err := api.DNSRecords(zoneID, cf.DNSRecord{})
-	if err != nil {
-		log.Println(err)
-		return resources, err
+	records := []cf.DNSRecord{}
+	for _, recordType := range g.recordTypes() {
+		typeRecords, err := api.DNSRecords(zoneID, cf.DNSRecord{Type: recordType})
+		if err != nil {
+			log.Println(err)
+			return resources, err
+		}
+		records = append(records, typeRecords...)
 	}
 
+	names := recordResourceNames(records)
 	for _, record := range records {
 		r := terraformutils.NewResource(
 			record.ID,
-			fmt.Sprintf("%s_%s_%s", record.Type, record.ZoneName, record.ID),
+			names[record.ID],
 			"cloudflare_record",
 			"cloudflare",
 			map[string]string{
@@ -79,6 +108,36 @@ func (*DNSGenerator) createRecordsResources(api *cf.API, zoneID string) ([]terra
 	return resources, nil
 }
 
+// recordResourceNames returns readable resource names of records by record ID, names are built from type
+// record name relative to zone and zone name, apex is named "apex" and wildcard label "wildcard", dots and dashes
+// of labels, punycode ones too, are replaced by underscores, so sanitizer doesn't escape them. Record ID
+// is appended when several records share the name, e.g. round-robin A records
+func recordResourceNames(records []cf.DNSRecord) map[string]string {
+	names := map[string]string{}
+	counts := map[string]int{}
+	for _, record := range records {
+		name := strings.TrimSuffix(strings.TrimSuffix(record.Name, record.ZoneName), ".")
+		if name == "" {
+			name = "apex"
+		}
+		labels := strings.Split(name, ".")
+		for i, label := range labels {
+			if label == "*" {
+				labels[i] = "wildcard"
+			}
+		}
+		name = recordNameReplacer.Replace(record.Type + "_" + strings.Join(labels, ".") + "_" + record.ZoneName)
+		names[record.ID] = name
+		counts[name]++
+	}
+	for _, record := range records {
+		if counts[names[record.ID]] > 1 {
+			names[record.ID] += "_" + record.ID
+		}
+	}
+	return names
+}
+
 func (g *DNSGenerator) InitResources() error {
 	api, err := g.initializeAPI()
 	if err != nil {
@@ -86,7 +145,7 @@ func (g *DNSGenerator) InitResources() error {
 		return err
 	}
 
-	zones, err := api.ListZones()
+	zones, err := g.listZones(api)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -111,15 +170,36 @@ func (g *DNSGenerator) InitResources() error {
 }
 
 func (g *DNSGenerator) PostConvertHook() error {
+	zones := map[string]string{}
+	for _, resource := range g.Resources {
+		if resource.InstanceInfo.Type == "cloudflare_zone" {
+			zones[resource.InstanceState.ID] = resource.ResourceName
+		}
+	}
 	// 'record' resource have 'data' and 'value' is mutual-exclude
 	// delete which one have empty value
 	for i, resource := range g.Resources {
-		if resource.InstanceInfo.Type == "cloudflare_record" {
-			if val, ok := resource.Item["data"]; ok && len(val.(map[string]interface{})) == 0 {
-				delete(g.Resources[i].Item, "data")
-			} else if val, ok := resource.Item["value"]; ok && len(val.(string)) == 0 {
-				delete(g.Resources[i].Item, "value")
-			}
+		if resource.InstanceInfo.Type != "cloudflare_record" {
+			continue
+		}
+		if val, ok := resource.Item["data"]; ok && len(val.(map[string]interface{})) == 0 {
+			delete(g.Resources[i].Item, "data")
+		} else if val, ok := resource.Item["value"]; ok && len(val.(string)) == 0 {
+			delete(g.Resources[i].Item, "value")
+		}
+		recordType := resource.InstanceState.Attributes["type"]
+		if !priorityRecordTypes[recordType] {
+			delete(g.Resources[i].Item, "priority")
+		}
+		if !proxiableRecordTypes[recordType] {
+			delete(g.Resources[i].Item, "proxied")
+		}
+		// TXT records are kept verbatim including quotes of split strings, only template sequences are escaped
+		if value, ok := resource.Item["value"].(string); ok && recordType == "TXT" {
+			g.Resources[i].Item["value"] = terraformutils.EscapeTemplate(value)
+		}
+		if zoneName, ok := zones[resource.InstanceState.Attributes["zone_id"]]; ok {
+			g.Resources[i].Item["zone_id"] = fmt.Sprintf("${cloudflare_zone.%s.id}", zoneName)
 		}
 	}
 
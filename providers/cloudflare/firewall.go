@@ -228,7 +228,7 @@ func (g *FirewallGenerator) InitResources() error {
 
 	}
 
-	zones, err := api.ListZones()
+	zones, err := g.listZones(api)
 	if err != nil {
 		return err
 	}
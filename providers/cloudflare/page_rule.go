@@ -53,7 +53,7 @@ func (g *PageRulesGenerator) InitResources() error {
 		return err
 	}
 
-	zones, err := api.ListZones()
+	zones, err := g.listZones(api)
 	if err != nil {
 		return err
 	}
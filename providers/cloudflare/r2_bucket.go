@@ -0,0 +1,70 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflare
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type R2BucketGenerator struct {
+	CloudflareService
+}
+
+func (g *R2BucketGenerator) InitResources() error {
+	api, err := g.initializeAPI()
+	if err != nil {
+		return err
+	}
+	if api.AccountID == "" {
+		return errors.New("cloudflare: CLOUDFLARE_ACCOUNT_ID environment variable must be set to import R2 buckets")
+	}
+	// cloudflare-go doesn't support R2 yet
+	result, err := api.Raw("GET", "/accounts/"+api.AccountID+"/r2/buckets", nil)
+	if err != nil {
+		return err
+	}
+	var response struct {
+		Buckets []struct {
+			Name     string `json:"name"`
+			Location string `json:"location"`
+		} `json:"buckets"`
+	}
+	if err := json.Unmarshal(result, &response); err != nil {
+		return err
+	}
+	for _, bucket := range response.Buckets {
+		attributes := map[string]string{
+			"name":       bucket.Name,
+			"account_id": api.AccountID,
+		}
+		// location hint is only set for buckets created with one
+		if bucket.Location != "" {
+			attributes["location"] = bucket.Location
+		}
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			bucket.Name,
+			bucket.Name,
+			"cloudflare_r2_bucket",
+			"cloudflare",
+			attributes,
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
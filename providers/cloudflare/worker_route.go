@@ -0,0 +1,72 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflare
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	cf "github.com/cloudflare/cloudflare-go"
+)
+
+type WorkerRouteGenerator struct {
+	CloudflareService
+}
+
+func (g *WorkerRouteGenerator) createWorkerRoutes(api *cf.API, zoneID, zoneName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	routes, err := api.ListWorkerRoutes(zoneID)
+	if err != nil {
+		return resources, err
+	}
+
+	for _, route := range routes.Routes {
+		resources = append(resources, terraformutils.NewResource(
+			route.ID,
+			fmt.Sprintf("%s_%s", zoneName, route.ID),
+			"cloudflare_worker_route",
+			"cloudflare",
+			map[string]string{
+				"zone_id": zoneID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+
+	return resources, nil
+}
+
+func (g *WorkerRouteGenerator) InitResources() error {
+	api, err := g.initializeAPI()
+	if err != nil {
+		return err
+	}
+
+	zones, err := g.listZones(api)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range zones {
+		resources, err := g.createWorkerRoutes(api, zone.ID, zone.Name)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, resources...)
+	}
+
+	return nil
+}
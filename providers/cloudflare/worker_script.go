@@ -0,0 +1,106 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflare
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	cf "github.com/cloudflare/cloudflare-go"
+)
+
+// workerScriptInlineSize is the largest script kept inline as heredoc, bigger ones go to separate files
+const workerScriptInlineSize = 4096
+
+type WorkerScriptGenerator struct {
+	CloudflareService
+}
+
+func (g *WorkerScriptGenerator) createWorkerScripts(api *cf.API) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	scripts, err := api.ListWorkerScripts()
+	if err != nil {
+		return resources, err
+	}
+
+	for _, script := range scripts.WorkerList {
+		resources = append(resources, terraformutils.NewResource(
+			script.ID,
+			script.ID,
+			"cloudflare_workers_script",
+			"cloudflare",
+			map[string]string{
+				"name":       script.ID,
+				"account_id": api.AccountID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+
+	return resources, nil
+}
+
+func (g *WorkerScriptGenerator) InitResources() error {
+	api, err := g.initializeAPI()
+	if err != nil {
+		return err
+	}
+
+	resources, err := g.createWorkerScripts(api)
+	if err != nil {
+		return err
+	}
+	g.Resources = append(g.Resources, resources...)
+
+	return nil
+}
+
+// PostConvertHook keeps small scripts inline as heredoc and moves bigger script sources and wasm modules
+// to separate files, they are referenced from HCL with file and filebase64 functions
+func (g *WorkerScriptGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		name := r.InstanceState.ID
+		dataFiles := map[string][]byte{}
+		if content, ok := r.Item["content"].(string); ok && content != "" {
+			if len(content) <= workerScriptInlineSize {
+				g.Resources[i].Item["content"] = terraformutils.Heredoc("SCRIPT", content)
+			} else {
+				fileName := name + ".js"
+				dataFiles[fileName] = []byte(content)
+				g.Resources[i].Item["content"] = fmt.Sprintf(`${file("${path.module}/%s")}`, fileName)
+			}
+		}
+		if bindings, ok := r.Item["webassembly_binding"].([]interface{}); ok {
+			for _, binding := range bindings {
+				binding := binding.(map[string]interface{})
+				module, ok := binding["module"].(string)
+				if !ok || module == "" {
+					continue
+				}
+				wasm, err := base64.StdEncoding.DecodeString(module)
+				if err != nil {
+					return err
+				}
+				fileName := fmt.Sprintf("%s_%s.wasm", name, binding["name"])
+				dataFiles[fileName] = wasm
+				binding["module"] = fmt.Sprintf(`${filebase64("${path.module}/%s")}`, fileName)
+			}
+		}
+		g.Resources[i].DataFiles = dataFiles
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflare
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type WorkersKVNamespaceGenerator struct {
+	CloudflareService
+}
+
+func (g *WorkersKVNamespaceGenerator) InitResources() error {
+	api, err := g.initializeAPI()
+	if err != nil {
+		return err
+	}
+	namespaces, err := api.ListWorkersKVNamespaces(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, namespace := range namespaces {
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			namespace.ID,
+			namespace.Title,
+			"cloudflare_workers_kv_namespace",
+			"cloudflare",
+			map[string]string{
+				"title":      namespace.Title,
+				"account_id": api.AccountID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflare
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	cf "github.com/cloudflare/cloudflare-go"
+)
+
+type ZoneSettingsOverrideGenerator struct {
+	CloudflareService
+}
+
+func (g *ZoneSettingsOverrideGenerator) InitResources() error {
+	api, err := g.initializeAPI()
+	if err != nil {
+		return err
+	}
+
+	zones, err := g.listZones(api)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range zones {
+		g.Resources = append(g.Resources, g.createZoneSettingsOverride(zone))
+	}
+
+	return nil
+}
+
+// createZoneSettingsOverride captures current settings of zone, settings read at import time are recorded
+// by provider as initial settings, they are computed and not generated
+func (g *ZoneSettingsOverrideGenerator) createZoneSettingsOverride(zone cf.Zone) terraformutils.Resource {
+	return terraformutils.NewResource(
+		zone.ID,
+		zone.Name,
+		"cloudflare_zone_settings_override",
+		"cloudflare",
+		map[string]string{
+			"zone_id": zone.ID,
+		},
+		[]string{},
+		map[string]interface{}{},
+	)
+}
@@ -0,0 +1,50 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type APIKeyGenerator struct {
+	ConfluentService
+}
+
+type apiKey struct {
+	ID   string `json:"id"`
+	Spec struct {
+		DisplayName string `json:"display_name"`
+	} `json:"spec"`
+}
+
+func (g *APIKeyGenerator) InitResources() error {
+	var apiKeys []apiKey
+	if err := g.list("/iam/v2/api-keys", nil, &apiKeys); err != nil {
+		return err
+	}
+	for _, key := range apiKeys {
+		resource := terraformutils.NewSimpleResource(
+			key.ID,
+			key.Spec.DisplayName+"_"+key.ID,
+			"confluent_api_key",
+			"confluent",
+			[]string{},
+		)
+		// secret is only returned on creation
+		resource.IgnoreKeys = append(resource.IgnoreKeys, "^secret$")
+		g.Resources = append(g.Resources, resource)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"errors"
+	"os"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type ConfluentProvider struct { //nolint
+	terraformutils.Provider
+	cloudAPIKey    string
+	cloudAPISecret string
+	kafkaAPIKey    string
+	kafkaAPISecret string
+}
+
+func (p *ConfluentProvider) Init(args []string) error {
+	p.cloudAPIKey = os.Getenv("CONFLUENT_CLOUD_API_KEY")
+	p.cloudAPISecret = os.Getenv("CONFLUENT_CLOUD_API_SECRET")
+	p.kafkaAPIKey = os.Getenv("KAFKA_API_KEY")
+	p.kafkaAPISecret = os.Getenv("KAFKA_API_SECRET")
+	for i, value := range []*string{&p.cloudAPIKey, &p.cloudAPISecret, &p.kafkaAPIKey, &p.kafkaAPISecret} {
+		if len(args) > i && args[i] != "" {
+			*value = args[i]
+		}
+	}
+	if p.cloudAPIKey == "" || p.cloudAPISecret == "" {
+		return errors.New("set CONFLUENT_CLOUD_API_KEY and CONFLUENT_CLOUD_API_SECRET env vars")
+	}
+	return nil
+}
+
+func (p *ConfluentProvider) GetName() string {
+	return "confluent"
+}
+
+func (p *ConfluentProvider) GetProviderData(arg ...string) map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (p *ConfluentProvider) GetConfig() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"cloud_api_key":    cty.StringVal(p.cloudAPIKey),
+		"cloud_api_secret": cty.StringVal(p.cloudAPISecret),
+	})
+}
+
+func (p *ConfluentProvider) InitService(serviceName string, verbose bool) error {
+	var isSupported bool
+	if _, isSupported = p.GetSupportedService()[serviceName]; !isSupported {
+		return errors.New(p.GetName() + ": " + serviceName + " not supported service")
+	}
+	p.Service = p.GetSupportedService()[serviceName]
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"cloud_api_key":    p.cloudAPIKey,
+		"cloud_api_secret": p.cloudAPISecret,
+		"kafka_api_key":    p.kafkaAPIKey,
+		"kafka_api_secret": p.kafkaAPISecret,
+	})
+	return nil
+}
+
+func (p *ConfluentProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	return map[string]terraformutils.ServiceGenerator{
+		"api_key":         &APIKeyGenerator{},
+		"environment":     &EnvironmentGenerator{},
+		"kafka_cluster":   &KafkaClusterGenerator{},
+		"kafka_topic":     &KafkaTopicGenerator{},
+		"role_binding":    &RoleBindingGenerator{},
+		"service_account": &ServiceAccountGenerator{},
+	}
+}
+
+func (ConfluentProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{
+		"api_key": {
+			"environment":     []string{"managed_resource.environment.id", "id"},
+			"kafka_cluster":   []string{"managed_resource.id", "id"},
+			"service_account": []string{"owner.id", "id"},
+		},
+		"kafka_cluster": {
+			"environment": []string{"environment.id", "id"},
+		},
+		"kafka_topic": {
+			"kafka_cluster": []string{"kafka_cluster.id", "id"},
+		},
+	}
+}
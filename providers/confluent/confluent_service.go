@@ -0,0 +1,142 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+const (
+	confluentAPIURL   = "https://api.confluent.cloud"
+	confluentPageSize = "100"
+)
+
+type ConfluentService struct { //nolint
+	terraformutils.Service
+}
+
+type Environment struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+	Metadata    struct {
+		ResourceName string `json:"resource_name"`
+	} `json:"metadata"`
+}
+
+type KafkaCluster struct {
+	ID       string `json:"id"`
+	Metadata struct {
+		ResourceName string `json:"resource_name"`
+	} `json:"metadata"`
+	Spec struct {
+		DisplayName  string `json:"display_name"`
+		HTTPEndpoint string `json:"http_endpoint"`
+		Config       struct {
+			Kind string `json:"kind"`
+		} `json:"config"`
+		Environment struct {
+			ID string `json:"id"`
+		} `json:"environment"`
+	} `json:"spec"`
+}
+
+type listResponse struct {
+	Data     []json.RawMessage `json:"data"`
+	Metadata struct {
+		Next string `json:"next"`
+	} `json:"metadata"`
+}
+
+func (s *ConfluentService) generateRequest(uri, key, secret string) ([]byte, error) {
+	client := &http.Client{}
+	req, err := http.NewRequest("GET", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(key, secret)
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("confluent: %s returned %s: %s", uri, resp.Status, body)
+	}
+	return body, nil
+}
+
+// listAll follows metadata.next links of a Confluent Cloud list endpoint and unmarshals all items into out
+func (s *ConfluentService) listAll(uri, key, secret string, out interface{}) error {
+	var items []json.RawMessage
+	for uri != "" {
+		body, err := s.generateRequest(uri, key, secret)
+		if err != nil {
+			return err
+		}
+		var page listResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return err
+		}
+		items = append(items, page.Data...)
+		uri = page.Metadata.Next
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func (s *ConfluentService) list(path string, query url.Values, out interface{}) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("page_size", confluentPageSize)
+	return s.listAll(confluentAPIURL+path+"?"+query.Encode(), s.Args["cloud_api_key"].(string), s.Args["cloud_api_secret"].(string), out)
+}
+
+func (s *ConfluentService) environments() ([]Environment, error) {
+	var environments []Environment
+	err := s.list("/org/v2/environments", nil, &environments)
+	return environments, err
+}
+
+func (s *ConfluentService) kafkaClusters() ([]KafkaCluster, error) {
+	environments, err := s.environments()
+	if err != nil {
+		return nil, err
+	}
+	var clusters []KafkaCluster
+	for _, environment := range environments {
+		var environmentClusters []KafkaCluster
+		err := s.list("/cmk/v2/clusters", url.Values{"environment": []string{environment.ID}}, &environmentClusters)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, environmentClusters...)
+	}
+	return clusters, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type EnvironmentGenerator struct {
+	ConfluentService
+}
+
+func (g *EnvironmentGenerator) InitResources() error {
+	environments, err := g.environments()
+	if err != nil {
+		return err
+	}
+	for _, environment := range environments {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			environment.ID,
+			environment.DisplayName+"_"+environment.ID,
+			"confluent_environment",
+			"confluent",
+			[]string{},
+		))
+	}
+	return nil
+}
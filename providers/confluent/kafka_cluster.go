@@ -0,0 +1,64 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type KafkaClusterGenerator struct {
+	ConfluentService
+	kinds map[string]string
+}
+
+func (g *KafkaClusterGenerator) InitResources() error {
+	clusters, err := g.kafkaClusters()
+	if err != nil {
+		return err
+	}
+	g.kinds = map[string]string{}
+	for _, cluster := range clusters {
+		g.kinds[cluster.ID] = strings.ToLower(cluster.Spec.Config.Kind)
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			cluster.ID,
+			cluster.Spec.DisplayName+"_"+cluster.ID,
+			"confluent_kafka_cluster",
+			"confluent",
+			map[string]string{
+				"environment.#":    "1",
+				"environment.0.id": cluster.Spec.Environment.ID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
+
+// PostConvertHook keeps the cluster type block, basic and standard blocks have no attributes and are dropped on convert
+func (g *KafkaClusterGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		kind := g.kinds[resource.InstanceState.ID]
+		if kind != "basic" && kind != "standard" {
+			continue
+		}
+		if _, ok := resource.Item[kind]; !ok {
+			g.Resources[i].Item[kind] = []interface{}{map[string]interface{}{}}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type KafkaTopicGenerator struct {
+	ConfluentService
+}
+
+type kafkaTopic struct {
+	TopicName  string `json:"topic_name"`
+	IsInternal bool   `json:"is_internal"`
+}
+
+// InitResources lists topics through Kafka REST API of every cluster the Kafka API key has access to
+func (g *KafkaTopicGenerator) InitResources() error {
+	key, secret := g.Args["kafka_api_key"].(string), g.Args["kafka_api_secret"].(string)
+	if key == "" || secret == "" {
+		log.Println("confluent: set KAFKA_API_KEY and KAFKA_API_SECRET env vars to import Kafka topics")
+		return nil
+	}
+	clusters, err := g.kafkaClusters()
+	if err != nil {
+		return err
+	}
+	for _, cluster := range clusters {
+		body, err := g.generateRequest(cluster.Spec.HTTPEndpoint+"/kafka/v3/clusters/"+cluster.ID+"/topics", key, secret)
+		if err != nil {
+			// Kafka API keys are scoped to a single cluster
+			log.Printf("confluent: skipping topics of cluster %s: %s", cluster.ID, err)
+			continue
+		}
+		var response struct {
+			Data []kafkaTopic `json:"data"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return err
+		}
+		for _, topic := range response.Data {
+			if topic.IsInternal || strings.HasPrefix(topic.TopicName, "_") {
+				continue
+			}
+			resource := terraformutils.NewResource(
+				cluster.ID+"/"+topic.TopicName,
+				cluster.ID+"_"+topic.TopicName,
+				"confluent_kafka_topic",
+				"confluent",
+				map[string]string{
+					"kafka_cluster.#":    "1",
+					"kafka_cluster.0.id": cluster.ID,
+					"topic_name":         topic.TopicName,
+					"rest_endpoint":      cluster.Spec.HTTPEndpoint,
+					"credentials.#":      "1",
+					"credentials.0.key":  key,
+					// secret is needed to refresh the topic and is dropped from generated files
+					"credentials.0.secret": secret,
+				},
+				[]string{},
+				map[string]interface{}{},
+			)
+			resource.IgnoreKeys = append(resource.IgnoreKeys, "^credentials\\.")
+			g.Resources = append(g.Resources, resource)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"net/url"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type RoleBindingGenerator struct {
+	ConfluentService
+}
+
+type roleBinding struct {
+	ID         string `json:"id"`
+	Principal  string `json:"principal"`
+	RoleName   string `json:"role_name"`
+	CrnPattern string `json:"crn_pattern"`
+}
+
+// InitResources lists role bindings of organization, environment and Kafka cluster scopes
+func (g *RoleBindingGenerator) InitResources() error {
+	var organizations []struct {
+		Metadata struct {
+			ResourceName string `json:"resource_name"`
+		} `json:"metadata"`
+	}
+	if err := g.list("/org/v2/organizations", nil, &organizations); err != nil {
+		return err
+	}
+	var crnPatterns []string
+	for _, organization := range organizations {
+		crnPatterns = append(crnPatterns, organization.Metadata.ResourceName)
+	}
+	environments, err := g.environments()
+	if err != nil {
+		return err
+	}
+	for _, environment := range environments {
+		crnPatterns = append(crnPatterns, environment.Metadata.ResourceName)
+	}
+	clusters, err := g.kafkaClusters()
+	if err != nil {
+		return err
+	}
+	for _, cluster := range clusters {
+		crnPatterns = append(crnPatterns, cluster.Metadata.ResourceName)
+	}
+
+	seen := map[string]bool{}
+	for _, crnPattern := range crnPatterns {
+		var roleBindings []roleBinding
+		if err := g.list("/iam/v2/role-bindings", url.Values{"crn_pattern": []string{crnPattern}}, &roleBindings); err != nil {
+			return err
+		}
+		for _, roleBinding := range roleBindings {
+			if seen[roleBinding.ID] {
+				continue
+			}
+			seen[roleBinding.ID] = true
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				roleBinding.ID,
+				roleBinding.Principal+"_"+roleBinding.RoleName+"_"+roleBinding.ID,
+				"confluent_role_binding",
+				"confluent",
+				[]string{},
+			))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type ServiceAccountGenerator struct {
+	ConfluentService
+}
+
+type serviceAccount struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+}
+
+func (g *ServiceAccountGenerator) InitResources() error {
+	var serviceAccounts []serviceAccount
+	if err := g.list("/iam/v2/service-accounts", nil, &serviceAccounts); err != nil {
+		return err
+	}
+	for _, serviceAccount := range serviceAccounts {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			serviceAccount.ID,
+			serviceAccount.DisplayName+"_"+serviceAccount.ID,
+			"confluent_service_account",
+			"confluent",
+			[]string{},
+		))
+	}
+	return nil
+}
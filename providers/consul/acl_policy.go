@@ -0,0 +1,51 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// globalManagementPolicyID is the builtin policy created during ACL bootstrap
+const globalManagementPolicyID = "00000000-0000-0000-0000-000000000001"
+
+type ACLPolicyGenerator struct {
+	ConsulService
+}
+
+func (g *ACLPolicyGenerator) InitResources() error {
+	client, err := g.consulClient()
+	if err != nil {
+		return err
+	}
+	policies, _, err := client.ACL().PolicyList(nil)
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		if policy.ID == globalManagementPolicyID {
+			continue
+		}
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			policy.ID,
+			policy.Name,
+			"consul_acl_policy",
+			"consul",
+			[]string{},
+		))
+	}
+	return nil
+}
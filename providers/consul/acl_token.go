@@ -0,0 +1,63 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// anonymousTokenAccessorID is the builtin token used for unauthenticated requests
+const anonymousTokenAccessorID = "00000000-0000-0000-0000-000000000002"
+
+type ACLTokenGenerator struct {
+	ConsulService
+}
+
+func (g *ACLTokenGenerator) InitResources() error {
+	client, err := g.consulClient()
+	if err != nil {
+		return err
+	}
+	tokens, _, err := client.ACL().TokenList(nil)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if token.AccessorID == anonymousTokenAccessorID {
+			continue
+		}
+		name := token.AccessorID
+		if token.Description != "" {
+			name = token.Description + "_" + token.AccessorID
+		}
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			token.AccessorID,
+			name,
+			"consul_acl_token",
+			"consul",
+			[]string{},
+		))
+	}
+	return nil
+}
+
+// PostConvertHook keeps token secrets out of generated files
+func (g *ACLTokenGenerator) PostConvertHook() error {
+	for i := range g.Resources {
+		delete(g.Resources[i].Item, "secret_id")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package consul
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// configEntryKinds are kinds of config entries listed, each kind is listed separately
+var configEntryKinds = []string{
+	"service-defaults",
+	"proxy-defaults",
+	"service-router",
+	"service-splitter",
+	"service-resolver",
+	"ingress-gateway",
+	"terminating-gateway",
+	"service-intentions",
+}
+
+type ConfigEntryGenerator struct {
+	ConsulService
+}
+
+func (g *ConfigEntryGenerator) InitResources() error {
+	client, err := g.consulClient()
+	if err != nil {
+		return err
+	}
+	for _, kind := range configEntryKinds {
+		entries, _, err := client.ConfigEntries().List(kind, nil)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				kind+"-"+entry.GetName(),
+				kind+"_"+entry.GetName(),
+				"consul_config_entry",
+				"consul",
+				map[string]string{
+					"kind": kind,
+					"name": entry.GetName(),
+				},
+				[]string{},
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
+
+// PostConvertHook renders config entry bodies as indented JSON heredocs
+func (g *ConfigEntryGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		config, ok := resource.Item["config_json"].(string)
+		if !ok || config == "" {
+			continue
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, []byte(config), "", "  "); err != nil {
+			return err
+		}
+		g.Resources[i].Item["config_json"] = terraformutils.Heredoc("EOT", indented.String())
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/terraformerstring"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const defaultConsulAddress = "127.0.0.1:8500"
+
+// kvServices are alternative representations of KV store, only one of them is supported in import
+var kvServices = []string{"keys", "key_prefix"}
+
+type ConsulProvider struct { //nolint
+	terraformutils.Provider
+	address    string
+	scheme     string
+	token      string
+	datacenter string
+	prefixes   []string
+	kvResource string
+}
+
+func (p *ConsulProvider) Init(args []string) error {
+	p.address = os.Getenv("CONSUL_HTTP_ADDR")
+	if len(args) > 0 && args[0] != "" {
+		p.address = args[0]
+	}
+	if p.address == "" {
+		p.address = defaultConsulAddress
+	}
+	p.scheme = "http"
+	if parts := strings.SplitN(p.address, "://", 2); len(parts) == 2 {
+		p.scheme, p.address = parts[0], parts[1]
+	}
+	p.address = strings.TrimSuffix(p.address, "/")
+
+	p.token = os.Getenv("CONSUL_HTTP_TOKEN")
+	if len(args) > 1 && args[1] != "" {
+		p.token = args[1]
+	}
+	if len(args) > 2 {
+		p.datacenter = args[2]
+	}
+	p.prefixes = []string{""}
+	if len(args) > 3 && args[3] != "" {
+		p.prefixes = strings.Split(args[3], ",")
+	}
+	p.kvResource = "keys"
+	if len(args) > 4 && args[4] != "" {
+		p.kvResource = args[4]
+	}
+	if !terraformerstring.ContainsString(kvServices, p.kvResource) {
+		return errors.New("consul: kv resource must be one of " + strings.Join(kvServices, ", "))
+	}
+	return nil
+}
+
+func (p *ConsulProvider) GetName() string {
+	return "consul"
+}
+
+func (p *ConsulProvider) GetProviderData(arg ...string) map[string]interface{} {
+	consulConfig := map[string]interface{}{
+		"address": p.address,
+		"scheme":  p.scheme,
+	}
+	if p.datacenter != "" {
+		consulConfig["datacenter"] = p.datacenter
+	}
+	return map[string]interface{}{
+		"provider": map[string]interface{}{
+			"consul": consulConfig,
+		},
+	}
+}
+
+func (p *ConsulProvider) GetConfig() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"address":    cty.StringVal(p.address),
+		"scheme":     cty.StringVal(p.scheme),
+		"token":      cty.StringVal(p.token),
+		"datacenter": cty.StringVal(p.datacenter),
+	})
+}
+
+func (p *ConsulProvider) InitService(serviceName string, verbose bool) error {
+	var isSupported bool
+	if _, isSupported = p.GetSupportedService()[serviceName]; !isSupported {
+		return errors.New(p.GetName() + ": " + serviceName + " not supported service")
+	}
+	p.Service = p.GetSupportedService()[serviceName]
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"address":    p.address,
+		"scheme":     p.scheme,
+		"token":      p.token,
+		"datacenter": p.datacenter,
+		"prefixes":   p.prefixes,
+	})
+	return nil
+}
+
+// GetSupportedService return map of support service for Consul, KV store is imported either as keys or
+// as key_prefix service, so both don't manage the same keys
+func (p *ConsulProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	services := map[string]terraformutils.ServiceGenerator{
+		"acl_policy":   &ACLPolicyGenerator{},
+		"acl_token":    &ACLTokenGenerator{},
+		"config_entry": &ConfigEntryGenerator{},
+		"intention":    &IntentionGenerator{},
+		"key_prefix":   &KeyPrefixGenerator{},
+		"keys":         &KeysGenerator{},
+		"service":      &ServiceGenerator{},
+	}
+	kvResource := p.kvResource
+	if kvResource == "" {
+		kvResource = "keys"
+	}
+	for _, service := range kvServices {
+		if service != kvResource {
+			delete(services, service)
+		}
+	}
+	return services
+}
+
+func (ConsulProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{
+		"acl_token": {
+			"acl_policy": []string{"policies", "name"},
+		},
+		"intention": {
+			"service": []string{
+				"source_name", "name",
+				"destination_name", "name",
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/hashicorp/consul/api"
+)
+
+type ConsulService struct { //nolint
+	terraformutils.Service
+	client *api.Client
+}
+
+// consulClient returns API client querying datacenter of provider args, TLS settings like CONSUL_CACERT
+// are read from environment
+func (s *ConsulService) consulClient() (*api.Client, error) {
+	if s.client != nil {
+		return s.client, nil
+	}
+	config := api.DefaultConfig()
+	config.Address = s.Args["address"].(string)
+	config.Scheme = s.Args["scheme"].(string)
+	config.Token = s.Args["token"].(string)
+	config.Datacenter = s.Args["datacenter"].(string)
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	s.client = client
+	return client, nil
+}
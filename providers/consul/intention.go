@@ -0,0 +1,50 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type IntentionGenerator struct {
+	ConsulService
+}
+
+func (g *IntentionGenerator) InitResources() error {
+	client, err := g.consulClient()
+	if err != nil {
+		return err
+	}
+	intentions, _, err := client.Connect().Intentions(nil)
+	if err != nil {
+		return err
+	}
+	for _, intention := range intentions {
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			intention.ID,
+			intention.SourceName+"_to_"+intention.DestinationName,
+			"consul_intention",
+			"consul",
+			map[string]string{
+				"source_name":      intention.SourceName,
+				"destination_name": intention.DestinationName,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package consul
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type KeyPrefixGenerator struct {
+	ConsulService
+}
+
+// InitResources creates one consul_key_prefix per prefix, unlike consul_keys it also deletes
+// keys below the prefix which aren't managed by Terraform
+func (g *KeyPrefixGenerator) InitResources() error {
+	for _, prefix := range g.Args["prefixes"].([]string) {
+		if prefix == "" {
+			continue // whole KV store can't be managed as one prefix
+		}
+		keys, err := g.listKeys(prefix)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		attributes := map[string]string{
+			"path_prefix": prefix,
+		}
+		if datacenter := g.Args["datacenter"].(string); datacenter != "" {
+			attributes["datacenter"] = datacenter
+		}
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			prefix,
+			prefixResourceName(prefix),
+			"consul_key_prefix",
+			"consul",
+			attributes,
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
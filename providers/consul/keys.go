@@ -0,0 +1,136 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package consul
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// maxKeysPerResource is size of consul_keys resource above which keys are split by folders
+const maxKeysPerResource = 500
+
+type KeysGenerator struct {
+	ConsulService
+}
+
+// listKeys returns values of keys below prefix, folder placeholders are skipped
+func (s *ConsulService) listKeys(prefix string) (map[string]string, error) {
+	client, err := s.consulClient()
+	if err != nil {
+		return nil, err
+	}
+	pairs, _, err := client.KV().List(prefix, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys := map[string]string{}
+	for _, pair := range pairs {
+		if strings.HasSuffix(pair.Key, "/") {
+			continue // folder placeholder
+		}
+		keys[pair.Key] = string(pair.Value)
+	}
+	return keys, nil
+}
+
+// splitKeys groups keys below prefix by their folders until each group fits maxKeysPerResource,
+// keys directly in split folder stay in group of the folder itself
+func splitKeys(prefix string, keys map[string]string) map[string]map[string]string {
+	if len(keys) <= maxKeysPerResource {
+		return map[string]map[string]string{prefix: keys}
+	}
+	folders := map[string]map[string]string{}
+	for key, value := range keys {
+		folder := prefix
+		base := strings.TrimSuffix(prefix, "/")
+		if base != "" {
+			base += "/"
+		}
+		rest := strings.TrimPrefix(key, base)
+		if i := strings.Index(rest, "/"); strings.HasPrefix(key, base) && i != -1 {
+			folder = base + rest[:i]
+		}
+		if folders[folder] == nil {
+			folders[folder] = map[string]string{}
+		}
+		folders[folder][key] = value
+	}
+	if len(folders) == 1 {
+		return folders // flat folder can't be split further
+	}
+	groups := map[string]map[string]string{}
+	for folder, folderKeys := range folders {
+		if folder == prefix {
+			groups[folder] = folderKeys
+			continue
+		}
+		for group, groupKeys := range splitKeys(folder, folderKeys) {
+			groups[group] = groupKeys
+		}
+	}
+	return groups
+}
+
+func prefixResourceName(prefix string) string {
+	name := strings.TrimSuffix(prefix, "/")
+	if name == "" {
+		name = "root"
+	}
+	return name
+}
+
+// InitResources creates consul_keys per prefix holding all keys below it, large trees are
+// split into one consul_keys per folder
+func (g *KeysGenerator) InitResources() error {
+	for _, prefix := range g.Args["prefixes"].([]string) {
+		keys, err := g.listKeys(prefix)
+		if err != nil {
+			return err
+		}
+		for group, groupKeys := range splitKeys(prefix, keys) {
+			if len(groupKeys) == 0 {
+				continue
+			}
+			paths := make([]string, 0, len(groupKeys))
+			for path := range groupKeys {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+			attributes := map[string]string{}
+			for i, path := range paths {
+				index := strconv.Itoa(i)
+				attributes["key."+index+".path"] = path
+				attributes["key."+index+".value"] = groupKeys[path]
+			}
+			attributes["key.#"] = strconv.Itoa(len(paths))
+			if datacenter := g.Args["datacenter"].(string); datacenter != "" {
+				attributes["datacenter"] = datacenter
+			}
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				group,
+				prefixResourceName(group),
+				"consul_keys",
+				"consul",
+				attributes,
+				[]string{},
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type ServiceGenerator struct {
+	ConsulService
+}
+
+func (g *ServiceGenerator) InitResources() error {
+	client, err := g.consulClient()
+	if err != nil {
+		return err
+	}
+	services, _, err := client.Catalog().Services(nil)
+	if err != nil {
+		return err
+	}
+	for serviceName := range services {
+		if serviceName == "consul" {
+			continue // registered by the servers themselves
+		}
+		instances, _, err := client.Catalog().Service(serviceName, "", nil)
+		if err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				instance.ServiceID,
+				instance.Node+"_"+instance.ServiceID,
+				"consul_service",
+				"consul",
+				map[string]string{
+					"name":       serviceName,
+					"node":       instance.Node,
+					"service_id": instance.ServiceID,
+				},
+				[]string{},
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
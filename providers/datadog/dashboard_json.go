@@ -0,0 +1,101 @@
+// Copyright 2018 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datadog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// DashboardJSONGenerator ...
+type DashboardJSONGenerator struct {
+	DatadogService
+}
+
+func (g *DashboardJSONGenerator) createResources(dashboards []datadogV1.DashboardSummaryDashboards) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	for _, dashboard := range dashboards {
+		resourceName := dashboard.GetId()
+		resources = append(resources, g.createResource(resourceName))
+	}
+
+	return resources
+}
+
+func (g *DashboardJSONGenerator) createResource(dashboardID string) terraformutils.Resource {
+	return terraformutils.NewSimpleResource(
+		dashboardID,
+		fmt.Sprintf("dashboard_json_%s", dashboardID),
+		"datadog_dashboard_json",
+		"datadog",
+		[]string{},
+	)
+}
+
+// InitResources Generate TerraformResources from Datadog API,
+// from each dashboard create 1 TerraformResource with the whole definition as JSON.
+// Need Dashboard ID as ID for terraform resource
+func (g *DashboardJSONGenerator) InitResources() error {
+	datadogClientV1 := g.Args["datadogClientV1"].(*datadogV1.APIClient)
+	authV1 := g.Args["authV1"].(context.Context)
+
+	resources := []terraformutils.Resource{}
+	for _, filter := range g.Filter {
+		if filter.FieldPath == "id" && filter.IsApplicable("dashboard_json") {
+			for _, value := range filter.AcceptableValues {
+				dashboard, _, err := datadogClientV1.DashboardsApi.GetDashboard(authV1, value).Execute()
+				if err != nil {
+					return err
+				}
+
+				resources = append(resources, g.createResource(dashboard.GetId()))
+			}
+		}
+	}
+
+	if len(resources) > 0 {
+		g.Resources = resources
+		return nil
+	}
+
+	summary, _, err := datadogClientV1.DashboardsApi.ListDashboards(authV1).Execute()
+	if err != nil {
+		return err
+	}
+	g.Resources = g.createResources(summary.GetDashboards())
+	return nil
+}
+
+// PostConvertHook renders the dashboard definition as indented JSON heredoc
+func (g *DashboardJSONGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		dashboard, ok := resource.Item["dashboard"].(string)
+		if !ok || dashboard == "" {
+			continue
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, []byte(dashboard), "", "  "); err == nil {
+			dashboard = indented.String()
+		}
+		g.Resources[i].Item["dashboard"] = terraformutils.Heredoc("EOF", dashboard)
+	}
+	return nil
+}
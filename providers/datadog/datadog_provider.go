@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
 
@@ -95,6 +96,8 @@ func (p *DatadogProvider) Init(args []string) error {
 		})
 	}
 	configV1 := datadogV1.NewConfiguration()
+	// Datadog API rate limits are per organization, wait for reset instead of failing import
+	configV1.HTTPClient = &http.Client{Transport: terraformutils.NewRetryTransport(http.DefaultTransport)}
 
 	// Enable unstable operations
 	configV1.SetUnstableOperationEnabled("GetLogsIndex", true)
@@ -131,6 +134,7 @@ func (p *DatadogProvider) Init(args []string) error {
 		})
 	}
 	configV2 := datadogV2.NewConfiguration()
+	configV2.HTTPClient = &http.Client{Transport: terraformutils.NewRetryTransport(http.DefaultTransport)}
 	datadogClientV2 := datadogV2.NewAPIClient(configV2)
 
 	p.authV1 = authV1
@@ -182,6 +186,7 @@ func (p *DatadogProvider) GetSupportedService() map[string]terraformutils.Servic
 	return map[string]terraformutils.ServiceGenerator{
 		"dashboard_list":                   &DashboardListGenerator{},
 		"dashboard":                        &DashboardGenerator{},
+		"dashboard_json":                   &DashboardJSONGenerator{},
 		"downtime":                         &DowntimeGenerator{},
 		"logs_archive":                     &LogsArchiveGenerator{},
 		"logs_archive_order":               &LogsArchiveOrderGenerator{},
@@ -95,3 +95,15 @@ func (g *MonitorGenerator) InitResources() error {
 	g.Resources = g.createResources(monitors)
 	return nil
 }
+
+// PostConvertHook escapes template sequences, so monitor queries and messages are kept verbatim
+func (g *MonitorGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		for _, key := range []string{"query", "message", "escalation_message"} {
+			if value, ok := resource.Item[key].(string); ok {
+				g.Resources[i].Item[key] = terraformutils.EscapeTemplate(value)
+			}
+		}
+	}
+	return nil
+}
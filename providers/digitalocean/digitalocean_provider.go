@@ -44,7 +44,30 @@ func (p *DigitalOceanProvider) GetProviderData(arg ...string) map[string]interfa
 }
 
 func (DigitalOceanProvider) GetResourceConnections() map[string]map[string][]string {
-	return map[string]map[string][]string{}
+	return map[string]map[string][]string{
+		"firewall": {
+			"droplet": []string{
+				"droplet_ids", "id",
+				"inbound_rule.source_droplet_ids", "id",
+				"outbound_rule.destination_droplet_ids", "id",
+			},
+			"loadbalancer": []string{
+				"inbound_rule.source_load_balancer_uids", "id",
+				"outbound_rule.destination_load_balancer_uids", "id",
+			},
+			"kubernetes_cluster": []string{
+				"inbound_rule.source_kubernetes_ids", "id",
+				"outbound_rule.destination_kubernetes_ids", "id",
+			},
+		},
+		"loadbalancer": {
+			"droplet":     []string{"droplet_ids", "id"},
+			"certificate": []string{"forwarding_rule.certificate_id", "id"},
+		},
+		"volume": {
+			"droplet": []string{"droplet_id", "id"},
+		},
+	}
 }
 
 func (p *DigitalOceanProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
@@ -60,6 +83,7 @@ func (p *DigitalOceanProvider) GetSupportedService() map[string]terraformutils.S
 		"kubernetes_cluster": &KubernetesClusterGenerator{},
 		"loadbalancer":       &LoadBalancerGenerator{},
 		"project":            &ProjectGenerator{},
+		"spaces_bucket":      &SpacesBucketGenerator{},
 		"ssh_key":            &SSHKeyGenerator{},
 		"tag":                &TagGenerator{},
 		"volume":             &VolumeGenerator{},
@@ -16,6 +16,8 @@ package digitalocean
 
 import (
 	"context"
+	"net/http"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/digitalocean/godo"
@@ -30,7 +32,36 @@ func (s *DigitalOceanService) generateClient() *godo.Client {
 	tokenSource := &TokenSource{
 		AccessToken: s.Args["token"].(string),
 	}
-	oauthClient := oauth2.NewClient(context.Background(), tokenSource)
+	// DigitalOcean API rate limits requests per token, throttled requests are retried with backoff
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{
+		Transport: terraformutils.NewRetryTransport(http.DefaultTransport),
+	})
+	oauthClient := oauth2.NewClient(ctx, tokenSource)
 	client := godo.NewClient(oauthClient)
 	return client
 }
+
+// resourceName prefers human name of object, ID is used for objects without name
+func resourceName(name, id string) string {
+	if name == "" {
+		return id
+	}
+	return name
+}
+
+// uniqueResourceNames appends ID to names of resources of same type sharing name, e.g. droplets
+// created by same autoscaling script, so each resource gets address of its own
+func uniqueResourceNames(resources []terraformutils.Resource) []terraformutils.Resource {
+	counts := map[string]int{}
+	for _, r := range resources {
+		counts[r.InstanceInfo.Id]++
+	}
+	for i, r := range resources {
+		if counts[r.InstanceInfo.Id] < 2 {
+			continue
+		}
+		resources[i].ResourceName = r.ResourceName + "_" + strings.TrimPrefix(terraformutils.TfSanitize(r.InstanceState.ID), "tfer--")
+		resources[i].InstanceInfo.Id = r.Type().TFAddress(resources[i].ResourceName)
+	}
+	return resources
+}
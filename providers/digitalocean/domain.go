@@ -17,11 +17,14 @@ package digitalocean
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/digitalocean/godo"
 )
 
+var recordNameReplacer = strings.NewReplacer(".", "_", "-", "_")
+
 type DomainGenerator struct {
 	DigitalOceanService
 }
@@ -76,7 +79,7 @@ func (g *DomainGenerator) loadRecords(ctx context.Context, client *godo.Client,
 		for _, record := range records {
 			g.Resources = append(g.Resources, terraformutils.NewResource(
 				strconv.Itoa(record.ID),
-				strconv.Itoa(record.ID),
+				recordName(domain, record),
 				"digitalocean_record",
 				"digitalocean",
 				map[string]string{"domain": domain},
@@ -101,6 +104,21 @@ func (g *DomainGenerator) loadRecords(ctx context.Context, client *godo.Client,
 	return nil
 }
 
+// recordName names record by type, name and domain, apex "@" is named "apex" and wildcard "*" label "wildcard",
+// records sharing name, e.g. MX records, get their ID appended
+func recordName(domain string, record godo.DomainRecord) string {
+	labels := strings.Split(record.Name, ".")
+	for i, label := range labels {
+		switch label {
+		case "@":
+			labels[i] = "apex"
+		case "*":
+			labels[i] = "wildcard"
+		}
+	}
+	return recordNameReplacer.Replace(record.Type + "_" + strings.Join(labels, ".") + "_" + domain)
+}
+
 func (g *DomainGenerator) InitResources() error {
 	client := g.generateClient()
 	domains, err := g.loadDomains(context.TODO(), client)
@@ -113,5 +131,24 @@ func (g *DomainGenerator) InitResources() error {
 			return err
 		}
 	}
+	g.Resources = uniqueResourceNames(g.Resources)
+	return nil
+}
+
+func (g *DomainGenerator) PostConvertHook() error {
+	domains := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "digitalocean_domain" {
+			domains[r.InstanceState.ID] = "${digitalocean_domain." + r.ResourceName + ".name}"
+		}
+	}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type != "digitalocean_record" {
+			continue
+		}
+		if reference, ok := domains[r.InstanceState.Attributes["domain"]]; ok {
+			r.Item["domain"] = reference
+		}
+	}
 	return nil
 }
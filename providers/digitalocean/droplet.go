@@ -16,12 +16,17 @@ package digitalocean
 
 import (
 	"context"
+	"log"
+	"regexp"
 	"strconv"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/digitalocean/godo"
 )
 
+// userDataHashRegexp matches user data stored in state as SHA1 hash instead of script
+var userDataHashRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
 type DropletGenerator struct {
 	DigitalOceanService
 }
@@ -61,12 +66,12 @@ func (g DropletGenerator) createResources(dropletList []godo.Droplet) []terrafor
 	for _, droplet := range dropletList {
 		resources = append(resources, terraformutils.NewSimpleResource(
 			strconv.Itoa(droplet.ID),
-			droplet.Name,
+			resourceName(droplet.Name, strconv.Itoa(droplet.ID)),
 			"digitalocean_droplet",
 			"digitalocean",
 			[]string{}))
 	}
-	return resources
+	return uniqueResourceNames(resources)
 }
 
 func (g *DropletGenerator) InitResources() error {
@@ -78,3 +83,24 @@ func (g *DropletGenerator) InitResources() error {
 	g.Resources = g.createResources(output)
 	return nil
 }
+
+// PostConvertHook writes user data scripts as heredoc, user data stored as hash can't be recovered
+// and is left out of plans
+func (g *DropletGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		userData, ok := r.Item["user_data"].(string)
+		if !ok || userData == "" {
+			continue
+		}
+		if userDataHashRegexp.MatchString(userData) {
+			log.Printf("digitalocean: user data of droplet %s is stored as hash, it needs to be set manually", r.InstanceState.ID)
+			delete(g.Resources[i].Item, "user_data")
+			g.Resources[i].Item["lifecycle"] = []interface{}{map[string]interface{}{
+				"ignore_changes": []interface{}{"user_data"},
+			}}
+			continue
+		}
+		g.Resources[i].Item["user_data"] = terraformutils.Heredoc("EOF", userData)
+	}
+	return nil
+}
@@ -60,12 +60,12 @@ func (g FirewallGenerator) createResources(firewallList []godo.Firewall) []terra
 	for _, firewall := range firewallList {
 		resources = append(resources, terraformutils.NewSimpleResource(
 			firewall.ID,
-			firewall.Name,
+			resourceName(firewall.Name, firewall.ID),
 			"digitalocean_firewall",
 			"digitalocean",
 			[]string{}))
 	}
-	return resources
+	return uniqueResourceNames(resources)
 }
 
 func (g *FirewallGenerator) InitResources() error {
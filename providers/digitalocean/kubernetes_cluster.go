@@ -99,5 +99,7 @@ func (g *KubernetesClusterGenerator) InitResources() error {
 	for _, cluster := range clusters {
 		g.loadKubernetesNodePools(cluster)
 	}
+	// node pools of different clusters often share name, e.g. default pool
+	g.Resources = uniqueResourceNames(g.Resources)
 	return nil
 }
@@ -58,14 +58,19 @@ func (g LoadBalancerGenerator) listLoadBalancers(ctx context.Context, client *go
 func (g LoadBalancerGenerator) createResources(loadBalancerList []godo.LoadBalancer) []terraformutils.Resource {
 	var resources []terraformutils.Resource
 	for _, loadBalancer := range loadBalancerList {
-		resources = append(resources, terraformutils.NewSimpleResource(
+		resource := terraformutils.NewSimpleResource(
 			loadBalancer.ID,
-			loadBalancer.Name,
+			resourceName(loadBalancer.Name, loadBalancer.ID),
 			"digitalocean_loadbalancer",
 			"digitalocean",
-			[]string{}))
+			[]string{})
+		if loadBalancer.Tag != "" {
+			// droplets are selected by tag, droplet_ids conflicts with droplet_tag
+			resource.IgnoreKeys = append(resource.IgnoreKeys, "^droplet_ids\\.")
+		}
+		resources = append(resources, resource)
 	}
-	return resources
+	return uniqueResourceNames(resources)
 }
 
 func (g *LoadBalancerGenerator) InitResources() error {
@@ -0,0 +1,81 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digitalocean
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// spacesEndpoint is used to list buckets of all regions, bucket regions are resolved by location
+const spacesEndpoint = "https://nyc3.digitaloceanspaces.com"
+
+type SpacesBucketGenerator struct {
+	DigitalOceanService
+}
+
+// generateSpacesClient builds S3 compatible client, Spaces keys are separate from API token
+func (g *SpacesBucketGenerator) generateSpacesClient() (*s3.Client, error) {
+	accessKey := os.Getenv("SPACES_ACCESS_KEY_ID")
+	secretKey := os.Getenv("SPACES_SECRET_ACCESS_KEY")
+	if accessKey == "" || secretKey == "" {
+		return nil, errors.New("set SPACES_ACCESS_KEY_ID and SPACES_SECRET_ACCESS_KEY env vars")
+	}
+	config := defaults.Config()
+	config.Region = "us-east-1"
+	config.Credentials = aws.NewStaticCredentialsProvider(accessKey, secretKey, "")
+	config.EndpointResolver = aws.ResolveWithEndpointURL(spacesEndpoint)
+	client := s3.New(config)
+	client.ForcePathStyle = true
+	return client, nil
+}
+
+func (g *SpacesBucketGenerator) InitResources() error {
+	client, err := g.generateSpacesClient()
+	if err != nil {
+		return err
+	}
+	buckets, err := client.ListBucketsRequest(&s3.ListBucketsInput{}).Send(context.TODO())
+	if err != nil {
+		return err
+	}
+	for _, bucket := range buckets.Buckets {
+		location, err := client.GetBucketLocationRequest(&s3.GetBucketLocationInput{
+			Bucket: bucket.Name,
+		}).Send(context.TODO())
+		if err != nil {
+			return err
+		}
+		name := aws.StringValue(bucket.Name)
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			name,
+			name,
+			"digitalocean_spaces_bucket",
+			"digitalocean",
+			map[string]string{
+				"name":   name,
+				"region": string(location.LocationConstraint),
+			},
+			[]string{},
+			map[string]interface{}{}))
+	}
+	return nil
+}
@@ -16,6 +16,8 @@ package digitalocean
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/digitalocean/godo"
@@ -55,17 +57,33 @@ func (g VolumeGenerator) listVolumes(ctx context.Context, client *godo.Client) (
 	return list, nil
 }
 
+// createResources creates digitalocean_volume for each volume and digitalocean_volume_attachment
+// for each droplet volume is attached to
 func (g VolumeGenerator) createResources(volumeList []godo.Volume) []terraformutils.Resource {
 	var resources []terraformutils.Resource
 	for _, volume := range volumeList {
+		name := resourceName(volume.Name, volume.ID)
 		resources = append(resources, terraformutils.NewSimpleResource(
 			volume.ID,
-			volume.Name,
+			name,
 			"digitalocean_volume",
 			"digitalocean",
 			[]string{}))
+		for _, dropletID := range volume.DropletIDs {
+			resources = append(resources, terraformutils.NewResource(
+				fmt.Sprintf("%d-%s", dropletID, volume.ID),
+				fmt.Sprintf("%s_%d", name, dropletID),
+				"digitalocean_volume_attachment",
+				"digitalocean",
+				map[string]string{
+					"droplet_id": strconv.Itoa(dropletID),
+					"volume_id":  volume.ID,
+				},
+				[]string{},
+				map[string]interface{}{}))
+		}
 	}
-	return resources
+	return uniqueResourceNames(resources)
 }
 
 func (g *VolumeGenerator) InitResources() error {
@@ -77,3 +95,22 @@ func (g *VolumeGenerator) InitResources() error {
 	g.Resources = g.createResources(output)
 	return nil
 }
+
+// PostConvertHook links attachments to volumes
+func (g *VolumeGenerator) PostConvertHook() error {
+	volumes := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "digitalocean_volume" {
+			volumes[r.InstanceState.ID] = "${digitalocean_volume." + r.ResourceName + ".id}"
+		}
+	}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type != "digitalocean_volume_attachment" {
+			continue
+		}
+		if reference, ok := volumes[r.InstanceState.Attributes["volume_id"]]; ok {
+			r.Item["volume_id"] = reference
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/elastic/cloud-sdk-go/pkg/api/deploymentapi"
+)
+
+type DeploymentGenerator struct {
+	ECService
+}
+
+// InitResources lists deployments, topology, autoscaling and configuration of each resource kind are read by terraform
+func (g *DeploymentGenerator) InitResources() error {
+	ecAPI, err := g.ecAPI()
+	if err != nil {
+		return err
+	}
+	response, err := deploymentapi.List(deploymentapi.ListParams{API: ecAPI})
+	if err != nil {
+		return err
+	}
+	for _, deployment := range response.Deployments {
+		resource := terraformutils.NewSimpleResource(
+			*deployment.ID,
+			*deployment.Name+"_"+*deployment.ID,
+			"ec_deployment",
+			"ec",
+			[]string{},
+		)
+		// credentials are only returned on creation
+		resource.IgnoreKeys = append(resource.IgnoreKeys, "^elasticsearch_password$", "^apm_secret_token$")
+		g.Resources = append(g.Resources, resource)
+	}
+	return nil
+}
+
+// PostConvertHook renders user settings of every resource kind as heredoc
+func (g *DeploymentGenerator) PostConvertHook() error {
+	for _, resource := range g.Resources {
+		for _, kind := range []string{"elasticsearch", "kibana", "apm", "enterprise_search"} {
+			blocks, ok := resource.Item[kind].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, block := range blocks {
+				block, ok := block.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				configs, ok := block["config"].([]interface{})
+				if !ok {
+					continue
+				}
+				for _, config := range configs {
+					config, ok := config.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					for key, delimiter := range map[string]string{
+						"user_settings_json":          "JSON",
+						"user_settings_override_json": "JSON",
+						"user_settings_yaml":          "YAML",
+						"user_settings_override_yaml": "YAML",
+					} {
+						if settings, ok := config[key].(string); ok && settings != "" {
+							config[key] = terraformutils.Heredoc(delimiter, settings)
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec
+
+import (
+	"errors"
+	"os"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const ecDefaultEndpoint = "https://api.elastic-cloud.com"
+
+type ECProvider struct { //nolint
+	terraformutils.Provider
+	endpoint string
+	apiKey   string
+}
+
+func (p *ECProvider) Init(args []string) error {
+	p.endpoint = os.Getenv("EC_ENDPOINT")
+	if len(args) > 0 && args[0] != "" {
+		p.endpoint = args[0]
+	}
+	if p.endpoint == "" {
+		p.endpoint = ecDefaultEndpoint
+	}
+	p.apiKey = os.Getenv("EC_API_KEY")
+	if len(args) > 1 && args[1] != "" {
+		p.apiKey = args[1]
+	}
+	if p.apiKey == "" {
+		return errors.New("set EC_API_KEY env var")
+	}
+	return nil
+}
+
+func (p *ECProvider) GetName() string {
+	return "ec"
+}
+
+func (p *ECProvider) GetProviderData(arg ...string) map[string]interface{} {
+	if p.endpoint == ecDefaultEndpoint {
+		return map[string]interface{}{}
+	}
+	return map[string]interface{}{
+		"provider": map[string]interface{}{
+			"ec": map[string]interface{}{
+				"endpoint": p.endpoint,
+			},
+		},
+	}
+}
+
+func (p *ECProvider) GetConfig() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"endpoint": cty.StringVal(p.endpoint),
+		"apikey":   cty.StringVal(p.apiKey),
+	})
+}
+
+func (p *ECProvider) InitService(serviceName string, verbose bool) error {
+	var isSupported bool
+	if _, isSupported = p.GetSupportedService()[serviceName]; !isSupported {
+		return errors.New(p.GetName() + ": " + serviceName + " not supported service")
+	}
+	p.Service = p.GetSupportedService()[serviceName]
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"endpoint": p.endpoint,
+		"api_key":  p.apiKey,
+	})
+	return nil
+}
+
+func (p *ECProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	return map[string]terraformutils.ServiceGenerator{
+		"deployment": &DeploymentGenerator{},
+	}
+}
+
+func (ECProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{}
+}
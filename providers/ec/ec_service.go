@@ -0,0 +1,40 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/elastic/cloud-sdk-go/pkg/api"
+	"github.com/elastic/cloud-sdk-go/pkg/auth"
+)
+
+type ECService struct { //nolint
+	terraformutils.Service
+}
+
+func (s *ECService) ecAPI() (*api.API, error) {
+	apiKey, err := auth.NewAPIKey(s.Args["api_key"].(string))
+	if err != nil {
+		return nil, err
+	}
+	return api.NewAPI(api.Config{
+		Client:     &http.Client{},
+		AuthWriter: apiKey,
+		Host:       strings.TrimSuffix(s.Args["endpoint"].(string), "/"),
+	})
+}
@@ -17,6 +17,8 @@ package fastly
 import (
 	"errors"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 )
@@ -25,8 +27,12 @@ type FastlyProvider struct { //nolint
 	terraformutils.Provider
 	customerID string
 	apiKey     string
+	// serviceVersions are versions of services to import by service ID
+	serviceVersions map[string]int
 }
 
+// Init FastlyProvider with optional service_id=version pairs of services to import,
+// active versions are imported for other services
 func (p *FastlyProvider) Init(args []string) error {
 	if os.Getenv("FASTLY_API_KEY") == "" {
 		return errors.New("set FASTLY_API_KEY env var")
@@ -38,6 +44,21 @@ func (p *FastlyProvider) Init(args []string) error {
 	}
 	p.customerID = os.Getenv("FASTLY_CUSTOMER_ID")
 
+	p.serviceVersions = map[string]int{}
+	if len(args) > 0 && args[0] != "" {
+		for _, pair := range strings.Split(args[0], ",") {
+			parts := strings.SplitN(pair, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return errors.New("service version must be service_id=version pair: " + pair)
+			}
+			serviceVersion, err := strconv.Atoi(parts[1])
+			if err != nil || serviceVersion < 1 {
+				return errors.New("service version must be a positive number: " + pair)
+			}
+			p.serviceVersions[parts[0]] = serviceVersion
+		}
+	}
+
 	return nil
 }
 
@@ -76,8 +97,9 @@ func (p *FastlyProvider) InitService(serviceName string, verbose bool) error {
 	p.Service.SetVerbose(verbose)
 	p.Service.SetProviderName(p.GetName())
 	p.Service.SetArgs(map[string]interface{}{
-		"customer_id": p.customerID,
-		"api_key":     p.apiKey,
+		"customer_id":      p.customerID,
+		"api_key":          p.apiKey,
+		"service_versions": p.serviceVersions,
 	})
 	return nil
 }
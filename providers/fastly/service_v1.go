@@ -15,52 +15,69 @@
 package fastly
 
 import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/fastly/go-fastly/fastly"
 )
 
+// unsafeVariableChars matches characters not allowed in variable names
+var unsafeVariableChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
 type ServiceV1Generator struct {
 	FastlyService
 }
 
-func (g *ServiceV1Generator) loadServices(client *fastly.Client) ([]*fastly.Service, error) {
-	services, err := client.ListServices(&fastly.ListServicesInput{})
-	if err != nil {
-		return nil, err
+// serviceVersion returns version of service to import, active one unless it's set by --service-version, 0 if there is none
+func (g *ServiceV1Generator) serviceVersion(service *fastly.Service) int {
+	versions, _ := g.Args["service_versions"].(map[string]int)
+	if version, ok := versions[service.ID]; ok {
+		return version
 	}
-	for _, service := range services {
-		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
-			service.ID,
-			service.ID,
-			"fastly_service_v1",
-			"fastly",
-			[]string{}))
-	}
-	return services, nil
+	return int(service.ActiveVersion)
 }
 
-func (g *ServiceV1Generator) loadDictionaryItems(client *fastly.Client, serviceID string) error {
-	latest, err := client.LatestVersion(&fastly.LatestVersionInput{
-		Service: serviceID,
-	})
-	if err != nil {
-		return err
+func (g *ServiceV1Generator) loadService(service *fastly.Service, version int) {
+	attributes := map[string]string{}
+	if version != int(service.ActiveVersion) {
+		// provider reads cloned_version instead of active one when service isn't activated
+		attributes["activate"] = "false"
+		attributes["cloned_version"] = strconv.Itoa(version)
 	}
+	g.Resources = append(g.Resources, terraformutils.NewResource(
+		service.ID,
+		service.Name,
+		"fastly_service_v1",
+		"fastly",
+		attributes,
+		[]string{},
+		map[string]interface{}{}))
+}
+
+func (g *ServiceV1Generator) loadDictionaryItems(client *fastly.Client, service *fastly.Service, version int) error {
 	dictionaries, err := client.ListDictionaries(&fastly.ListDictionariesInput{
-		Service: serviceID,
-		Version: latest.Number,
+		Service: service.ID,
+		Version: version,
 	})
 	if err != nil {
 		return err
 	}
 	for _, dictionary := range dictionaries {
+		if dictionary.WriteOnly {
+			log.Printf("WARNING: fastly: items of write-only dictionary %s of service %s can't be read, skipping", dictionary.Name, service.Name)
+			continue
+		}
 		g.Resources = append(g.Resources, terraformutils.NewResource(
 			dictionary.ID,
-			dictionary.ID,
+			service.Name+"_"+dictionary.Name,
 			"fastly_service_dictionary_items_v1",
 			"fastly",
 			map[string]string{
-				"service_id":    serviceID,
+				"service_id":    service.ID,
 				"dictionary_id": dictionary.ID,
 			},
 			[]string{},
@@ -69,16 +86,10 @@ func (g *ServiceV1Generator) loadDictionaryItems(client *fastly.Client, serviceI
 	return nil
 }
 
-func (g *ServiceV1Generator) loadACLEntries(client *fastly.Client, serviceID string) error {
-	latest, err := client.LatestVersion(&fastly.LatestVersionInput{
-		Service: serviceID,
-	})
-	if err != nil {
-		return err
-	}
+func (g *ServiceV1Generator) loadACLEntries(client *fastly.Client, service *fastly.Service, version int) error {
 	acls, err := client.ListACLs(&fastly.ListACLsInput{
-		Service: serviceID,
-		Version: latest.Number,
+		Service: service.ID,
+		Version: version,
 	})
 	if err != nil {
 		return err
@@ -86,11 +97,11 @@ func (g *ServiceV1Generator) loadACLEntries(client *fastly.Client, serviceID str
 	for _, acl := range acls {
 		g.Resources = append(g.Resources, terraformutils.NewResource(
 			acl.ID,
-			acl.ID,
+			service.Name+"_"+acl.Name,
 			"fastly_service_acl_entries_v1",
 			"fastly",
 			map[string]string{
-				"service_id": serviceID,
+				"service_id": service.ID,
 				"acl_id":     acl.ID,
 			},
 			[]string{},
@@ -99,16 +110,10 @@ func (g *ServiceV1Generator) loadACLEntries(client *fastly.Client, serviceID str
 	return nil
 }
 
-func (g *ServiceV1Generator) loadDynamicSnippetContent(client *fastly.Client, serviceID string) error {
-	latest, err := client.LatestVersion(&fastly.LatestVersionInput{
-		Service: serviceID,
-	})
-	if err != nil {
-		return err
-	}
+func (g *ServiceV1Generator) loadDynamicSnippetContent(client *fastly.Client, service *fastly.Service, version int) error {
 	snippets, err := client.ListSnippets(&fastly.ListSnippetsInput{
-		Service: serviceID,
-		Version: latest.Number,
+		Service: service.ID,
+		Version: version,
 	})
 	if err != nil {
 		return err
@@ -118,11 +123,11 @@ func (g *ServiceV1Generator) loadDynamicSnippetContent(client *fastly.Client, se
 		if snippet.Dynamic == 1 {
 			g.Resources = append(g.Resources, terraformutils.NewResource(
 				snippet.ID,
-				snippet.ID,
+				service.Name+"_"+snippet.Name,
 				"fastly_service_dynamic_snippet_content_v1",
 				"fastly",
 				map[string]string{
-					"service_id": serviceID,
+					"service_id": service.ID,
 					"snippet_id": snippet.ID,
 				},
 				[]string{},
@@ -132,28 +137,97 @@ func (g *ServiceV1Generator) loadDynamicSnippetContent(client *fastly.Client, se
 	return nil
 }
 
+// InitResources imports VCL services, Compute@Edge services and services without active version are skipped
 func (g *ServiceV1Generator) InitResources() error {
 	client, err := fastly.NewClient(g.Args["api_key"].(string))
 	if err != nil {
 		return err
 	}
-	services, err := g.loadServices(client)
+	services, err := client.ListServices(&fastly.ListServicesInput{})
 	if err != nil {
 		return err
 	}
 	for _, service := range services {
-		err := g.loadDictionaryItems(client, service.ID)
+		if service.Type != "" && service.Type != "vcl" {
+			log.Printf("fastly: skipping %s service %s", service.Type, service.Name)
+			continue
+		}
+		version := g.serviceVersion(service)
+		if version == 0 {
+			log.Printf("fastly: service %s has no active version, skipping", service.Name)
+			continue
+		}
+		g.loadService(service, version)
+		err := g.loadDictionaryItems(client, service, version)
 		if err != nil {
 			return err
 		}
-		err = g.loadACLEntries(client, service.ID)
+		err = g.loadACLEntries(client, service, version)
 		if err != nil {
 			return err
 		}
-		err = g.loadDynamicSnippetContent(client, service.ID)
+		err = g.loadDynamicSnippetContent(client, service, version)
 		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// PostConvertHook emits VCL as heredoc, replaces TLS client keys of backends
+// by sensitive variables and links service_id of child resources
+func (g *ServiceV1Generator) PostConvertHook() error {
+	serviceNames := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "fastly_service_v1" {
+			serviceNames[r.InstanceState.ID] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "fastly_service_v1":
+			for _, block := range []string{"vcl", "snippet"} {
+				items, ok := r.Item[block].([]interface{})
+				if !ok {
+					continue
+				}
+				for _, item := range items {
+					item, ok := item.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if content, ok := item["content"].(string); ok {
+						item["content"] = terraformutils.Heredoc("VCL", content)
+					}
+				}
+			}
+			backends, _ := r.Item["backend"].([]interface{})
+			for _, backend := range backends {
+				backend, ok := backend.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if key, ok := backend["ssl_client_key"].(string); ok && key != "" {
+					backend["ssl_client_key"] = redactBackendKey(&g.Resources[i], fmt.Sprint(backend["name"]))
+				}
+			}
+		case "fastly_service_dynamic_snippet_content_v1":
+			if content, ok := r.Item["content"].(string); ok {
+				r.Item["content"] = terraformutils.Heredoc("VCL", content)
+			}
+			fallthrough
+		default:
+			if name, ok := serviceNames[r.InstanceState.Attributes["service_id"]]; ok {
+				r.Item["service_id"] = "${fastly_service_v1." + name + ".id}"
+			}
+		}
+	}
+	return nil
+}
+
+// redactBackendKey adds sensitive variable for TLS client key of backend to resource data files
+// and returns reference to it
+func redactBackendKey(r *terraformutils.Resource, backend string) string {
+	variable := strings.TrimPrefix(r.ResourceName, "tfer--") + "_" + unsafeVariableChars.ReplaceAllString(backend, "_") + "_ssl_client_key"
+	return r.AddSensitiveVariable(variable, "TLS client key of backend "+backend+" of service "+r.InstanceState.ID)
+}
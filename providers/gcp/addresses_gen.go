@@ -63,7 +63,7 @@ func (g AddressesGenerator) createResources(ctx context.Context, addressesList *
 // Need addresses name as ID for terraform resource
 func (g *AddressesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
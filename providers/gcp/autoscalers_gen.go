@@ -65,7 +65,7 @@ func (g AutoscalersGenerator) createResources(ctx context.Context, autoscalersLi
 // Need autoscalers name as ID for terraform resource
 func (g *AutoscalersGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
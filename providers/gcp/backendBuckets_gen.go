@@ -63,7 +63,7 @@ func (g BackendBucketsGenerator) createResources(ctx context.Context, backendBuc
 // Need backendBuckets name as ID for terraform resource
 func (g *BackendBucketsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -62,7 +62,7 @@ func (g BackendServicesGenerator) createResources(ctx context.Context, backendSe
 // Need backendServices name as ID for terraform resource
 func (g *BackendServicesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -15,7 +15,9 @@
 package gcp
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"log"
 	"strings"
 
@@ -94,7 +96,7 @@ func (g *BigQueryGenerator) createResourcesTables(ctx context.Context, datasetID
 // Generate TerraformResources from GCP API,
 func (g *BigQueryGenerator) InitResources() error {
 	ctx := context.Background()
-	bigQueryService, err := bigquery.NewService(ctx)
+	bigQueryService, err := bigquery.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -105,26 +107,85 @@ func (g *BigQueryGenerator) InitResources() error {
 	return nil
 }
 
-// PostGenerateHook for convert schema json as heredoc
+// PostConvertHook links tables and authorized views to datasets, converts table schema json
+// and view query as heredoc
 func (g *BigQueryGenerator) PostConvertHook() error {
-	for i, dataset := range g.Resources {
-		if dataset.InstanceInfo.Type != "google_bigquery_dataset" {
-			continue
+	datasets := map[string]string{}
+	tables := map[string]string{}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "google_bigquery_dataset":
+			datasets[r.InstanceState.Attributes["dataset_id"]] = r.ResourceName
+		case "google_bigquery_table":
+			tables[r.InstanceState.Attributes["dataset_id"]+"."+r.InstanceState.Attributes["table_id"]] = r.ResourceName
 		}
-		if val, ok := dataset.Item["default_table_expiration_ms"].(string); ok { // TODO zero int issue
-			if val == "0" {
-				delete(g.Resources[i].Item, "default_table_expiration_ms")
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "google_bigquery_dataset":
+			if val, ok := r.Item["default_table_expiration_ms"].(string); ok { // TODO zero int issue
+				if val == "0" {
+					delete(g.Resources[i].Item, "default_table_expiration_ms")
+				}
+			}
+			g.linkAuthorizedViews(r.Item, datasets, tables)
+		case "google_bigquery_table":
+			if resourceName, exist := datasets[r.InstanceState.Attributes["dataset_id"]]; exist {
+				g.Resources[i].Item["dataset_id"] = "${google_bigquery_dataset." + resourceName + ".dataset_id}"
+			}
+			if schema, ok := r.Item["schema"].(string); ok {
+				g.Resources[i].Item["schema"] = terraformutils.Heredoc("SCHEMA", prettyPrintBigQuerySchema(schema))
+			}
+			if views, ok := r.Item["view"].([]interface{}); ok {
+				for _, view := range views {
+					view := view.(map[string]interface{})
+					if query, ok := view["query"].(string); ok {
+						view["query"] = terraformutils.Heredoc("QUERY", strings.TrimRight(query, "\n"))
+					}
+				}
 			}
 		}
-		for j, table := range g.Resources {
-			if table.InstanceInfo.Type != "google_bigquery_table" {
+	}
+	return nil
+}
+
+// linkAuthorizedViews interpolates views of dataset access entries imported in same run
+func (g *BigQueryGenerator) linkAuthorizedViews(item map[string]interface{}, datasets, tables map[string]string) {
+	project := g.GetArgs()["project"].(string)
+	accesses, _ := item["access"].([]interface{})
+	for _, access := range accesses {
+		views, _ := access.(map[string]interface{})["view"].([]interface{})
+		for _, view := range views {
+			view := view.(map[string]interface{})
+			datasetID, _ := view["dataset_id"].(string)
+			tableID, _ := view["table_id"].(string)
+			if view["project_id"] != project {
 				continue
 			}
-			if table.InstanceState.Attributes["dataset_id"] == dataset.InstanceState.Attributes["dataset_id"] {
-				g.Resources[j].Item["dataset_id"] = "${google_bigquery_dataset." + dataset.ResourceName + ".dataset_id}"
+			if resourceName, exist := tables[datasetID+"."+tableID]; exist {
+				view["table_id"] = "${google_bigquery_table." + resourceName + ".table_id}"
+			}
+			if resourceName, exist := datasets[datasetID]; exist {
+				view["dataset_id"] = "${google_bigquery_dataset." + resourceName + ".dataset_id}"
 			}
 		}
 	}
+}
 
-	return nil
+// prettyPrintBigQuerySchema indents schema json, keys of fields are sorted so output is deterministic
+func prettyPrintBigQuerySchema(schema string) string {
+	var fields interface{}
+	if err := json.Unmarshal([]byte(schema), &fields); err != nil {
+		log.Println(err)
+		return schema
+	}
+	prettySchema := &bytes.Buffer{}
+	encoder := json.NewEncoder(prettySchema)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(fields); err != nil {
+		log.Println(err)
+		return schema
+	}
+	return strings.TrimRight(prettySchema.String(), "\n")
 }
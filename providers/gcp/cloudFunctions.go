@@ -17,6 +17,7 @@ package gcp
 import (
 	"context"
 	"log"
+	"regexp"
 	"strings"
 
 	"google.golang.org/api/cloudfunctions/v1"
@@ -27,6 +28,11 @@ import (
 
 var cloudFunctionsAllowEmptyValues = []string{""}
 
+var cloudFunctionsAdditionalFields = map[string]interface{}{}
+
+// secretEnvRegexp matches names of environment variables which usually carry credentials
+var secretEnvRegexp = regexp.MustCompile(`(?i)(token|key|secret|password|passwd|credential)`)
+
 type CloudFunctionsGenerator struct {
 	GCPService
 }
@@ -34,16 +40,24 @@ type CloudFunctionsGenerator struct {
 // Run on CloudFunctionsList and create for each TerraformResource
 func (g CloudFunctionsGenerator) createResources(ctx context.Context, functionsList *cloudfunctions.ProjectsLocationsFunctionsListCall) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	region := g.GetArgs()["region"].(compute.Region).Name
 	if err := functionsList.Pages(ctx, func(page *cloudfunctions.ListFunctionsResponse) error {
 		for _, functions := range page.Functions {
 			t := strings.Split(functions.Name, "/")
 			name := t[len(t)-1]
-			resources = append(resources, terraformutils.NewSimpleResource(
-				g.GetArgs()["project"].(string)+"/"+g.GetArgs()["region"].(compute.Region).Name+"/"+name,
-				g.GetArgs()["region"].(compute.Region).Name+"_"+name,
+			resources = append(resources, terraformutils.NewResource(
+				project+"/"+region+"/"+name,
+				region+"_"+name,
 				"google_cloudfunctions_function",
 				g.ProviderName,
+				map[string]string{
+					"name":    name,
+					"region":  region,
+					"project": project,
+				},
 				cloudFunctionsAllowEmptyValues,
+				cloudFunctionsAdditionalFields,
 			))
 		}
 		return nil
@@ -53,12 +67,48 @@ func (g CloudFunctionsGenerator) createResources(ctx context.Context, functionsL
 	return resources
 }
 
+// Create google_cloudfunctions_function_iam_binding for invoker role of each function
+func (g CloudFunctionsGenerator) createInvokerBindingResources(cloudfunctionsService *cloudfunctions.Service, functions []terraformutils.Resource) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	region := g.GetArgs()["region"].(compute.Region).Name
+	for _, function := range functions {
+		name := function.InstanceState.Attributes["name"]
+		functionID := "projects/" + project + "/locations/" + region + "/functions/" + name
+		policy, err := cloudfunctionsService.Projects.Locations.Functions.GetIamPolicy(functionID).Do()
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		for _, binding := range policy.Bindings {
+			if binding.Role != "roles/cloudfunctions.invoker" {
+				continue
+			}
+			resources = append(resources, terraformutils.NewResource(
+				functionID+"/"+binding.Role,
+				region+"_"+name+"-invoker",
+				"google_cloudfunctions_function_iam_binding",
+				g.ProviderName,
+				map[string]string{
+					"cloud_function": name,
+					"region":         region,
+					"role":           binding.Role,
+					"project":        project,
+				},
+				cloudFunctionsAllowEmptyValues,
+				cloudFunctionsAdditionalFields,
+			))
+		}
+	}
+	return resources
+}
+
 // Generate TerraformResources from GCP API,
 // from each CloudFunctions create 1 TerraformResource
 // Need CloudFunctions name as ID for terraform resource
 func (g *CloudFunctionsGenerator) InitResources() error {
 	ctx := context.Background()
-	cloudfunctionsService, err := cloudfunctions.NewService(ctx)
+	cloudfunctionsService, err := cloudfunctions.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -66,5 +116,48 @@ func (g *CloudFunctionsGenerator) InitResources() error {
 	functionsList := cloudfunctionsService.Projects.Locations.Functions.List("projects/" + g.GetArgs()["project"].(string) + "/locations/" + g.GetArgs()["region"].(compute.Region).Name)
 
 	g.Resources = g.createResources(ctx, functionsList)
+	g.Resources = append(g.Resources, g.createInvokerBindingResources(cloudfunctionsService, g.Resources)...)
+	return nil
+}
+
+// PostConvertHook links invoker bindings to functions, replaces environment variables
+// with credentials by sensitive variables and ignores changes of source archive,
+// source archives can't be regenerated from API
+func (g *CloudFunctionsGenerator) PostConvertHook() error {
+	functions := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "google_cloudfunctions_function" {
+			functions[r.InstanceState.Attributes["name"]] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "google_cloudfunctions_function":
+			if environment, ok := r.Item["environment_variables"].(map[string]interface{}); ok {
+				for key := range environment {
+					if secretEnvRegexp.MatchString(key) {
+						environment[key] = redactEnvironmentVariable(&g.Resources[i], key, "function "+r.InstanceState.ID)
+					}
+				}
+			}
+			if _, ok := r.Item["source_archive_object"]; ok {
+				g.Resources[i].Item["lifecycle"] = []interface{}{map[string]interface{}{
+					"ignore_changes": []interface{}{"source_archive_object"},
+				}}
+			}
+		case "google_cloudfunctions_function_iam_binding":
+			if resourceName, exist := functions[r.InstanceState.Attributes["cloud_function"]]; exist {
+				g.Resources[i].Item["cloud_function"] = "${google_cloudfunctions_function." + resourceName + ".name}"
+			}
+		}
+	}
 	return nil
 }
+
+// redactEnvironmentVariable adds sensitive variable for environment variable value to resource data files
+// and returns reference to it
+func redactEnvironmentVariable(r *terraformutils.Resource, key, owner string) string {
+	variable := terraformutils.VariableName(r.ResourceName + "_" + strings.ToLower(key))
+	log.Printf("google: environment variable %s of %s looks like a secret, set variable %s", key, owner, variable)
+	return r.AddSensitiveVariable(variable, "Environment variable "+key+" of "+owner)
+}
@@ -0,0 +1,195 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/run/v1"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+var cloudRunAllowEmptyValues = []string{""}
+
+var cloudRunAdditionalFields = map[string]interface{}{}
+
+// cloudRunPlatformAnnotationPrefix is prefix of annotations set by Cloud Run itself
+const cloudRunPlatformAnnotationPrefix = "serving.knative.dev/"
+
+type CloudRunGenerator struct {
+	GCPService
+}
+
+// Run on services of region and create for each TerraformResource
+func (g CloudRunGenerator) createServicesResources(runService *run.APIService) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	region := g.GetArgs()["region"].(compute.Region).Name
+	continueToken := ""
+	for {
+		servicesList := runService.Projects.Locations.Services.List("projects/" + project + "/locations/" + region)
+		if continueToken != "" {
+			servicesList = servicesList.Continue(continueToken)
+		}
+		page, err := servicesList.Do()
+		if err != nil {
+			log.Println(err)
+			return resources
+		}
+		for _, service := range page.Items {
+			name := service.Metadata.Name
+			resources = append(resources, terraformutils.NewResource(
+				"locations/"+region+"/namespaces/"+project+"/services/"+name,
+				region+"_"+name,
+				"google_cloud_run_service",
+				g.ProviderName,
+				map[string]string{
+					"name":     name,
+					"location": region,
+					"project":  project,
+				},
+				cloudRunAllowEmptyValues,
+				cloudRunAdditionalFields,
+			))
+		}
+		if page.Metadata == nil || page.Metadata.Continue == "" {
+			return resources
+		}
+		continueToken = page.Metadata.Continue
+	}
+}
+
+// Create google_cloud_run_service_iam_binding for invoker role of each service
+func (g CloudRunGenerator) createInvokerBindingResources(runService *run.APIService, services []terraformutils.Resource) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	region := g.GetArgs()["region"].(compute.Region).Name
+	for _, service := range services {
+		name := service.InstanceState.Attributes["name"]
+		serviceID := "projects/" + project + "/locations/" + region + "/services/" + name
+		policy, err := runService.Projects.Locations.Services.GetIamPolicy(serviceID).Do()
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		for _, binding := range policy.Bindings {
+			if binding.Role != "roles/run.invoker" {
+				continue
+			}
+			resources = append(resources, terraformutils.NewResource(
+				serviceID+"/"+binding.Role,
+				region+"_"+name+"-invoker",
+				"google_cloud_run_service_iam_binding",
+				g.ProviderName,
+				map[string]string{
+					"service":  name,
+					"location": region,
+					"role":     binding.Role,
+					"project":  project,
+				},
+				cloudRunAllowEmptyValues,
+				cloudRunAdditionalFields,
+			))
+		}
+	}
+	return resources
+}
+
+// Generate TerraformResources from GCP API,
+// create terraform resource for each service + invoker binding
+func (g *CloudRunGenerator) InitResources() error {
+	ctx := context.Background()
+	runService, err := run.NewService(ctx, clientOptions(ctx)...)
+	if err != nil {
+		return err
+	}
+
+	g.Resources = g.createServicesResources(runService)
+	g.Resources = append(g.Resources, g.createInvokerBindingResources(runService, g.Resources)...)
+	return nil
+}
+
+// PostConvertHook prunes annotations set by Cloud Run, links invoker bindings to services
+// and replaces container environment variables with credentials by sensitive variables
+func (g *CloudRunGenerator) PostConvertHook() error {
+	services := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "google_cloud_run_service" {
+			services[r.InstanceState.Attributes["name"]] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "google_cloud_run_service":
+			pruneCloudRunAnnotations(r.Item)
+			if templates, ok := r.Item["template"].([]interface{}); ok {
+				for _, template := range templates {
+					pruneCloudRunAnnotations(template.(map[string]interface{}))
+					g.redactContainersEnv(i, template.(map[string]interface{}))
+				}
+			}
+		case "google_cloud_run_service_iam_binding":
+			if resourceName, exist := services[r.InstanceState.Attributes["service"]]; exist {
+				g.Resources[i].Item["service"] = "${google_cloud_run_service." + resourceName + ".name}"
+			}
+		}
+	}
+	return nil
+}
+
+func (g *CloudRunGenerator) redactContainersEnv(i int, template map[string]interface{}) {
+	specs, _ := template["spec"].([]interface{})
+	for _, spec := range specs {
+		containers, _ := spec.(map[string]interface{})["containers"].([]interface{})
+		for _, container := range containers {
+			envs, _ := container.(map[string]interface{})["env"].([]interface{})
+			for _, env := range envs {
+				env := env.(map[string]interface{})
+				key, _ := env["name"].(string)
+				if _, hasValue := env["value"]; hasValue && secretEnvRegexp.MatchString(key) {
+					env["value"] = redactEnvironmentVariable(&g.Resources[i], key, "service "+g.Resources[i].InstanceState.ID)
+				}
+			}
+		}
+	}
+}
+
+// pruneCloudRunAnnotations removes serving.knative.dev/* annotations from metadata blocks,
+// they are managed by Cloud Run and produce perpetual diffs
+func pruneCloudRunAnnotations(item map[string]interface{}) {
+	metadatas, _ := item["metadata"].([]interface{})
+	for _, metadata := range metadatas {
+		metadata, ok := metadata.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		annotations, ok := metadata["annotations"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key := range annotations {
+			if strings.HasPrefix(key, cloudRunPlatformAnnotationPrefix) {
+				delete(annotations, key)
+			}
+		}
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+}
@@ -50,7 +50,7 @@ func (g CloudDNSGenerator) createZonesResources(ctx context.Context, svc *dns.Se
 				cloudDNSAllowEmptyValues,
 				cloudDNSAdditionalFields,
 			))
-			records := g.createRecordsResources(ctx, svc, project, zone.Name)
+			records := g.createRecordsResources(ctx, svc, project, zone)
 			resources = append(resources, records...)
 		}
 		return nil
@@ -61,11 +61,18 @@ func (g CloudDNSGenerator) createZonesResources(ctx context.Context, svc *dns.Se
 	}
 	return resources
 }
-func (g CloudDNSGenerator) createRecordsResources(ctx context.Context, svc *dns.Service, project, zoneName string) []terraformutils.Resource {
+
+// NS and SOA records at zone apex are created with zone, they are skipped unless --dns-include-apex-records is set
+func (g CloudDNSGenerator) createRecordsResources(ctx context.Context, svc *dns.Service, project string, zone *dns.ManagedZone) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
+	zoneName := zone.Name
+	includeApexRecords, _ := g.GetArgs()["dns_include_apex_records"].(bool)
 	managedRecordsListCall := svc.ResourceRecordSets.List(project, zoneName)
 	err := managedRecordsListCall.Pages(ctx, func(listDNS *dns.ResourceRecordSetsListResponse) error {
 		for _, record := range listDNS.Rrsets {
+			if !includeApexRecords && record.Name == zone.DnsName && (record.Type == "NS" || record.Type == "SOA") {
+				continue
+			}
 			resources = append(resources, terraformutils.NewResource(
 				fmt.Sprintf("%s/%s/%s", zoneName, record.Name, record.Type),
 				zoneName+"_"+strings.TrimSuffix(record.Name+"-"+record.Type, "."),
@@ -95,7 +102,7 @@ func (g CloudDNSGenerator) createRecordsResources(ctx context.Context, svc *dns.
 func (g *CloudDNSGenerator) InitResources() error {
 	project := g.GetArgs()["project"].(string)
 	ctx := context.Background()
-	svc, err := dns.NewService(ctx)
+	svc, err := dns.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -109,16 +116,21 @@ func (g *CloudDNSGenerator) PostConvertHook() error {
 		if resourceRecord.InstanceInfo.Type == "google_dns_managed_zone" {
 			continue
 		}
-		item := resourceRecord.Item
-		zoneID := item["managed_zone"].(string)
+		// TXT values may contain characters terraform interpolates
+		if rrdatas, ok := resourceRecord.Item["rrdatas"].([]interface{}); ok {
+			for j, rrdata := range rrdatas {
+				rrdatas[j] = terraformutils.EscapeTemplate(rrdata.(string))
+			}
+		}
+		zoneID := resourceRecord.InstanceState.Attributes["managed_zone"]
 		for _, resourceZone := range g.Resources {
 			if resourceZone.InstanceInfo.Type != "google_dns_managed_zone" {
 				continue
 			}
 			if zoneID == resourceZone.InstanceState.ID {
 				g.Resources[i].Item["managed_zone"] = "${google_dns_managed_zone." + resourceZone.ResourceName + ".name}"
-				name := g.Resources[i].Item["name"].(string)
-				name = strings.ReplaceAll(name, resourceZone.Item["dns_name"].(string), "")
+				name := resourceRecord.InstanceState.Attributes["name"]
+				name = strings.TrimSuffix(name, resourceZone.InstanceState.Attributes["dns_name"])
 				g.Resources[i].Item["name"] = name + "${google_dns_managed_zone." + resourceZone.ResourceName + ".dns_name}"
 			}
 		}
@@ -16,6 +16,9 @@ package gcp
 
 import (
 	"context"
+	"log"
+	"sort"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 
@@ -26,6 +29,21 @@ var cloudSQLAllowEmptyValues = []string{}
 
 var cloudSQLAdditionalFields = map[string]interface{}{}
 
+// cloudSQLDefaultFlags are flag values Cloud SQL uses when flag isn't set
+var cloudSQLDefaultFlags = map[string]string{
+	"general_log":                 "off",
+	"local_infile":                "off",
+	"log_checkpoints":             "off",
+	"log_connections":             "off",
+	"log_disconnections":          "off",
+	"log_lock_waits":              "off",
+	"log_output":                  "FILE",
+	"log_temp_files":              "-1",
+	"slow_query_log":              "off",
+	"skip_show_database":          "off",
+	"cloudsql.iam_authentication": "off",
+}
+
 type CloudSQLGenerator struct {
 	GCPService
 }
@@ -52,6 +70,9 @@ func (g *CloudSQLGenerator) loadDBInstances(svc *sqladmin.Service, project strin
 		if err != nil {
 			return err
 		}
+		if err := g.loadUsers(svc, dbInstance.Name, project); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -81,13 +102,37 @@ func (g *CloudSQLGenerator) loadDBs(svc *sqladmin.Service, instanceName, project
 	return nil
 }
 
+func (g *CloudSQLGenerator) loadUsers(svc *sqladmin.Service, instanceName, project string) error {
+	users, err := svc.Users.List(project, instanceName).Do()
+	if err != nil {
+		return err
+	}
+	for _, user := range users.Items {
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			user.Name+"/"+user.Host+"/"+instanceName,
+			instanceName+"-"+user.Name+"-"+user.Host,
+			"google_sql_user",
+			g.ProviderName,
+			map[string]string{
+				"instance": instanceName,
+				"project":  project,
+				"name":     user.Name,
+				"host":     user.Host,
+			},
+			cloudSQLAllowEmptyValues,
+			cloudSQLAdditionalFields,
+		))
+	}
+	return nil
+}
+
 // Generate TerraformResources from GCP API,
 // from each databases create many TerraformResource(dbinstance + databases)
 // Need dbinstance name as ID for terraform resource
 func (g *CloudSQLGenerator) InitResources() error {
 	project := g.GetArgs()["project"].(string)
 	ctx := context.Background()
-	svc, err := sqladmin.NewService(ctx)
+	svc, err := sqladmin.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -97,3 +142,81 @@ func (g *CloudSQLGenerator) InitResources() error {
 
 	return nil
 }
+
+// PostConvertHook links replicas and users with imported instances, keeps database flags stable
+// and replaces users passwords, Cloud SQL doesn't return them, with sensitive variables
+func (g *CloudSQLGenerator) PostConvertHook() error {
+	instances := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "google_sql_database_instance" {
+			instances[r.InstanceState.Attributes["name"]] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "google_sql_database_instance":
+			if resourceName, exist := instances[r.InstanceState.Attributes["master_instance_name"]]; exist {
+				g.Resources[i].Item["master_instance_name"] = "${google_sql_database_instance." + resourceName + ".name}"
+			}
+			g.normalizeDatabaseFlags(i)
+		case "google_sql_database":
+			if resourceName, exist := instances[r.InstanceState.Attributes["instance"]]; exist {
+				g.Resources[i].Item["instance"] = "${google_sql_database_instance." + resourceName + ".name}"
+			}
+		case "google_sql_user":
+			if resourceName, exist := instances[r.InstanceState.Attributes["instance"]]; exist {
+				g.Resources[i].Item["instance"] = "${google_sql_database_instance." + resourceName + ".name}"
+			}
+			// IAM users sign in with IAM credentials and have no password
+			if userType := r.InstanceState.Attributes["type"]; userType != "" && userType != "BUILT_IN" {
+				continue
+			}
+			variable := terraformutils.VariableName(r.ResourceName + "_password")
+			log.Printf("google: password of sql user %s isn't returned by Cloud SQL, set variable %s", r.InstanceState.ID, variable)
+			g.Resources[i].Item["password"] = g.Resources[i].AddSensitiveVariable(variable, "Password of sql user "+r.InstanceState.ID)
+			g.Resources[i].Item["lifecycle"] = []interface{}{map[string]interface{}{
+				"ignore_changes": []interface{}{"password"},
+			}}
+		}
+	}
+	return nil
+}
+
+// normalizeDatabaseFlags sorts database flags by name and prunes ones set to default value from generated HCL
+func (g *CloudSQLGenerator) normalizeDatabaseFlags(i int) {
+	settings, ok := g.Resources[i].Item["settings"].([]interface{})
+	if !ok || len(settings) == 0 {
+		return
+	}
+	setting, ok := settings[0].(map[string]interface{})
+	if !ok {
+		return
+	}
+	flags, ok := setting["database_flags"].([]interface{})
+	if !ok {
+		return
+	}
+	var normalized []interface{}
+	for _, flag := range flags {
+		flagMap, ok := flag.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := flagMap["name"].(string)
+		value, _ := flagMap["value"].(string)
+		if defaultValue, exist := cloudSQLDefaultFlags[name]; exist && strings.EqualFold(defaultValue, value) {
+			continue
+		}
+		normalized = append(normalized, flagMap)
+	}
+	sort.Slice(normalized, func(a, b int) bool {
+		nameA, _ := normalized[a].(map[string]interface{})["name"].(string)
+		nameB, _ := normalized[b].(map[string]interface{})["name"].(string)
+		return nameA < nameB
+	})
+	if len(normalized) == 0 {
+		delete(setting, "database_flags")
+	} else {
+		setting["database_flags"] = normalized
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"google.golang.org/api/composer/v1"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+var composerAllowEmptyValues = []string{""}
+
+var composerAdditionalFields = map[string]interface{}{}
+
+type ComposerGenerator struct {
+	GCPService
+}
+
+// Run on environmentsList and create for each TerraformResource
+func (g ComposerGenerator) createResources(ctx context.Context, environmentsList *composer.ProjectsLocationsEnvironmentsListCall) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	region := g.GetArgs()["region"].(compute.Region).Name
+	if err := environmentsList.Pages(ctx, func(page *composer.ListEnvironmentsResponse) error {
+		for _, environment := range page.Environments {
+			t := strings.Split(environment.Name, "/")
+			name := t[len(t)-1]
+			resources = append(resources, terraformutils.NewResource(
+				environment.Name,
+				region+"_"+name,
+				"google_composer_environment",
+				g.ProviderName,
+				map[string]string{
+					"name":    name,
+					"region":  region,
+					"project": project,
+				},
+				composerAllowEmptyValues,
+				composerAdditionalFields,
+			))
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+// Generate TerraformResources from GCP API,
+// from each Composer environment create 1 TerraformResource
+func (g *ComposerGenerator) InitResources() error {
+	ctx := context.Background()
+	composerService, err := composer.NewService(ctx, clientOptions(ctx)...)
+	if err != nil {
+		return err
+	}
+
+	environmentsList := composerService.Projects.Locations.Environments.List("projects/" + g.GetArgs()["project"].(string) + "/locations/" + g.GetArgs()["region"].(compute.Region).Name)
+	g.Resources = g.createResources(ctx, environmentsList)
+	return nil
+}
+
+// PostConvertHook replaces Airflow environment variables with credentials by sensitive variables
+// and prunes labels added by Composer
+func (g *ComposerGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		pruneSystemLabels(g.Resources[i].Item)
+		configs, _ := r.Item["config"].([]interface{})
+		for _, config := range configs {
+			softwareConfigs, _ := config.(map[string]interface{})["software_config"].([]interface{})
+			for _, softwareConfig := range softwareConfigs {
+				environment, ok := softwareConfig.(map[string]interface{})["env_variables"].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				for key := range environment {
+					if secretEnvRegexp.MatchString(key) {
+						environment[key] = redactEnvironmentVariable(&g.Resources[i], key, "environment "+r.InstanceState.ID)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
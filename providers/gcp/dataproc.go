@@ -17,6 +17,7 @@ package gcp
 import (
 	"context"
 	"log"
+	"strings"
 
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/dataproc/v1"
@@ -60,6 +61,34 @@ func (g DataprocGenerator) createClusterResources(ctx context.Context, clusterLi
 	return resources
 }
 
+// Run on autoscaling policies of region and create for each TerraformResource
+func (g DataprocGenerator) createAutoscalingPolicyResources(ctx context.Context, policyList *dataproc.ProjectsRegionsAutoscalingPoliciesListCall) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	region := g.GetArgs()["region"].(compute.Region).Name
+	if err := policyList.Pages(ctx, func(page *dataproc.ListAutoscalingPoliciesResponse) error {
+		for _, policy := range page.Policies {
+			resources = append(resources, terraformutils.NewResource(
+				"projects/"+project+"/locations/"+region+"/autoscalingPolicies/"+policy.Id,
+				region+"_"+policy.Id,
+				"google_dataproc_autoscaling_policy",
+				g.ProviderName,
+				map[string]string{
+					"policy_id": policy.Id,
+					"location":  region,
+					"project":   project,
+				},
+				dataprocAllowEmptyValues,
+				dataprocAdditionalFields,
+			))
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
 /*
 // Run on DataprocJobList and create for each TerraformResource
 func (g DataprocGenerator) createJobResources(jobList *dataproc.ProjectsRegionsJobsListCall, ctx context.Context) []terraformutils.Resource {
@@ -92,7 +121,7 @@ func (g DataprocGenerator) createJobResources(jobList *dataproc.ProjectsRegionsJ
 // Need DataprocGenerator name as ID for terraform resource
 func (g *DataprocGenerator) InitResources() error {
 	ctx := context.Background()
-	dataprocService, err := dataproc.NewService(ctx)
+	dataprocService, err := dataproc.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -100,8 +129,57 @@ func (g *DataprocGenerator) InitResources() error {
 	clusterList := dataprocService.Projects.Regions.Clusters.List(g.GetArgs()["project"].(string), g.GetArgs()["region"].(compute.Region).Name)
 	g.Resources = g.createClusterResources(ctx, clusterList)
 
+	policyList := dataprocService.Projects.Regions.AutoscalingPolicies.List("projects/" + g.GetArgs()["project"].(string) + "/regions/" + g.GetArgs()["region"].(compute.Region).Name)
+	g.Resources = append(g.Resources, g.createAutoscalingPolicyResources(ctx, policyList)...)
+
 	// jobList := dataprocService.Projects.Regions.Jobs.List(g.GetArgs()["project"].(string), g.GetArgs()["region"])
 	// g.Resources = append(g.Resources, g.createJobResources(jobList, ctx)...)
 
 	return nil
 }
+
+// PostConvertHook links clusters to autoscaling policies and prunes labels added by Dataproc
+func (g *DataprocGenerator) PostConvertHook() error {
+	policies := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "google_dataproc_autoscaling_policy" {
+			policies[r.InstanceState.Attributes["policy_id"]] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type != "google_dataproc_cluster" {
+			continue
+		}
+		pruneSystemLabels(g.Resources[i].Item)
+		clusterConfigs, _ := r.Item["cluster_config"].([]interface{})
+		for _, clusterConfig := range clusterConfigs {
+			autoscalingConfigs, _ := clusterConfig.(map[string]interface{})["autoscaling_config"].([]interface{})
+			for _, autoscalingConfig := range autoscalingConfigs {
+				autoscalingConfig := autoscalingConfig.(map[string]interface{})
+				policyURI, _ := autoscalingConfig["policy_uri"].(string)
+				t := strings.Split(policyURI, "/")
+				if resourceName, exist := policies[t[len(t)-1]]; exist {
+					autoscalingConfig["policy_uri"] = "${google_dataproc_autoscaling_policy." + resourceName + ".name}"
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// pruneSystemLabels removes goog-* labels, they are added by Google services
+// to resources they manage and can't be set by users
+func pruneSystemLabels(item map[string]interface{}) {
+	labels, ok := item["labels"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for key := range labels {
+		if strings.HasPrefix(key, "goog-") {
+			delete(labels, key)
+		}
+	}
+	if len(labels) == 0 {
+		delete(item, "labels")
+	}
+}
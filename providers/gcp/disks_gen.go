@@ -65,7 +65,7 @@ func (g DisksGenerator) createResources(ctx context.Context, disksList *compute.
 // Need disks name as ID for terraform resource
 func (g *DisksGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g ExternalVpnGatewaysGenerator) createResources(ctx context.Context, exter
 // Need externalVpnGateways name as ID for terraform resource
 func (g *ExternalVpnGatewaysGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g FirewallGenerator) createResources(ctx context.Context, firewallList *co
 // Need firewall name as ID for terraform resource
 func (g *FirewallGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g ForwardingRulesGenerator) createResources(ctx context.Context, forwardin
 // Need forwardingRules name as ID for terraform resource
 func (g *ForwardingRulesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
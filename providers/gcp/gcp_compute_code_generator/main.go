@@ -99,7 +99,7 @@ func (g {{.titleResourceName}}Generator) createResources(ctx context.Context, {{
 // Need {{.resource}} name as ID for terraform resource
 func (g *{{.titleResourceName}}Generator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
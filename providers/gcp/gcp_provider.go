@@ -28,10 +28,16 @@ type GCPProvider struct { //nolint
 	projectName  string
 	region       compute.Region
 	providerType string
+
+	includeServiceAgents      bool
+	exportIam                 bool
+	iamGranularity            string
+	dnsIncludeApexRecords     bool
+	networkIncludeAutoCreated bool
 }
 
 func GetRegions(project string) []string {
-	computeService, err := compute.NewService(context.Background())
+	computeService, err := compute.NewService(context.Background(), clientOptions(context.Background())...)
 	if err != nil {
 		return []string{}
 	}
@@ -47,7 +53,7 @@ func GetRegions(project string) []string {
 }
 
 func getRegion(project, regionName string) *compute.Region {
-	computeService, err := compute.NewService(context.Background())
+	computeService, err := compute.NewService(context.Background(), clientOptions(context.Background())...)
 	if err != nil {
 		return &compute.Region{}
 	}
@@ -70,6 +76,19 @@ func (p *GCPProvider) Init(args []string) error {
 	p.projectName = projectName
 	p.region = *getRegion(projectName, args[0])
 	p.providerType = args[2]
+	p.includeServiceAgents = len(args) > 3 && args[3] == "true"
+	p.exportIam = len(args) > 4 && args[4] == "true"
+	p.iamGranularity = "member"
+	if len(args) > 5 && args[5] != "" {
+		p.iamGranularity = args[5]
+	}
+	switch p.iamGranularity {
+	case "member", "binding", "policy":
+	default:
+		return errors.New("google: iam granularity must be member, binding or policy")
+	}
+	p.dnsIncludeApexRecords = len(args) > 6 && args[6] == "true"
+	p.networkIncludeAutoCreated = len(args) > 7 && args[7] == "true"
 	return nil
 }
 
@@ -90,38 +109,68 @@ func (p *GCPProvider) InitService(serviceName string, verbose bool) error {
 	p.Service.SetVerbose(verbose)
 	p.Service.SetProviderName(p.GetName())
 	p.Service.SetArgs(map[string]interface{}{
-		"region":  p.region,
-		"project": p.projectName,
+		"region":                       p.region,
+		"project":                      p.projectName,
+		"include_service_agents":       p.includeServiceAgents,
+		"export_iam":                   p.exportIam,
+		"iam_granularity":              p.iamGranularity,
+		"dns_include_apex_records":     p.dnsIncludeApexRecords,
+		"network_include_auto_created": p.networkIncludeAutoCreated,
 	})
 	return nil
 }
 
+// GetAggregateServices returns services importing resources of compute services together
+func (p *GCPProvider) GetAggregateServices() []string {
+	return []string{"lb", "networking"}
+}
+
 // GetGCPSupportService return map of support service for GCP
 func (p *GCPProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
 	services := ComputeServices
 	services["bigQuery"] = &GCPFacade{service: &BigQueryGenerator{}}
 	services["cloudFunctions"] = &GCPFacade{service: &CloudFunctionsGenerator{}}
+	services["cloudRun"] = &GCPFacade{service: &CloudRunGenerator{}}
 	services["cloudsql"] = &GCPFacade{service: &CloudSQLGenerator{}}
+	services["composer"] = &GCPFacade{service: &ComposerGenerator{}}
 	services["dataProc"] = &GCPFacade{service: &DataprocGenerator{}}
 	services["dns"] = &GCPFacade{service: &CloudDNSGenerator{}}
 	services["gcs"] = &GCPFacade{service: &GcsGenerator{}}
 	services["gke"] = &GCPFacade{service: &GkeGenerator{}}
 	services["iam"] = &GCPFacade{service: &IamGenerator{}}
 	services["kms"] = &GCPFacade{service: &KmsGenerator{}}
+	services["lb"] = &GCPFacade{service: &LbGenerator{}}
 	services["logging"] = &GCPFacade{service: &LoggingGenerator{}}
 	services["memoryStore"] = &GCPFacade{service: &MemoryStoreGenerator{}}
 	services["monitoring"] = &GCPFacade{service: &MonitoringGenerator{}}
+	services["networking"] = &GCPFacade{service: &NetworkingGenerator{}}
 	services["project"] = &GCPFacade{service: &ProjectGenerator{}}
 	services["instances"] = &GCPFacade{service: &InstancesGenerator{}}
 	services["pubsub"] = &GCPFacade{service: &PubsubGenerator{}}
 	services["schedulerJobs"] = &GCPFacade{service: &SchedulerJobsGenerator{}}
+	services["spanner"] = &GCPFacade{service: &SpannerGenerator{}}
 	return services
 }
 
 func (GCPProvider) GetResourceConnections() map[string]map[string][]string {
 	return map[string]map[string][]string{
 		"backendBuckets": {"gcs": []string{"bucket_name", "name"}},
-		"firewall":       {"networks": []string{"network", "self_link"}},
+		"bigQuery":       {"iam": []string{"access.user_by_email", "email"}},
+		"dns": {
+			"networks": []string{
+				"private_visibility_config.networks.network_url", "self_link",
+				"peering_config.target_network.network_url", "self_link",
+			},
+		},
+		"firewall": {"networks": []string{"network", "self_link"}},
+		// only service accounts of iam service have email
+		"kms": {"iam": []string{"members", "serviceAccount:email"}},
+		"lb": {
+			"instanceGroupManagers":       []string{"backend.group", "instance_group"},
+			"regionInstanceGroupManagers": []string{"backend.group", "instance_group"},
+			"networks":                    []string{"network", "self_link"},
+			"subnetworks":                 []string{"subnetwork", "self_link"},
+		},
 		"gke": {
 			"networks":    []string{"network", "self_link"},
 			"subnetworks": []string{"subnetwork", "self_link"},
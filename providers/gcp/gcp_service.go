@@ -15,9 +15,17 @@
 package gcp
 
 import (
+	"context"
+	"log"
+	"net/http"
+
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
 )
 
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
 type GCPService struct { //nolint
 	terraformutils.Service
 }
@@ -30,3 +38,17 @@ func (s *GCPService) applyCustomProviderType(resources []terraformutils.Resource
 	}
 	return editedResources
 }
+
+// clientOptions returns options for Google API clients, calls are written to audit log when it's enabled
+func clientOptions(ctx context.Context) []option.ClientOption {
+	logger := terraformutils.GetAPIAuditLogger()
+	if logger == nil {
+		return nil
+	}
+	transport, err := htransport.NewTransport(ctx, logger.RoundTripper(http.DefaultTransport), option.WithScopes(cloudPlatformScope))
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+	return []option.ClientOption{option.WithHTTPClient(&http.Client{Transport: transport})}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 
+	"google.golang.org/api/cloudresourcemanager/v1"
 	"google.golang.org/api/storage/v1"
 )
 
@@ -35,9 +36,14 @@ type GcsGenerator struct {
 
 func (g *GcsGenerator) createBucketsResources(ctx context.Context, gcsService *storage.Service) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
+	projectNumber := g.getProjectNumber(ctx)
 	bucketList := gcsService.Buckets.List(g.GetArgs()["project"].(string))
 	if err := bucketList.Pages(ctx, func(page *storage.Buckets) error {
 		for _, bucket := range page.Items {
+			if projectNumber != 0 && bucket.ProjectNumber != projectNumber {
+				log.Printf("skipping bucket %s: it belongs to project %d", bucket.Name, bucket.ProjectNumber)
+				continue
+			}
 			resources = append(resources, terraformutils.NewResource(
 				bucket.Name,
 				bucket.Name,
@@ -74,44 +80,85 @@ func (g *GcsGenerator) createBucketsResources(ctx context.Context, gcsService *s
 				GcsAllowEmptyValues,
 				GcsAdditionalFields,
 			))
+			resources = append(resources, g.createBucketIamResources(gcsService, bucket)...)
+			resources = append(resources, g.createNotificationResources(gcsService, bucket)...)
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+// getProjectNumber returns number of imported project, 0 when it's unknown
+func (g *GcsGenerator) getProjectNumber(ctx context.Context) uint64 {
+	cm, err := cloudresourcemanager.NewService(ctx, clientOptions(ctx)...)
+	if err != nil {
+		log.Println(err)
+		return 0
+	}
+	project, err := cm.Projects.Get(g.GetArgs()["project"].(string)).Context(ctx).Do()
+	if err != nil {
+		log.Println(err)
+		return 0
+	}
+	return uint64(project.ProjectNumber)
+}
+
+// createBucketIamResources creates bucket IAM resources in granularity from --iam-granularity:
+// member (default) - one resource per role member, binding - one resource per role, policy - one resource per bucket
+func (g *GcsGenerator) createBucketIamResources(gcsService *storage.Service, bucket *storage.Bucket) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	granularity, _ := g.GetArgs()["iam_granularity"].(string)
+	if granularity == "policy" {
+		return append(resources, terraformutils.NewResource(
+			bucket.Name,
+			bucket.Name,
+			"google_storage_bucket_iam_policy",
+			g.ProviderName,
+			map[string]string{
+				"bucket": bucket.Name,
+			},
+			GcsAllowEmptyValues,
+			GcsAdditionalFields,
+		))
+	}
+	policy, err := gcsService.Buckets.GetIamPolicy(bucket.Name).Do()
+	if err != nil {
+		log.Println(err)
+		return resources
+	}
+	for _, binding := range policy.Bindings {
+		if granularity == "binding" {
 			resources = append(resources, terraformutils.NewResource(
-				bucket.Name,
-				bucket.Name,
+				"b/"+bucket.Name+"/"+binding.Role,
+				bucket.Name+"-"+binding.Role,
 				"google_storage_bucket_iam_binding",
 				g.ProviderName,
 				map[string]string{
 					"bucket": bucket.Name,
+					"role":   binding.Role,
 				},
 				GcsAllowEmptyValues,
 				GcsAdditionalFields,
 			))
+			continue
+		}
+		for _, member := range binding.Members {
 			resources = append(resources, terraformutils.NewResource(
-				bucket.Name,
-				bucket.Name,
+				"b/"+bucket.Name+"/"+binding.Role+"/"+member,
+				bucket.Name+"-"+binding.Role+"-"+member,
 				"google_storage_bucket_iam_member",
 				g.ProviderName,
 				map[string]string{
 					"bucket": bucket.Name,
+					"role":   binding.Role,
+					"member": member,
 				},
 				GcsAllowEmptyValues,
 				GcsAdditionalFields,
 			))
-			resources = append(resources, terraformutils.NewResource(
-				bucket.Name,
-				bucket.Name,
-				"google_storage_bucket_iam_policy",
-				g.ProviderName,
-				map[string]string{
-					"bucket": bucket.Name,
-				},
-				GcsAllowEmptyValues,
-				GcsAdditionalFields,
-			))
-			resources = append(resources, g.createNotificationResources(gcsService, bucket)...)
 		}
-		return nil
-	}); err != nil {
-		log.Println(err)
 	}
 	return resources
 }
@@ -129,7 +176,9 @@ func (g *GcsGenerator) createNotificationResources(gcsService *storage.Service,
 			bucket.Name+"/"+notification.Id,
 			"google_storage_notification",
 			g.ProviderName,
-			map[string]string{},
+			map[string]string{
+				"bucket": bucket.Name,
+			},
 			GcsAllowEmptyValues,
 			GcsAdditionalFields,
 		))
@@ -170,7 +219,7 @@ func (g *GcsGenerator) createTransferJobsResources(ctx context.Context, storageT
 // Need bucket name as ID for terraform resource
 func (g *GcsGenerator) InitResources() error {
 	ctx := context.Background()
-	gcsService, err := storage.NewService(ctx)
+	gcsService, err := storage.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		log.Print(err)
 		return err
@@ -178,7 +227,7 @@ func (g *GcsGenerator) InitResources() error {
 	g.Resources = g.createBucketsResources(ctx, gcsService)
 
 	// TODO find bug with storageTransferService.TransferJobs.List().Pages
-	// storageTransferService, err := storagetransfer.NewService(ctx)
+	// storageTransferService, err := storagetransfer.NewService(ctx, clientOptions(ctx)...)
 	// if err != nil {
 	// 	log.Print(err)
 	// 		return err
@@ -190,7 +239,19 @@ func (g *GcsGenerator) InitResources() error {
 // PostGenerateHook for add bucket policy json as heredoc
 // support only bucket with policy
 func (g *GcsGenerator) PostConvertHook() error {
+	buckets := map[string]string{}
+	for _, resource := range g.Resources {
+		if resource.InstanceInfo.Type == "google_storage_bucket" {
+			buckets[resource.InstanceState.Attributes["name"]] = resource.ResourceName
+		}
+	}
 	for i, resource := range g.Resources {
+		if resource.InstanceInfo.Type == "google_storage_bucket" {
+			continue
+		}
+		if resourceName, exist := buckets[resource.InstanceState.Attributes["bucket"]]; exist {
+			g.Resources[i].Item["bucket"] = "${google_storage_bucket." + resourceName + ".name}"
+		}
 		if resource.InstanceInfo.Type != "google_storage_bucket_iam_policy" {
 			continue
 		}
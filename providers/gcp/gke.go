@@ -49,6 +49,8 @@ func (g *GkeGenerator) initClusters(clusters *container.ListClustersResponse) []
 				"project":  g.GetArgs()["project"].(string),
 				"location": cluster.Location,
 				"zone":     cluster.Zone,
+				// all node pools, default one included, are imported as separate resources
+				"remove_default_node_pool": "true",
 			},
 			GkeAllowEmptyValues,
 			GkeAdditionalFields,
@@ -59,9 +61,10 @@ func (g *GkeGenerator) initClusters(clusters *container.ListClustersResponse) []
 			"^zone$",
 			"^node_pool\\.(.*)",   // delete node_pool config from google_container_cluster
 			"^node_config\\.(.*)", // delete node_config config from google_container_cluster
-			"^ip_allocation_policy\\.[0-9]\\.cluster_secondary_range_name$",  // conflict with cluster_ipv4_cidr_block
-			"^ip_allocation_policy\\.[0-9]\\.services_secondary_range_name$", // conflict with services_ipv4_cidr_block
-			"^ip_allocation_policy\\.[0-9]\\.create_subnetwork")              // only for create new cluster conflict with others ip_allocation_policy fields
+			"^ip_allocation_policy\\.[0-9]\\.cluster_secondary_range_name$",                           // conflict with cluster_ipv4_cidr_block
+			"^ip_allocation_policy\\.[0-9]\\.services_secondary_range_name$",                          // conflict with services_ipv4_cidr_block
+			"^ip_allocation_policy\\.[0-9]\\.create_subnetwork",                                       // only for create new cluster conflict with others ip_allocation_policy fields
+			"^master_auth\\.[0-9]\\.(client_certificate|client_key|cluster_ca_certificate|password)$") // never write cluster credentials
 		resources = append(resources, resource)
 		resources = append(resources, g.initNodePools(cluster.NodePools, cluster.Name, cluster.Location)...)
 	}
@@ -93,7 +96,7 @@ func (g *GkeGenerator) initNodePools(nodePools []*container.NodePool, clusterNam
 // Generate TerraformResources from GCP API,
 func (g *GkeGenerator) InitResources() error {
 	ctx := context.Background()
-	service, err := container.NewService(ctx)
+	service, err := container.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		log.Print(err)
 		return err
@@ -126,6 +129,12 @@ func (g *GkeGenerator) PostConvertHook() error {
 				}
 			}
 		}
+		// autoscaler changes node count
+		if autoscaling, ok := r.Item["autoscaling"].([]interface{}); ok && len(autoscaling) > 0 {
+			g.Resources[i].Item["lifecycle"] = []interface{}{map[string]interface{}{
+				"ignore_changes": []interface{}{"node_count"},
+			}}
+		}
 		for _, cluster := range g.Resources {
 			if cluster.InstanceState.Attributes["name"] == r.InstanceState.Attributes["cluster"] {
 				g.Resources[i].Item["cluster"] = "${google_container_cluster." + cluster.ResourceName + ".name}"
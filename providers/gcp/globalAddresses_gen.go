@@ -63,7 +63,7 @@ func (g GlobalAddressesGenerator) createResources(ctx context.Context, globalAdd
 // Need globalAddresses name as ID for terraform resource
 func (g *GlobalAddressesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -62,7 +62,7 @@ func (g GlobalForwardingRulesGenerator) createResources(ctx context.Context, glo
 // Need globalForwardingRules name as ID for terraform resource
 func (g *GlobalForwardingRulesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
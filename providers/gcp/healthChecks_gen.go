@@ -63,7 +63,7 @@ func (g HealthChecksGenerator) createResources(ctx context.Context, healthChecks
 // Need healthChecks name as ID for terraform resource
 func (g *HealthChecksGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
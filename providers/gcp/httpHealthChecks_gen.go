@@ -63,7 +63,7 @@ func (g HttpHealthChecksGenerator) createResources(ctx context.Context, httpHeal
 // Need httpHealthChecks name as ID for terraform resource
 func (g *HttpHealthChecksGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g HttpsHealthChecksGenerator) createResources(ctx context.Context, httpsHe
 // Need httpsHealthChecks name as ID for terraform resource
 func (g *HttpsHealthChecksGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -16,13 +16,21 @@ package gcp
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"regexp"
+	"strings"
 
 	admin "cloud.google.com/go/iam/admin/apiv1"
 	"google.golang.org/api/cloudresourcemanager/v1"
 	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
 	adminpb "google.golang.org/genproto/googleapis/iam/admin/v1"
+	iampb "google.golang.org/genproto/googleapis/iam/v1"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 )
@@ -31,11 +39,34 @@ var IamAllowEmptyValues = []string{"tags."}
 
 var IamAdditionalFields = map[string]interface{}{}
 
+// Google-managed service agents, their IAM is managed by Google
+var serviceAgentRegexp = regexp.MustCompile(`^serviceAccount:(service-[0-9]+@.*|[0-9]+@cloudservices\.gserviceaccount\.com|.*@gcp-sa-[a-z-]+\.iam\.gserviceaccount\.com)$`)
+
+var serviceAgentRoleRegexp = regexp.MustCompile(`(?i)serviceAgent$`)
+
+const serviceAccountKeyComment = `Private key of service account key can't be retrieved from Google Cloud,
+only metadata of existing key is imported. Key material changes are ignored so key isn't recreated.`
+
+const iamAPIEndpoint = "https://iam.googleapis.com/v1/"
+
+// workloadIdentityList is response of workload identity pools and providers list calls,
+// they aren't available in generated IAM client yet
+type workloadIdentityList struct {
+	WorkloadIdentityPools         []workloadIdentityResource `json:"workloadIdentityPools"`
+	WorkloadIdentityPoolProviders []workloadIdentityResource `json:"workloadIdentityPoolProviders"`
+	NextPageToken                 string                     `json:"nextPageToken"`
+}
+
+type workloadIdentityResource struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
 type IamGenerator struct {
 	GCPService
 }
 
-func (g IamGenerator) createServiceAccountResources(serviceAccountsIterator *admin.ServiceAccountIterator) []terraformutils.Resource {
+func (g IamGenerator) createServiceAccountResources(ctx context.Context, client *admin.IamClient, serviceAccountsIterator *admin.ServiceAccountIterator) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
 	re := regexp.MustCompile(`^[a-z]`)
 	for {
@@ -58,10 +89,146 @@ func (g IamGenerator) createServiceAccountResources(serviceAccountsIterator *adm
 			g.ProviderName,
 			IamAllowEmptyValues,
 		))
+		policy, err := client.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{Resource: serviceAccount.Name})
+		if err != nil {
+			log.Println("error with service account policy:", err)
+			continue
+		}
+		for _, b := range policy.InternalProto.Bindings {
+			resources = append(resources, terraformutils.NewResource(
+				serviceAccount.Name+"/"+b.Role,
+				serviceAccount.UniqueId+"-"+b.Role,
+				"google_service_account_iam_binding",
+				g.ProviderName,
+				map[string]string{
+					"service_account_id": serviceAccount.Name,
+					"role":               b.Role,
+				},
+				IamAllowEmptyValues,
+				IamAdditionalFields,
+			))
+		}
+		resources = append(resources, g.createServiceAccountKeyResources(ctx, client, serviceAccount)...)
+	}
+	return resources
+}
+
+// Create google_service_account_key for user managed keys of service account, only metadata is imported
+func (g IamGenerator) createServiceAccountKeyResources(ctx context.Context, client *admin.IamClient, serviceAccount *adminpb.ServiceAccount) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	keys, err := client.ListServiceAccountKeys(ctx, &adminpb.ListServiceAccountKeysRequest{
+		Name:     serviceAccount.Name,
+		KeyTypes: []adminpb.ListServiceAccountKeysRequest_KeyType{adminpb.ListServiceAccountKeysRequest_USER_MANAGED},
+	})
+	if err != nil {
+		log.Println("error with service account keys:", err)
+		return resources
+	}
+	for _, key := range keys.Keys {
+		t := strings.Split(key.Name, "/")
+		resource := terraformutils.NewResource(
+			key.Name,
+			serviceAccount.UniqueId+"-"+t[len(t)-1],
+			"google_service_account_key",
+			g.ProviderName,
+			map[string]string{
+				"service_account_id": serviceAccount.Name,
+			},
+			IamAllowEmptyValues,
+			IamAdditionalFields,
+		)
+		resource.IgnoreKeys = append(resource.IgnoreKeys, "^private_key$", "^public_key$")
+		resource.Comment = serviceAccountKeyComment
+		resources = append(resources, resource)
 	}
 	return resources
 }
 
+// Create google_iam_workload_identity_pool and google_iam_workload_identity_pool_provider for each pool provider
+func (g *IamGenerator) createWorkloadIdentityResources(ctx context.Context, project string) ([]terraformutils.Resource, error) {
+	resources := []terraformutils.Resource{}
+	client, _, err := htransport.NewClient(ctx, append(clientOptions(ctx), option.WithScopes(cloudPlatformScope))...)
+	if err != nil {
+		return resources, err
+	}
+	pools, err := listWorkloadIdentity(client, "projects/"+project+"/locations/global/workloadIdentityPools")
+	if err != nil {
+		return resources, err
+	}
+	for _, pool := range pools.WorkloadIdentityPools {
+		if pool.State == "DELETED" {
+			continue
+		}
+		t := strings.Split(pool.Name, "/")
+		poolID := t[len(t)-1]
+		resources = append(resources, terraformutils.NewResource(
+			pool.Name,
+			poolID,
+			"google_iam_workload_identity_pool",
+			g.ProviderName,
+			map[string]string{
+				"workload_identity_pool_id": poolID,
+				"project":                   project,
+			},
+			IamAllowEmptyValues,
+			IamAdditionalFields,
+		))
+		providers, err := listWorkloadIdentity(client, pool.Name+"/providers")
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		for _, provider := range providers.WorkloadIdentityPoolProviders {
+			if provider.State == "DELETED" {
+				continue
+			}
+			t := strings.Split(provider.Name, "/")
+			providerID := t[len(t)-1]
+			resources = append(resources, terraformutils.NewResource(
+				provider.Name,
+				poolID+"-"+providerID,
+				"google_iam_workload_identity_pool_provider",
+				g.ProviderName,
+				map[string]string{
+					"workload_identity_pool_id":          poolID,
+					"workload_identity_pool_provider_id": providerID,
+					"project":                            project,
+				},
+				IamAllowEmptyValues,
+				IamAdditionalFields,
+			))
+		}
+	}
+	return resources, nil
+}
+
+// listWorkloadIdentity returns all pages of workload identity pools or providers of parent
+func listWorkloadIdentity(client *http.Client, parent string) (workloadIdentityList, error) {
+	all := workloadIdentityList{}
+	pageToken := ""
+	for {
+		resp, err := client.Get(iamAPIEndpoint + parent + "?pageToken=" + url.QueryEscape(pageToken))
+		if err != nil {
+			return all, err
+		}
+		page := workloadIdentityList{}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return all, fmt.Errorf("error listing %s: %s", parent, resp.Status)
+		}
+		if err != nil {
+			return all, err
+		}
+		all.WorkloadIdentityPools = append(all.WorkloadIdentityPools, page.WorkloadIdentityPools...)
+		all.WorkloadIdentityPoolProviders = append(all.WorkloadIdentityPoolProviders, page.WorkloadIdentityPoolProviders...)
+		if page.NextPageToken == "" {
+			return all, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
 func (g *IamGenerator) createIamCustomRoleResources(rolesResponse *adminpb.ListRolesResponse, project string) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
 	for _, role := range rolesResponse.Roles {
@@ -88,10 +255,17 @@ func (g *IamGenerator) createIamCustomRoleResources(rolesResponse *adminpb.ListR
 	return resources
 }
 
+func (g *IamGenerator) isServiceAgent(member string) bool {
+	return !g.GetArgs()["include_service_agents"].(bool) && serviceAgentRegexp.MatchString(member)
+}
+
 func (g *IamGenerator) createIamMemberResources(policy *cloudresourcemanager.Policy, project string) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
 	for _, b := range policy.Bindings {
 		for _, m := range b.Members {
+			if g.isServiceAgent(m) {
+				continue
+			}
 			resources = append(resources, terraformutils.NewResource(
 				b.Role+m,
 				b.Role+m,
@@ -111,6 +285,35 @@ func (g *IamGenerator) createIamMemberResources(policy *cloudresourcemanager.Pol
 	return resources
 }
 
+// Bindings are authoritative, members of service agents can't be dropped from them
+// so only roles granted to service agents alone are skipped
+func (g *IamGenerator) createIamBindingResources(policy *cloudresourcemanager.Policy, project string) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	for _, b := range policy.Bindings {
+		onlyServiceAgents := true
+		for _, m := range b.Members {
+			onlyServiceAgents = onlyServiceAgents && g.isServiceAgent(m)
+		}
+		if onlyServiceAgents || (!g.GetArgs()["include_service_agents"].(bool) && serviceAgentRoleRegexp.MatchString(b.Role)) {
+			continue
+		}
+		resources = append(resources, terraformutils.NewResource(
+			project+"/"+b.Role,
+			b.Role,
+			"google_project_iam_binding",
+			g.ProviderName,
+			map[string]string{
+				"role":    b.Role,
+				"project": project,
+			},
+			IamAllowEmptyValues,
+			IamAdditionalFields,
+		))
+	}
+
+	return resources
+}
+
 func (g *IamGenerator) InitResources() error {
 	ctx := context.Background()
 
@@ -125,7 +328,7 @@ func (g *IamGenerator) InitResources() error {
 		return err
 	}
 
-	cm, err := cloudresourcemanager.NewService(context.Background())
+	cm, err := cloudresourcemanager.NewService(context.Background(), clientOptions(context.Background())...)
 	if err != nil {
 		return err
 	}
@@ -135,8 +338,69 @@ func (g *IamGenerator) InitResources() error {
 		return err
 	}
 
-	g.Resources = g.createServiceAccountResources(serviceAccountsIterator)
+	g.Resources = g.createServiceAccountResources(ctx, client, serviceAccountsIterator)
 	g.Resources = append(g.Resources, g.createIamCustomRoleResources(rolesResponse, projectID)...)
-	g.Resources = append(g.Resources, g.createIamMemberResources(policyResponse, projectID)...)
+	workloadIdentityResources, err := g.createWorkloadIdentityResources(ctx, projectID)
+	if err != nil {
+		log.Println("error with workload identity pools:", err)
+	}
+	g.Resources = append(g.Resources, workloadIdentityResources...)
+	// project policy would include service agents, policy granularity exports members like the default
+	if granularity, _ := g.GetArgs()["iam_granularity"].(string); granularity == "binding" {
+		g.Resources = append(g.Resources, g.createIamBindingResources(policyResponse, projectID)...)
+	} else {
+		g.Resources = append(g.Resources, g.createIamMemberResources(policyResponse, projectID)...)
+	}
+	return nil
+}
+
+// PostConvertHook interpolates emails of service accounts imported in same run,
+// links keys to service accounts and pool providers to pools
+func (g *IamGenerator) PostConvertHook() error {
+	serviceAccounts := map[string]string{}
+	pools := map[string]string{}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "google_service_account":
+			serviceAccounts["serviceAccount:"+r.InstanceState.Attributes["email"]] = r.ResourceName
+		case "google_iam_workload_identity_pool":
+			pools[r.InstanceState.Attributes["workload_identity_pool_id"]] = r.ResourceName
+		}
+	}
+	linkMember := func(member string) string {
+		if resourceName, exist := serviceAccounts[member]; exist {
+			return "serviceAccount:${google_service_account." + resourceName + ".email}"
+		}
+		return member
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "google_project_iam_member":
+			if member, ok := r.Item["member"].(string); ok {
+				g.Resources[i].Item["member"] = linkMember(member)
+			}
+		case "google_project_iam_binding", "google_service_account_iam_binding":
+			if members, ok := r.Item["members"].([]interface{}); ok {
+				for j, member := range members {
+					members[j] = linkMember(member.(string))
+				}
+			}
+		case "google_service_account_key":
+			g.Resources[i].Item["lifecycle"] = []interface{}{map[string]interface{}{
+				"ignore_changes": []interface{}{"key_algorithm", "private_key_type", "public_key_type", "public_key_data", "keepers"},
+			}}
+		case "google_iam_workload_identity_pool_provider":
+			if resourceName, exist := pools[r.InstanceState.Attributes["workload_identity_pool_id"]]; exist {
+				g.Resources[i].Item["workload_identity_pool_id"] = "${google_iam_workload_identity_pool." + resourceName + ".workload_identity_pool_id}"
+			}
+		}
+		if r.InstanceInfo.Type == "google_service_account_iam_binding" || r.InstanceInfo.Type == "google_service_account_key" {
+			for _, serviceAccount := range g.Resources {
+				if serviceAccount.InstanceInfo.Type == "google_service_account" && serviceAccount.InstanceState.ID == r.InstanceState.Attributes["service_account_id"] {
+					g.Resources[i].Item["service_account_id"] = "${google_service_account." + serviceAccount.ResourceName + ".name}"
+				}
+			}
+		}
+	}
 	return nil
 }
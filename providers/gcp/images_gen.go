@@ -63,7 +63,7 @@ func (g ImagesGenerator) createResources(ctx context.Context, imagesList *comput
 // Need images name as ID for terraform resource
 func (g *ImagesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func (g InstanceGroupManagersGenerator) createResources(ctx context.Context, ins
 // Need instanceGroupManagers name as ID for terraform resource
 func (g *InstanceGroupManagersGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func (g InstanceGroupsGenerator) createResources(ctx context.Context, instanceGr
 // Need instanceGroups name as ID for terraform resource
 func (g *InstanceGroupsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g InstanceTemplatesGenerator) createResources(ctx context.Context, instanc
 // Need instanceTemplates name as ID for terraform resource
 func (g *InstanceTemplatesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -69,7 +69,7 @@ func (g InstancesGenerator) createResources(ctx context.Context, instancesList *
 // Need instances name as ID for terraform resource
 func (g *InstancesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
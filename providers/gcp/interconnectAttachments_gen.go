@@ -63,7 +63,7 @@ func (g InterconnectAttachmentsGenerator) createResources(ctx context.Context, i
 // Need interconnectAttachments name as ID for terraform resource
 func (g *InterconnectAttachmentsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
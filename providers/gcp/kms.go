@@ -65,6 +65,10 @@ func (g *KmsGenerator) createKmsKeyResources(ctx context.Context, keyRingName st
 	keyList := kmsService.Projects.Locations.KeyRings.CryptoKeys.List(keyRingName)
 	if err := keyList.Pages(ctx, func(page *cloudkms.ListCryptoKeysResponse) error {
 		for _, key := range page.CryptoKeys {
+			if !g.hasActiveVersion(ctx, key, kmsService) {
+				log.Println("google: skip crypto key " + key.Name + " without enabled versions")
+				continue
+			}
 			tm := strings.Split(key.Name, "/")
 			resources = append(resources, terraformutils.NewResource(
 				key.Name,
@@ -78,10 +82,67 @@ func (g *KmsGenerator) createKmsKeyResources(ctx context.Context, keyRingName st
 				kmsAllowEmptyValues,
 				kmsAdditionalFields,
 			))
+			resources = append(resources, g.createKmsKeyIamBindingResources(key.Name, tm[1]+"_"+tm[3]+"_"+tm[5]+"_"+tm[7], kmsService)...)
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+// hasActiveVersion returns false for keys whose versions are all disabled or destroyed,
+// keys can't be deleted in KMS so they are left over after destroying their material
+func (g *KmsGenerator) hasActiveVersion(ctx context.Context, key *cloudkms.CryptoKey, kmsService *cloudkms.Service) bool {
+	if key.Primary != nil {
+		return isActiveKmsKeyVersion(key.Primary)
+	}
+	// asymmetric keys have no primary version
+	hasActiveVersion := false
+	versionsList := kmsService.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.List(key.Name)
+	if err := versionsList.Pages(ctx, func(page *cloudkms.ListCryptoKeyVersionsResponse) error {
+		for _, version := range page.CryptoKeyVersions {
+			if isActiveKmsKeyVersion(version) {
+				hasActiveVersion = true
+			}
 		}
 		return nil
 	}); err != nil {
 		log.Println(err)
+		return true
+	}
+	return hasActiveVersion
+}
+
+func isActiveKmsKeyVersion(version *cloudkms.CryptoKeyVersion) bool {
+	switch version.State {
+	case "DISABLED", "DESTROYED", "DESTROY_SCHEDULED":
+		return false
+	}
+	return true
+}
+
+// Create google_kms_crypto_key_iam_binding for each role in crypto key IAM policy
+func (g *KmsGenerator) createKmsKeyIamBindingResources(keyName, resourceName string, kmsService *cloudkms.Service) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	policy, err := kmsService.Projects.Locations.KeyRings.CryptoKeys.GetIamPolicy(keyName).Do()
+	if err != nil {
+		log.Println(err)
+		return resources
+	}
+	for _, binding := range policy.Bindings {
+		resources = append(resources, terraformutils.NewResource(
+			keyName+"/"+binding.Role,
+			resourceName+"-"+binding.Role,
+			"google_kms_crypto_key_iam_binding",
+			g.ProviderName,
+			map[string]string{
+				"crypto_key_id": keyName,
+				"role":          binding.Role,
+			},
+			kmsAllowEmptyValues,
+			kmsAdditionalFields,
+		))
 	}
 	return resources
 }
@@ -89,7 +150,7 @@ func (g *KmsGenerator) createKmsKeyResources(ctx context.Context, keyRingName st
 // Generate TerraformResources from GCP API,
 func (g *KmsGenerator) InitResources() error {
 	ctx := context.Background()
-	kmsService, err := cloudkms.NewService(ctx)
+	kmsService, err := cloudkms.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -100,17 +161,27 @@ func (g *KmsGenerator) InitResources() error {
 	return nil
 }
 
+// PostConvertHook links crypto keys to key rings and IAM bindings to crypto keys
 func (g *KmsGenerator) PostConvertHook() error {
-	for i, key := range g.Resources {
-		if key.InstanceInfo.Type != "google_kms_crypto_key" {
-			continue
+	keyRings := map[string]string{}
+	keys := map[string]string{}
+	for _, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "google_kms_key_ring":
+			keyRings["projects/"+r.InstanceState.Attributes["project"]+"/locations/"+r.InstanceState.Attributes["location"]+"/keyRings/"+r.InstanceState.Attributes["name"]] = r.ResourceName
+		case "google_kms_crypto_key":
+			keys[r.InstanceState.ID] = r.ResourceName
 		}
-		for _, keyRing := range g.Resources {
-			if keyRing.InstanceInfo.Type != "google_kms_key_ring" {
-				continue
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "google_kms_crypto_key":
+			if resourceName, exist := keyRings[r.InstanceState.Attributes["key_ring"]]; exist {
+				g.Resources[i].Item["key_ring"] = "${google_kms_key_ring." + resourceName + ".self_link}"
 			}
-			if key.Item["key_ring"] == keyRing.InstanceState.ID {
-				g.Resources[i].Item["key_ring"] = "${google_kms_key_ring." + keyRing.ResourceName + ".self_link}"
+		case "google_kms_crypto_key_iam_binding":
+			if resourceName, exist := keys[r.InstanceState.Attributes["crypto_key_id"]]; exist {
+				g.Resources[i].Item["crypto_key_id"] = "${google_kms_crypto_key." + resourceName + ".self_link}"
 			}
 		}
 	}
@@ -0,0 +1,152 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"log"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+
+	"google.golang.org/api/compute/v1"
+)
+
+var lbAllowEmptyValues = []string{""}
+
+var lbAdditionalFields = map[string]interface{}{}
+
+// LbGenerator imports load balancers together, so forwarding rules, proxies, URL maps,
+// backend services and health checks reference each other inside of one service
+type LbGenerator struct {
+	GCPService
+}
+
+func (g *LbGenerator) lbServices() []terraformutils.ServiceGenerator {
+	return []terraformutils.ServiceGenerator{
+		&GlobalForwardingRulesGenerator{},
+		&ForwardingRulesGenerator{},
+		&TargetHttpProxiesGenerator{},
+		&TargetHttpsProxiesGenerator{},
+		&TargetSslProxiesGenerator{},
+		&TargetTcpProxiesGenerator{},
+		&RegionTargetHttpProxiesGenerator{},
+		&RegionTargetHttpsProxiesGenerator{},
+		&UrlMapsGenerator{},
+		&RegionUrlMapsGenerator{},
+		&BackendServicesGenerator{},
+		&RegionBackendServicesGenerator{},
+		&HealthChecksGenerator{},
+		&RegionHealthChecksGenerator{},
+		&HttpHealthChecksGenerator{},
+		&HttpsHealthChecksGenerator{},
+	}
+}
+
+// Managed certificates are imported as google_compute_managed_ssl_certificate,
+// self managed ones without certificate and private key
+func (g *LbGenerator) createSslCertificatesResources(ctx context.Context, computeService *compute.Service) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	if err := computeService.SslCertificates.List(project).Pages(ctx, func(page *compute.SslCertificateList) error {
+		for _, obj := range page.Items {
+			resourceType := "google_compute_ssl_certificate"
+			if obj.Type == "MANAGED" {
+				resourceType = "google_compute_managed_ssl_certificate"
+			}
+			resources = append(resources, terraformutils.NewResource(
+				"projects/"+project+"/global/sslCertificates/"+obj.Name,
+				obj.Name,
+				resourceType,
+				g.ProviderName,
+				map[string]string{
+					"name":    obj.Name,
+					"project": project,
+				},
+				lbAllowEmptyValues,
+				lbAdditionalFields,
+			))
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+func (g *LbGenerator) InitResources() error {
+	for _, service := range g.lbServices() {
+		service.SetArgs(g.GetArgs())
+		service.SetProviderName(g.ProviderName)
+		if err := service.InitResources(); err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, service.GetResources()...)
+	}
+
+	ctx := context.Background()
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
+	if err != nil {
+		return err
+	}
+	g.Resources = append(g.Resources, g.createSslCertificatesResources(ctx, computeService)...)
+	return nil
+}
+
+// PostConvertHook links load balancer parts by their self links
+// and replaces self managed certificates with variables
+func (g *LbGenerator) PostConvertHook() error {
+	selfLinks := map[string]string{}
+	for _, r := range g.Resources {
+		if selfLink := r.InstanceState.Attributes["self_link"]; selfLink != "" {
+			selfLinks[selfLink] = "${" + r.InstanceInfo.Type + "." + r.ResourceName + ".self_link}"
+		}
+	}
+	for i, r := range g.Resources {
+		linkSelfLinks(r.Item, selfLinks)
+		if r.InstanceInfo.Type != "google_compute_ssl_certificate" {
+			continue
+		}
+		certificateVariable := terraformutils.VariableName(r.ResourceName + "_certificate")
+		privateKeyVariable := terraformutils.VariableName(r.ResourceName + "_private_key")
+		log.Printf("google: certificate and private key of ssl certificate %s aren't exported, set variables %s and %s", r.InstanceState.ID, certificateVariable, privateKeyVariable)
+		g.Resources[i].Item["certificate"] = g.Resources[i].AddVariable(terraformutils.Variable{
+			Name:        certificateVariable,
+			Description: "Certificate of ssl certificate " + r.InstanceState.ID,
+		})
+		g.Resources[i].Item["private_key"] = g.Resources[i].AddSensitiveVariable(privateKeyVariable, "Private key of ssl certificate "+r.InstanceState.ID)
+		g.Resources[i].Item["lifecycle"] = []interface{}{map[string]interface{}{
+			"ignore_changes": []interface{}{"certificate", "private_key"},
+		}}
+	}
+	return nil
+}
+
+func linkSelfLinks(value interface{}, selfLinks map[string]string) interface{} {
+	switch v := value.(type) {
+	case string:
+		if link, exist := selfLinks[v]; exist {
+			return link
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = linkSelfLinks(v[i], selfLinks)
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = linkSelfLinks(v[k], selfLinks)
+		}
+	}
+	return value
+}
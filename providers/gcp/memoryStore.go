@@ -40,7 +40,7 @@ func (g MemoryStoreGenerator) createResources(ctx context.Context, redisInstance
 		for _, obj := range page.Instances {
 			t := strings.Split(obj.Name, "/")
 			name := t[len(t)-1]
-			resources = append(resources, terraformutils.NewResource(
+			resource := terraformutils.NewResource(
 				obj.Name,
 				name,
 				"google_redis_instance",
@@ -52,7 +52,15 @@ func (g MemoryStoreGenerator) createResources(ctx context.Context, redisInstance
 				},
 				redisAllowEmptyValues,
 				redisAdditionalFields,
-			))
+			)
+			// connection details are computed by Memorystore, auth string is generated when auth is enabled
+			resource.IgnoreKeys = append(resource.IgnoreKeys,
+				"^host$",
+				"^port$",
+				"^current_location_id$",
+				"^persistence_iam_identity$",
+				"^auth_string$")
+			resources = append(resources, resource)
 		}
 		return nil
 	}); err != nil {
@@ -66,7 +74,7 @@ func (g MemoryStoreGenerator) createResources(ctx context.Context, redisInstance
 // Need Redis name as ID for terraform resource
 func (g *MemoryStoreGenerator) InitResources() error {
 	ctx := context.Background()
-	redisService, err := redis.NewService(ctx)
+	redisService, err := redis.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
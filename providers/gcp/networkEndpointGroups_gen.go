@@ -65,7 +65,7 @@ func (g NetworkEndpointGroupsGenerator) createResources(ctx context.Context, net
 // Need networkEndpointGroups name as ID for terraform resource
 func (g *NetworkEndpointGroupsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
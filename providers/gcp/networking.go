@@ -0,0 +1,226 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+
+	"google.golang.org/api/compute/v1"
+)
+
+var networkingAllowEmptyValues = []string{""}
+
+var networkingAdditionalFields = map[string]interface{}{}
+
+// NetworkingGenerator imports VPC networks together with their subnetworks, routes, firewalls,
+// peerings, routers and NATs, so they reference networks inside of one service.
+// Subnetworks of auto mode networks and default routes are created by GCP,
+// they are skipped unless --network-include-auto-created is set
+type NetworkingGenerator struct {
+	GCPService
+}
+
+func (g *NetworkingGenerator) networkingServices() []terraformutils.ServiceGenerator {
+	return []terraformutils.ServiceGenerator{
+		&FirewallGenerator{},
+		&RoutersGenerator{},
+	}
+}
+
+// Create google_compute_network and google_compute_network_peering for each peering of network,
+// returns self links of auto mode networks
+func (g *NetworkingGenerator) createNetworksResources(ctx context.Context, computeService *compute.Service) ([]terraformutils.Resource, map[string]bool) {
+	resources := []terraformutils.Resource{}
+	autoModeNetworks := map[string]bool{}
+	project := g.GetArgs()["project"].(string)
+	if err := computeService.Networks.List(project).Pages(ctx, func(page *compute.NetworkList) error {
+		for _, obj := range page.Items {
+			if obj.AutoCreateSubnetworks {
+				autoModeNetworks[obj.SelfLink] = true
+			}
+			resources = append(resources, terraformutils.NewResource(
+				obj.Name,
+				obj.Name,
+				"google_compute_network",
+				g.ProviderName,
+				map[string]string{
+					"name":    obj.Name,
+					"project": project,
+				},
+				networkingAllowEmptyValues,
+				networkingAdditionalFields,
+			))
+			for _, peering := range obj.Peerings {
+				resources = append(resources, terraformutils.NewResource(
+					obj.Name+"/"+peering.Name,
+					obj.Name+"_"+peering.Name,
+					"google_compute_network_peering",
+					g.ProviderName,
+					map[string]string{
+						"name":         peering.Name,
+						"network":      obj.SelfLink,
+						"peer_network": peering.Network,
+					},
+					networkingAllowEmptyValues,
+					networkingAdditionalFields,
+				))
+			}
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources, autoModeNetworks
+}
+
+func (g *NetworkingGenerator) createSubnetworksResources(ctx context.Context, computeService *compute.Service, autoModeNetworks map[string]bool, includeAutoCreated bool) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	region := g.GetArgs()["region"].(compute.Region).Name
+	if err := computeService.Subnetworks.List(project, region).Pages(ctx, func(page *compute.SubnetworkList) error {
+		for _, obj := range page.Items {
+			if autoModeNetworks[obj.Network] && !includeAutoCreated {
+				continue
+			}
+			resources = append(resources, terraformutils.NewResource(
+				obj.Name,
+				obj.Name,
+				"google_compute_subnetwork",
+				g.ProviderName,
+				map[string]string{
+					"name":    obj.Name,
+					"project": project,
+					"region":  region,
+				},
+				networkingAllowEmptyValues,
+				networkingAdditionalFields,
+			))
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+func (g *NetworkingGenerator) createRoutesResources(ctx context.Context, computeService *compute.Service, includeAutoCreated bool) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	if err := computeService.Routes.List(project).Pages(ctx, func(page *compute.RouteList) error {
+		for _, obj := range page.Items {
+			// default routes to internet and subnetworks are created with network
+			if strings.HasPrefix(obj.Name, "default-route-") && !includeAutoCreated {
+				continue
+			}
+			resources = append(resources, terraformutils.NewResource(
+				obj.Name,
+				obj.Name,
+				"google_compute_route",
+				g.ProviderName,
+				map[string]string{
+					"name":    obj.Name,
+					"project": project,
+				},
+				networkingAllowEmptyValues,
+				networkingAdditionalFields,
+			))
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+func (g *NetworkingGenerator) createRouterNatsResources(ctx context.Context, computeService *compute.Service) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	region := g.GetArgs()["region"].(compute.Region).Name
+	if err := computeService.Routers.List(project, region).Pages(ctx, func(page *compute.RouterList) error {
+		for _, router := range page.Items {
+			for _, nat := range router.Nats {
+				resources = append(resources, terraformutils.NewResource(
+					project+"/"+region+"/"+router.Name+"/"+nat.Name,
+					router.Name+"_"+nat.Name,
+					"google_compute_router_nat",
+					g.ProviderName,
+					map[string]string{
+						"name":    nat.Name,
+						"router":  router.Name,
+						"project": project,
+						"region":  region,
+					},
+					networkingAllowEmptyValues,
+					networkingAdditionalFields,
+				))
+			}
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+func (g *NetworkingGenerator) InitResources() error {
+	includeAutoCreated, _ := g.GetArgs()["network_include_auto_created"].(bool)
+	ctx := context.Background()
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
+	if err != nil {
+		return err
+	}
+	networks, autoModeNetworks := g.createNetworksResources(ctx, computeService)
+	g.Resources = append(g.Resources, networks...)
+	g.Resources = append(g.Resources, g.createSubnetworksResources(ctx, computeService, autoModeNetworks, includeAutoCreated)...)
+	g.Resources = append(g.Resources, g.createRoutesResources(ctx, computeService, includeAutoCreated)...)
+
+	for _, service := range g.networkingServices() {
+		service.SetArgs(g.GetArgs())
+		service.SetProviderName(g.ProviderName)
+		if err := service.InitResources(); err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, service.GetResources()...)
+	}
+	g.Resources = append(g.Resources, g.createRouterNatsResources(ctx, computeService)...)
+	return nil
+}
+
+// PostConvertHook links networks, subnetworks and routers by their self links, NATs by router name
+func (g *NetworkingGenerator) PostConvertHook() error {
+	selfLinks := map[string]string{}
+	routers := map[string]string{}
+	for _, r := range g.Resources {
+		if selfLink := r.InstanceState.Attributes["self_link"]; selfLink != "" {
+			selfLinks[selfLink] = "${" + r.InstanceInfo.Type + "." + r.ResourceName + ".self_link}"
+		}
+		if r.InstanceInfo.Type == "google_compute_router" {
+			routers[r.InstanceState.Attributes["name"]] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type == "google_compute_router_nat" {
+			if resourceName, exist := routers[r.InstanceState.Attributes["router"]]; exist {
+				g.Resources[i].Item["router"] = "${google_compute_router." + resourceName + ".name}"
+			}
+		}
+		linkSelfLinks(r.Item, selfLinks)
+	}
+	return nil
+}
@@ -63,7 +63,7 @@ func (g NetworksGenerator) createResources(ctx context.Context, networksList *co
 // Need networks name as ID for terraform resource
 func (g *NetworksGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func (g NodeGroupsGenerator) createResources(ctx context.Context, nodeGroupsList
 // Need nodeGroups name as ID for terraform resource
 func (g *NodeGroupsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
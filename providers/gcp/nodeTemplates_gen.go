@@ -63,7 +63,7 @@ func (g NodeTemplatesGenerator) createResources(ctx context.Context, nodeTemplat
 // Need nodeTemplates name as ID for terraform resource
 func (g *NodeTemplatesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
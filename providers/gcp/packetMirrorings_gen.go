@@ -63,7 +63,7 @@ func (g PacketMirroringsGenerator) createResources(ctx context.Context, packetMi
 // Need packetMirrorings name as ID for terraform resource
 func (g *PacketMirroringsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
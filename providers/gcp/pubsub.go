@@ -17,6 +17,8 @@ package gcp
 import (
 	"context"
 	"log"
+	"net/url"
+	"regexp"
 	"strings"
 
 	"google.golang.org/api/pubsub/v1"
@@ -28,6 +30,9 @@ var pubsubAllowEmptyValues = []string{""}
 
 var pubsubAdditionalFields = map[string]interface{}{}
 
+// pubsubSecretQueryRegexp matches query parameters of push endpoints which usually carry credentials
+var pubsubSecretQueryRegexp = regexp.MustCompile(`(?i)(token|key|secret|password|signature|sig|auth)`)
+
 type PubsubGenerator struct {
 	GCPService
 }
@@ -86,10 +91,40 @@ func (g PubsubGenerator) createTopicsListResources(ctx context.Context, topicsLi
 	return resources
 }
 
+// Create google_pubsub_topic_iam_binding for each role in topics IAM policies
+func (g PubsubGenerator) createTopicsIamBindingResources(pubsubService *pubsub.Service, topics []terraformutils.Resource) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	for _, topic := range topics {
+		topicID := "projects/" + project + "/topics/" + topic.InstanceState.Attributes["name"]
+		policy, err := pubsubService.Projects.Topics.GetIamPolicy(topicID).Do()
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		for _, binding := range policy.Bindings {
+			resources = append(resources, terraformutils.NewResource(
+				topicID+"/"+binding.Role,
+				topic.InstanceState.Attributes["name"]+"-"+binding.Role,
+				"google_pubsub_topic_iam_binding",
+				g.ProviderName,
+				map[string]string{
+					"topic":   topicID,
+					"role":    binding.Role,
+					"project": project,
+				},
+				pubsubAllowEmptyValues,
+				pubsubAdditionalFields,
+			))
+		}
+	}
+	return resources
+}
+
 // Generate TerraformResources from GCP API,
 func (g *PubsubGenerator) InitResources() error {
 	ctx := context.Background()
-	pubsubService, err := pubsub.NewService(ctx)
+	pubsubService, err := pubsub.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -103,16 +138,58 @@ func (g *PubsubGenerator) InitResources() error {
 	g.Resources = append(g.Resources, subscriptionsResources...)
 	g.Resources = append(g.Resources, topicsResources...)
 
+	if exportIam, _ := g.GetArgs()["export_iam"].(bool); exportIam {
+		g.Resources = append(g.Resources, g.createTopicsIamBindingResources(pubsubService, topicsResources)...)
+	}
+
 	return nil
 }
 
 func (g *PubsubGenerator) PostConvertHook() error {
+	project := g.GetArgs()["project"].(string)
+	topics := map[string]string{}
+	for _, topic := range g.Resources {
+		if topic.InstanceInfo.Type == "google_pubsub_topic" {
+			topics["projects/"+project+"/topics/"+topic.InstanceState.Attributes["name"]] = topic.ResourceName
+		}
+	}
 	for i, r := range g.Resources {
-		for _, topic := range g.Resources {
-			if r.InstanceState.Attributes["topic"] == "projects/"+g.GetArgs()["project"].(string)+"/topics/"+topic.InstanceState.Attributes["name"] {
-				g.Resources[i].Item["topic"] = "${google_pubsub_topic." + topic.ResourceName + ".name}"
+		switch r.InstanceInfo.Type {
+		case "google_pubsub_subscription":
+			if resourceName, exist := topics[r.InstanceState.Attributes["topic"]]; exist {
+				g.Resources[i].Item["topic"] = "${google_pubsub_topic." + resourceName + ".name}"
+			}
+			if resourceName, exist := topics[r.InstanceState.Attributes["dead_letter_policy.0.dead_letter_topic"]]; exist {
+				g.Resources[i].Item["dead_letter_policy"].([]interface{})[0].(map[string]interface{})["dead_letter_topic"] = "${google_pubsub_topic." + resourceName + ".id}"
+			}
+			g.redactPushEndpoint(i)
+		case "google_pubsub_topic_iam_binding":
+			if resourceName, exist := topics[r.InstanceState.Attributes["topic"]]; exist {
+				g.Resources[i].Item["topic"] = "${google_pubsub_topic." + resourceName + ".id}"
 			}
 		}
 	}
 	return nil
 }
+
+// redactPushEndpoint replaces push endpoint with credentials in query string with sensitive variable
+func (g *PubsubGenerator) redactPushEndpoint(i int) {
+	r := g.Resources[i]
+	endpoint := r.InstanceState.Attributes["push_config.0.push_endpoint"]
+	endpointURL, err := url.Parse(endpoint)
+	if endpoint == "" || err != nil {
+		return
+	}
+	hasSecret := false
+	for key := range endpointURL.Query() {
+		if pubsubSecretQueryRegexp.MatchString(key) {
+			hasSecret = true
+		}
+	}
+	if !hasSecret {
+		return
+	}
+	variable := terraformutils.VariableName(r.ResourceName + "_push_endpoint")
+	log.Printf("google: push endpoint of subscription %s contains credentials, set variable %s", r.InstanceState.ID, variable)
+	r.Item["push_config"].([]interface{})[0].(map[string]interface{})["push_endpoint"] = g.Resources[i].AddSensitiveVariable(variable, "Push endpoint of subscription "+r.InstanceState.ID)
+}
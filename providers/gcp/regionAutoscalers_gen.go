@@ -63,7 +63,7 @@ func (g RegionAutoscalersGenerator) createResources(ctx context.Context, regionA
 // Need regionAutoscalers name as ID for terraform resource
 func (g *RegionAutoscalersGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
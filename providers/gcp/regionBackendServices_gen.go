@@ -63,7 +63,7 @@ func (g RegionBackendServicesGenerator) createResources(ctx context.Context, reg
 // Need regionBackendServices name as ID for terraform resource
 func (g *RegionBackendServicesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g RegionDisksGenerator) createResources(ctx context.Context, regionDisksLi
 // Need regionDisks name as ID for terraform resource
 func (g *RegionDisksGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
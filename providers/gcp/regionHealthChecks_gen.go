@@ -63,7 +63,7 @@ func (g RegionHealthChecksGenerator) createResources(ctx context.Context, region
 // Need regionHealthChecks name as ID for terraform resource
 func (g *RegionHealthChecksGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g RegionInstanceGroupManagersGenerator) createResources(ctx context.Contex
 // Need regionInstanceGroupManagers name as ID for terraform resource
 func (g *RegionInstanceGroupManagersGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g RegionInstanceGroupsGenerator) createResources(ctx context.Context, regi
 // Need regionInstanceGroups name as ID for terraform resource
 func (g *RegionInstanceGroupsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
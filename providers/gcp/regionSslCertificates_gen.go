@@ -63,7 +63,7 @@ func (g RegionSslCertificatesGenerator) createResources(ctx context.Context, reg
 // Need regionSslCertificates name as ID for terraform resource
 func (g *RegionSslCertificatesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g RegionTargetHttpProxiesGenerator) createResources(ctx context.Context, r
 // Need regionTargetHttpProxies name as ID for terraform resource
 func (g *RegionTargetHttpProxiesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g RegionTargetHttpsProxiesGenerator) createResources(ctx context.Context,
 // Need regionTargetHttpsProxies name as ID for terraform resource
 func (g *RegionTargetHttpsProxiesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
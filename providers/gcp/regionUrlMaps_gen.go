@@ -63,7 +63,7 @@ func (g RegionUrlMapsGenerator) createResources(ctx context.Context, regionUrlMa
 // Need regionUrlMaps name as ID for terraform resource
 func (g *RegionUrlMapsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
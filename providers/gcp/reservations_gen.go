@@ -65,7 +65,7 @@ func (g ReservationsGenerator) createResources(ctx context.Context, reservations
 // Need reservations name as ID for terraform resource
 func (g *ReservationsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
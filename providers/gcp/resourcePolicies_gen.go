@@ -63,7 +63,7 @@ func (g ResourcePoliciesGenerator) createResources(ctx context.Context, resource
 // Need resourcePolicies name as ID for terraform resource
 func (g *ResourcePoliciesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g RoutersGenerator) createResources(ctx context.Context, routersList *comp
 // Need routers name as ID for terraform resource
 func (g *RoutersGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g RoutesGenerator) createResources(ctx context.Context, routesList *comput
 // Need routes name as ID for terraform resource
 func (g *RoutesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
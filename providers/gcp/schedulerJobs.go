@@ -64,7 +64,7 @@ func (g SchedulerJobsGenerator) createResources(ctx context.Context, jobsList *c
 // Generate TerraformResources from GCP API,
 func (g *SchedulerJobsGenerator) InitResources() error {
 	ctx := context.Background()
-	cloudSchedulerService, err := cloudscheduler.NewService(ctx)
+	cloudSchedulerService, err := cloudscheduler.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g SecurityPoliciesGenerator) createResources(ctx context.Context, security
 // Need securityPolicies name as ID for terraform resource
 func (g *SecurityPoliciesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
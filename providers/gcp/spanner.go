@@ -0,0 +1,137 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/api/spanner/v1"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+var spannerAllowEmptyValues = []string{""}
+
+type SpannerGenerator struct {
+	GCPService
+}
+
+// Run on instancesList and create for each instance and its databases TerraformResource
+func (g SpannerGenerator) createInstancesResources(ctx context.Context, spannerService *spanner.Service) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	instancesList := spannerService.Projects.Instances.List("projects/" + project)
+	if err := instancesList.Pages(ctx, func(page *spanner.ListInstancesResponse) error {
+		for _, instance := range page.Instances {
+			t := strings.Split(instance.Name, "/")
+			name := t[len(t)-1]
+			resources = append(resources, terraformutils.NewResource(
+				project+"/"+name,
+				name,
+				"google_spanner_instance",
+				g.ProviderName,
+				map[string]string{
+					"name":    name,
+					"project": project,
+				},
+				spannerAllowEmptyValues,
+				map[string]interface{}{},
+			))
+			resources = append(resources, g.createDatabasesResources(ctx, spannerService, instance.Name, name)...)
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+// Run on databasesList of instance and create for each TerraformResource,
+// provider doesn't read DDL back so statements are added from GetDatabaseDdl in same order
+func (g SpannerGenerator) createDatabasesResources(ctx context.Context, spannerService *spanner.Service, instanceID, instanceName string) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	project := g.GetArgs()["project"].(string)
+	databasesList := spannerService.Projects.Instances.Databases.List(instanceID)
+	if err := databasesList.Pages(ctx, func(page *spanner.ListDatabasesResponse) error {
+		for _, database := range page.Databases {
+			t := strings.Split(database.Name, "/")
+			name := t[len(t)-1]
+			additionalFields := map[string]interface{}{}
+			ddl, err := spannerService.Projects.Instances.Databases.GetDdl(database.Name).Do()
+			if err != nil {
+				log.Println(err)
+			} else if len(ddl.Statements) > 0 {
+				statements := []interface{}{}
+				for _, statement := range ddl.Statements {
+					statements = append(statements, fmt.Sprintf(`<<DDL
+%s
+DDL`, statement))
+				}
+				additionalFields["ddl"] = statements
+			}
+			resources = append(resources, terraformutils.NewResource(
+				project+"/"+instanceName+"/"+name,
+				instanceName+"_"+name,
+				"google_spanner_database",
+				g.ProviderName,
+				map[string]string{
+					"name":     name,
+					"instance": instanceName,
+					"project":  project,
+				},
+				spannerAllowEmptyValues,
+				additionalFields,
+			))
+		}
+		return nil
+	}); err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+// Generate TerraformResources from GCP API,
+// create terraform resource for each instance + each database
+func (g *SpannerGenerator) InitResources() error {
+	ctx := context.Background()
+	spannerService, err := spanner.NewService(ctx, clientOptions(ctx)...)
+	if err != nil {
+		return err
+	}
+
+	g.Resources = g.createInstancesResources(ctx, spannerService)
+	return nil
+}
+
+func (g *SpannerGenerator) PostConvertHook() error {
+	instances := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "google_spanner_instance" {
+			instances[r.InstanceState.Attributes["name"]] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type != "google_spanner_database" {
+			continue
+		}
+		if resourceName, exist := instances[r.InstanceState.Attributes["instance"]]; exist {
+			g.Resources[i].Item["instance"] = "${google_spanner_instance." + resourceName + ".name}"
+		}
+	}
+	return nil
+}
@@ -63,7 +63,7 @@ func (g SslCertificatesGenerator) createResources(ctx context.Context, sslCertif
 // Need sslCertificates name as ID for terraform resource
 func (g *SslCertificatesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g SslPoliciesGenerator) createResources(ctx context.Context, sslPoliciesLi
 // Need sslPolicies name as ID for terraform resource
 func (g *SslPoliciesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
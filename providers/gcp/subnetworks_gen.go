@@ -63,7 +63,7 @@ func (g SubnetworksGenerator) createResources(ctx context.Context, subnetworksLi
 // Need subnetworks name as ID for terraform resource
 func (g *SubnetworksGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g TargetHttpProxiesGenerator) createResources(ctx context.Context, targetH
 // Need targetHttpProxies name as ID for terraform resource
 func (g *TargetHttpProxiesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g TargetHttpsProxiesGenerator) createResources(ctx context.Context, target
 // Need targetHttpsProxies name as ID for terraform resource
 func (g *TargetHttpsProxiesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func (g TargetInstancesGenerator) createResources(ctx context.Context, targetIns
 // Need targetInstances name as ID for terraform resource
 func (g *TargetInstancesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
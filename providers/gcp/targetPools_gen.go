@@ -63,7 +63,7 @@ func (g TargetPoolsGenerator) createResources(ctx context.Context, targetPoolsLi
 // Need targetPools name as ID for terraform resource
 func (g *TargetPoolsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g TargetSslProxiesGenerator) createResources(ctx context.Context, targetSs
 // Need targetSslProxies name as ID for terraform resource
 func (g *TargetSslProxiesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func (g TargetTcpProxiesGenerator) createResources(ctx context.Context, targetTc
 // Need targetTcpProxies name as ID for terraform resource
 func (g *TargetTcpProxiesGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
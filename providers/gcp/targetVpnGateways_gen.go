@@ -63,7 +63,7 @@ func (g TargetVpnGatewaysGenerator) createResources(ctx context.Context, targetV
 // Need targetVpnGateways name as ID for terraform resource
 func (g *TargetVpnGatewaysGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
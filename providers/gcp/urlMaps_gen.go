@@ -63,7 +63,7 @@ func (g UrlMapsGenerator) createResources(ctx context.Context, urlMapsList *comp
 // Need urlMaps name as ID for terraform resource
 func (g *UrlMapsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
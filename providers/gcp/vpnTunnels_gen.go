@@ -63,7 +63,7 @@ func (g VpnTunnelsGenerator) createResources(ctx context.Context, vpnTunnelsList
 // Need vpnTunnels name as ID for terraform resource
 func (g *VpnTunnelsGenerator) InitResources() error {
 	ctx := context.Background()
-	computeService, err := compute.NewService(ctx)
+	computeService, err := compute.NewService(ctx, clientOptions(ctx)...)
 	if err != nil {
 		return err
 	}
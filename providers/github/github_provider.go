@@ -22,10 +22,20 @@ import (
 	"github.com/zclconf/go-cty/cty"
 )
 
+// ownerTypeUser selects repositories of user instead of organization
+const ownerTypeUser = "user"
+
+// userServices are services available for user owner type, others are organization only
+var userServices = map[string]bool{
+	"repositories":  true,
+	"user_ssh_keys": true,
+}
+
 type GithubProvider struct { //nolint
 	terraformutils.Provider
 	organization string
 	token        string
+	ownerType    string
 }
 
 func (p GithubProvider) GetResourceConnections() map[string]map[string][]string {
@@ -33,6 +43,14 @@ func (p GithubProvider) GetResourceConnections() map[string]map[string][]string
 }
 
 func (p GithubProvider) GetProviderData(arg ...string) map[string]interface{} {
+	if p.ownerType == ownerTypeUser {
+		// provider acts as authenticated user without organization
+		return map[string]interface{}{
+			"provider": map[string]interface{}{
+				"github": map[string]interface{}{},
+			},
+		}
+	}
 	return map[string]interface{}{
 		"provider": map[string]interface{}{
 			"github": map[string]interface{}{
@@ -43,16 +61,24 @@ func (p GithubProvider) GetProviderData(arg ...string) map[string]interface{} {
 }
 
 func (p *GithubProvider) GetConfig() cty.Value {
+	organization := p.organization
+	if p.ownerType == ownerTypeUser {
+		// without organization provider manages resources of authenticated user
+		organization = ""
+	}
 	return cty.ObjectVal(map[string]cty.Value{
-		"organization": cty.StringVal(p.organization),
+		"organization": cty.StringVal(organization),
 		"token":        cty.StringVal(p.token),
 	})
 }
 
-// Init GithubProvider with organization
+// Init GithubProvider with organization, or user with "user" owner type as third argument
 func (p *GithubProvider) Init(args []string) error {
 	p.organization = args[0]
-	if len(args) < 2 {
+	if len(args) > 2 {
+		p.ownerType = args[2]
+	}
+	if len(args) < 2 || args[1] == "" {
 		if os.Getenv("GITHUB_TOKEN") == "" {
 			return errors.New("token requirement")
 		}
@@ -79,13 +105,14 @@ func (p *GithubProvider) InitService(serviceName string, verbose bool) error {
 	p.Service.SetArgs(map[string]interface{}{
 		"organization": p.organization,
 		"token":        p.token,
+		"owner_type":   p.ownerType,
 	})
 	return nil
 }
 
-// GetSupportedService return map of support service for Github
+// GetSupportedService return map of support service for Github, only userServices for user owner type
 func (p *GithubProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
-	return map[string]terraformutils.ServiceGenerator{
+	services := map[string]terraformutils.ServiceGenerator{
 		"members":               &MembersGenerator{},
 		"organization_blocks":   &OrganizationBlockGenerator{},
 		"organization_projects": &OrganizationProjectGenerator{},
@@ -94,4 +121,12 @@ func (p *GithubProvider) GetSupportedService() map[string]terraformutils.Service
 		"teams":                 &TeamsGenerator{},
 		"user_ssh_keys":         &UserSSHKeyGenerator{},
 	}
+	if p.ownerType == ownerTypeUser {
+		for name := range services {
+			if !userServices[name] {
+				delete(services, name)
+			}
+		}
+	}
+	return services
 }
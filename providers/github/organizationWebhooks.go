@@ -17,7 +17,9 @@ package github
 import (
 	"context"
 	"log"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 
@@ -25,6 +27,12 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// GitHub API returns webhook secrets masked, so they aren't written to HCL
+const webhookSecretIgnoreKey = "^configuration.[0-9]+.secret$"
+
+// webhookURLSecretRegexp matches webhook URLs with credentials in user info or query parameters
+var webhookURLSecretRegexp = regexp.MustCompile(`(?i)://[^/\s@]+@|[?&][^=&]*(token|secret|key|password|sig|auth)[^=&]*=`)
+
 type OrganizationWebhooksGenerator struct {
 	GithubService
 }
@@ -58,6 +66,7 @@ func (g *OrganizationWebhooksGenerator) InitResources() error {
 				[]string{},
 			)
 			resource.SlowQueryRequired = true
+			resource.IgnoreKeys = append(resource.IgnoreKeys, webhookSecretIgnoreKey)
 			g.Resources = append(g.Resources, resource)
 		}
 
@@ -68,3 +77,35 @@ func (g *OrganizationWebhooksGenerator) InitResources() error {
 	}
 	return nil
 }
+
+func (g *OrganizationWebhooksGenerator) PostConvertHook() error {
+	for i := range g.Resources {
+		redactWebhook(&g.Resources[i])
+	}
+	return nil
+}
+
+// redactWebhook replaces webhook secret and URL with credentials by sensitive variables
+func redactWebhook(r *terraformutils.Resource) {
+	configurations, ok := r.Item["configuration"].([]interface{})
+	if !ok || len(configurations) == 0 {
+		return
+	}
+	configuration, ok := configurations[0].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if r.InstanceState.Attributes["configuration.0.secret"] != "" {
+		configuration["secret"] = webhookVariable(r, "secret")
+	}
+	if url, ok := configuration["url"].(string); ok && webhookURLSecretRegexp.MatchString(url) {
+		configuration["url"] = webhookVariable(r, "url")
+	}
+}
+
+// webhookVariable adds sensitive variable for webhook configuration key to resource data files and returns reference to it
+func webhookVariable(r *terraformutils.Resource, key string) string {
+	variable := strings.TrimPrefix(r.ResourceName, "tfer--") + "_" + key
+	log.Printf("github: %s of webhook %s is a secret, set variable %s", key, r.InstanceState.ID, variable)
+	return r.AddSensitiveVariable(variable, "Configuration "+key+" of webhook "+r.InstanceState.ID)
+}
@@ -17,9 +17,11 @@ package github
 import (
 	"context"
 	"log"
+	"sort"
 	"strconv"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/terraformerstring"
 	githubAPI "github.com/google/go-github/v25/github"
 	"golang.org/x/oauth2"
 )
@@ -38,17 +40,18 @@ func (g *RepositoriesGenerator) InitResources() error {
 
 	client := githubAPI.NewClient(tc)
 
-	opt := &githubAPI.RepositoryListByOrgOptions{
-		ListOptions: githubAPI.ListOptions{PerPage: 100},
-	}
-	// list all repositories for the authenticated user
-	for {
-		repos, resp, err := client.Repositories.ListByOrg(ctx, g.GetArgs()["organization"].(string), opt)
+	// list all repositories of organization or user, page by page
+	page := 1
+	for page != 0 {
+		repos, resp, err := g.listRepositories(ctx, client, page)
 		if err != nil {
 			log.Println(err)
 			return nil
 		}
 		for _, repo := range repos {
+			if g.isRepositoryFiltered(repo) {
+				continue
+			}
 			resource := terraformutils.NewSimpleResource(
 				repo.GetName(),
 				repo.GetName(),
@@ -63,100 +66,177 @@ func (g *RepositoriesGenerator) InitResources() error {
 			g.Resources = append(g.Resources, g.createRepositoryCollaboratorResources(ctx, client, repo)...)
 			g.Resources = append(g.Resources, g.createRepositoryDeployKeyResources(ctx, client, repo)...)
 		}
-
-		if resp.NextPage == 0 {
-			break
-		}
-		opt.Page = resp.NextPage
+		page = resp.NextPage
 	}
 
 	return nil
 }
 
-func (g *RepositoriesGenerator) createRepositoryWebhookResources(ctx context.Context, client *githubAPI.Client, repo *githubAPI.Repository) []terraformutils.Resource {
-	resources := []terraformutils.Resource{}
-	hooks, _, err := client.Repositories.ListHooks(ctx, g.GetArgs()["organization"].(string), repo.GetName(), nil)
+// listRepositories returns page of repositories of organization, with user owner type repositories of user,
+// private repositories are listed for authenticated user only
+func (g *RepositoriesGenerator) listRepositories(ctx context.Context, client *githubAPI.Client, page int) ([]*githubAPI.Repository, *githubAPI.Response, error) {
+	owner := g.GetArgs()["organization"].(string)
+	listOptions := githubAPI.ListOptions{PerPage: 100, Page: page}
+	if ownerType, _ := g.GetArgs()["owner_type"].(string); ownerType != ownerTypeUser {
+		return client.Repositories.ListByOrg(ctx, owner, &githubAPI.RepositoryListByOrgOptions{ListOptions: listOptions})
+	}
+	user, _, err := client.Users.Get(ctx, "")
 	if err != nil {
-		log.Println(err)
+		return nil, nil, err
 	}
-	for _, hook := range hooks {
-		resources = append(resources, terraformutils.NewResource(
-			strconv.FormatInt(hook.GetID(), 10),
-			repo.GetName()+"_"+strconv.FormatInt(hook.GetID(), 10),
-			"github_repository_webhook",
-			"github",
-			map[string]string{
-				"repository": repo.GetName(),
-			},
-			[]string{},
-			map[string]interface{}{},
-		))
+	if user.GetLogin() == owner {
+		return client.Repositories.List(ctx, "", &githubAPI.RepositoryListOptions{Affiliation: "owner", ListOptions: listOptions})
 	}
-	return resources
+	return client.Repositories.List(ctx, owner, &githubAPI.RepositoryListOptions{Type: "owner", ListOptions: listOptions})
 }
 
-func (g *RepositoriesGenerator) createRepositoryBranchProtectionResources(ctx context.Context, client *githubAPI.Client, repo *githubAPI.Repository) []terraformutils.Resource {
-	resources := []terraformutils.Resource{}
-	branches, _, err := client.Repositories.ListBranches(ctx, g.GetArgs()["organization"].(string), repo.GetName(), nil)
-	if err != nil {
-		log.Println(err)
+// isRepositoryFiltered applies archived filter (e.g. Type=repository;Name=archived;Value=false) before listing,
+// so resources of skipped repositories aren't listed either
+func (g *RepositoriesGenerator) isRepositoryFiltered(repo *githubAPI.Repository) bool {
+	for _, filter := range g.Filter {
+		if filter.FieldPath != "archived" || !filter.IsApplicable("repository") {
+			continue
+		}
+		if !terraformerstring.ContainsString(filter.AcceptableValues, strconv.FormatBool(repo.GetArchived())) {
+			return true
+		}
 	}
-	for _, branch := range branches {
-		if branch.GetProtected() {
-			resources = append(resources, terraformutils.NewSimpleResource(
-				repo.GetName()+":"+branch.GetName(),
-				repo.GetName()+"_"+branch.GetName(),
-				"github_branch_protection",
+	return false
+}
+
+func (g *RepositoriesGenerator) createRepositoryWebhookResources(ctx context.Context, client *githubAPI.Client, repo *githubAPI.Repository) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	opt := &githubAPI.ListOptions{PerPage: 100}
+	for {
+		hooks, resp, err := client.Repositories.ListHooks(ctx, g.GetArgs()["organization"].(string), repo.GetName(), opt)
+		if err != nil {
+			log.Println(err)
+			return resources
+		}
+		for _, hook := range hooks {
+			resource := terraformutils.NewResource(
+				strconv.FormatInt(hook.GetID(), 10),
+				repo.GetName()+"_"+strconv.FormatInt(hook.GetID(), 10),
+				"github_repository_webhook",
 				"github",
+				map[string]string{
+					"repository": repo.GetName(),
+				},
 				[]string{},
-			))
+				map[string]interface{}{},
+			)
+			resource.IgnoreKeys = append(resource.IgnoreKeys, webhookSecretIgnoreKey)
+			resources = append(resources, resource)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+	return resources
+}
+
+func (g *RepositoriesGenerator) createRepositoryBranchProtectionResources(ctx context.Context, client *githubAPI.Client, repo *githubAPI.Repository) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	opt := &githubAPI.ListOptions{PerPage: 100}
+	for {
+		branches, resp, err := client.Repositories.ListBranches(ctx, g.GetArgs()["organization"].(string), repo.GetName(), opt)
+		if err != nil {
+			log.Println(err)
+			return resources
 		}
+		for _, branch := range branches {
+			if branch.GetProtected() {
+				resources = append(resources, terraformutils.NewSimpleResource(
+					repo.GetName()+":"+branch.GetName(),
+					repo.GetName()+"_"+branch.GetName(),
+					"github_branch_protection",
+					"github",
+					[]string{},
+				))
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
 	}
 	return resources
 }
 
 func (g *RepositoriesGenerator) createRepositoryCollaboratorResources(ctx context.Context, client *githubAPI.Client, repo *githubAPI.Repository) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
-	collaborators, _, err := client.Repositories.ListCollaborators(ctx, g.GetArgs()["organization"].(string), repo.GetName(), nil)
-	if err != nil {
-		log.Println(err)
+	// only direct collaborators, access inherited from organization and teams is managed elsewhere
+	opt := &githubAPI.ListCollaboratorsOptions{
+		Affiliation: "direct",
+		ListOptions: githubAPI.ListOptions{PerPage: 100},
 	}
-	for _, collaborator := range collaborators {
-		resources = append(resources, terraformutils.NewSimpleResource(
-			repo.GetName()+":"+collaborator.GetName(),
-			repo.GetName()+":"+collaborator.GetName(),
-			"github_repository_collaborator",
-			"github",
-			[]string{},
-		))
+	for {
+		collaborators, resp, err := client.Repositories.ListCollaborators(ctx, g.GetArgs()["organization"].(string), repo.GetName(), opt)
+		if err != nil {
+			log.Println(err)
+			return resources
+		}
+		for _, collaborator := range collaborators {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				repo.GetName()+":"+collaborator.GetLogin(),
+				repo.GetName()+":"+collaborator.GetLogin(),
+				"github_repository_collaborator",
+				"github",
+				[]string{},
+			))
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
 	}
 	return resources
 }
 
 func (g *RepositoriesGenerator) createRepositoryDeployKeyResources(ctx context.Context, client *githubAPI.Client, repo *githubAPI.Repository) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
-	deployKeys, _, err := client.Repositories.ListKeys(ctx, g.GetArgs()["organization"].(string), repo.GetName(), nil)
-	if err != nil {
-		log.Println(err)
-	}
-	for _, key := range deployKeys {
-		resources = append(resources, terraformutils.NewSimpleResource(
-			repo.GetName()+":"+strconv.FormatInt(key.GetID(), 10),
-			repo.GetName()+":"+key.GetTitle(),
-			"github_repository_deploy_key",
-			"github",
-			[]string{},
-		))
+	opt := &githubAPI.ListOptions{PerPage: 100}
+	for {
+		deployKeys, resp, err := client.Repositories.ListKeys(ctx, g.GetArgs()["organization"].(string), repo.GetName(), opt)
+		if err != nil {
+			log.Println(err)
+			return resources
+		}
+		for _, key := range deployKeys {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				repo.GetName()+":"+strconv.FormatInt(key.GetID(), 10),
+				repo.GetName()+":"+key.GetTitle(),
+				"github_repository_deploy_key",
+				"github",
+				[]string{},
+			))
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
 	}
 	return resources
 }
 
 // PostGenerateHook for connect between resources
 func (g *RepositoriesGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type == "github_repository_webhook" {
+			redactWebhook(&g.Resources[i])
+		}
+	}
 	for _, repo := range g.Resources {
 		if repo.InstanceInfo.Type != "github_repository" {
 			continue
 		}
+		// topics are a set, sort them to keep output stable
+		if topics, ok := repo.Item["topics"].([]interface{}); ok {
+			sort.Slice(topics, func(i, j int) bool {
+				return topics[i].(string) < topics[j].(string)
+			})
+		}
 		for i, member := range g.Resources {
 			if member.InstanceInfo.Type != "github_repository_webhook" {
 				continue
@@ -49,36 +49,52 @@ func (g *TeamsGenerator) createTeamsResources(ctx context.Context, teams []*gith
 
 func (g *TeamsGenerator) createTeamMembersResources(ctx context.Context, team *githubAPI.Team, client *githubAPI.Client) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
-	members, _, err := client.Teams.ListTeamMembers(ctx, team.GetID(), nil)
-	if err != nil {
-		log.Println(err)
-	}
-	for _, member := range members {
-		resources = append(resources, terraformutils.NewSimpleResource(
-			strconv.FormatInt(team.GetID(), 10)+":"+member.GetLogin(),
-			team.GetName()+"_"+member.GetLogin(),
-			"github_team_membership",
-			"github",
-			[]string{},
-		))
+	opt := &githubAPI.TeamListTeamMembersOptions{ListOptions: githubAPI.ListOptions{PerPage: 100}}
+	for {
+		members, resp, err := client.Teams.ListTeamMembers(ctx, team.GetID(), opt)
+		if err != nil {
+			log.Println(err)
+			return resources
+		}
+		for _, member := range members {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				strconv.FormatInt(team.GetID(), 10)+":"+member.GetLogin(),
+				team.GetName()+"_"+member.GetLogin(),
+				"github_team_membership",
+				"github",
+				[]string{},
+			))
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
 	}
 	return resources
 }
 
 func (g *TeamsGenerator) createTeamRepositoriesResources(ctx context.Context, team *githubAPI.Team, client *githubAPI.Client) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
-	repos, _, err := client.Teams.ListTeamRepos(ctx, team.GetID(), nil)
-	if err != nil {
-		log.Println(err)
-	}
-	for _, repo := range repos {
-		resources = append(resources, terraformutils.NewSimpleResource(
-			strconv.FormatInt(team.GetID(), 10)+":"+repo.GetName(),
-			team.GetName()+"_"+repo.GetName(),
-			"github_team_repository",
-			"github",
-			[]string{},
-		))
+	opt := &githubAPI.ListOptions{PerPage: 100}
+	for {
+		repos, resp, err := client.Teams.ListTeamRepos(ctx, team.GetID(), opt)
+		if err != nil {
+			log.Println(err)
+			return resources
+		}
+		for _, repo := range repos {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				strconv.FormatInt(team.GetID(), 10)+":"+repo.GetName(),
+				team.GetName()+"_"+repo.GetName(),
+				"github_team_repository",
+				"github",
+				[]string{},
+			))
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
 	}
 	return resources
 }
@@ -93,7 +109,7 @@ func (g *TeamsGenerator) InitResources() error {
 
 	client := githubAPI.NewClient(tc)
 
-	opt := &githubAPI.ListOptions{PerPage: 1}
+	opt := &githubAPI.ListOptions{PerPage: 100}
 
 	for {
 		teams, resp, err := client.Teams.ListTeams(ctx, g.Args["organization"].(string), opt)
@@ -0,0 +1,110 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gitlab
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const defaultGitLabBaseURL = "https://gitlab.com/api/v4/"
+
+type GitLabProvider struct { //nolint
+	terraformutils.Provider
+	group   string
+	token   string
+	baseURL string
+}
+
+// Init GitLabProvider with group path or id, token and base URL of API of self-hosted instance
+func (p *GitLabProvider) Init(args []string) error {
+	p.group = args[0]
+	if len(args) > 1 && args[1] != "" {
+		p.token = args[1]
+	} else if token := os.Getenv("GITLAB_TOKEN"); token != "" {
+		p.token = token
+	} else {
+		return errors.New("token requirement")
+	}
+	p.baseURL = os.Getenv("GITLAB_BASE_URL")
+	if len(args) > 2 && args[2] != "" {
+		p.baseURL = args[2]
+	}
+	if p.baseURL == "" {
+		p.baseURL = defaultGitLabBaseURL
+	}
+	if !strings.HasSuffix(p.baseURL, "/") {
+		p.baseURL += "/"
+	}
+	return nil
+}
+
+func (p *GitLabProvider) GetName() string {
+	return "gitlab"
+}
+
+func (p *GitLabProvider) GetProviderData(arg ...string) map[string]interface{} {
+	gitlabConfig := map[string]interface{}{}
+	if p.baseURL != defaultGitLabBaseURL {
+		gitlabConfig["base_url"] = p.baseURL
+	}
+	return map[string]interface{}{
+		"provider": map[string]interface{}{
+			"gitlab": gitlabConfig,
+		},
+	}
+}
+
+func (p *GitLabProvider) GetConfig() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"token":    cty.StringVal(p.token),
+		"base_url": cty.StringVal(p.baseURL),
+	})
+}
+
+func (p *GitLabProvider) InitService(serviceName string, verbose bool) error {
+	var isSupported bool
+	if _, isSupported = p.GetSupportedService()[serviceName]; !isSupported {
+		return errors.New(p.GetName() + ": " + serviceName + " not supported service")
+	}
+	p.Service = p.GetSupportedService()[serviceName]
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"group":    p.group,
+		"token":    p.token,
+		"base_url": p.baseURL,
+	})
+	return nil
+}
+
+func (p *GitLabProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	return map[string]terraformutils.ServiceGenerator{
+		"groups":   &GroupsGenerator{},
+		"projects": &ProjectsGenerator{},
+	}
+}
+
+func (GitLabProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{
+		"projects": {
+			"groups": []string{"namespace_id", "id"},
+		},
+	}
+}
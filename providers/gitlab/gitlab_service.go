@@ -0,0 +1,117 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+const gitLabPageSize = 100
+
+var nextLinkRegexp = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+type GitLabService struct { //nolint
+	terraformutils.Service
+}
+
+// generateRequest calls API path or absolute URL of next page, 404 is returned as nil body
+func (s *GitLabService) generateRequest(uri string) ([]byte, http.Header, error) {
+	requestURL := uri
+	if !strings.HasPrefix(uri, "http://") && !strings.HasPrefix(uri, "https://") {
+		requestURL = s.Args["base_url"].(string) + uri
+	}
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("PRIVATE-TOKEN", s.Args["token"].(string))
+	client := &http.Client{Transport: terraformutils.NewRetryTransport(http.DefaultTransport)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, resp.Header, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("gitlab: %s returned %s: %s", uri, resp.Status, body)
+	}
+	return body, resp.Header, nil
+}
+
+// listAll follows Link headers, they work for both offset and keyset pagination
+// of large groups, and returns raw items of all pages
+func (s *GitLabService) listAll(uri string) ([]json.RawMessage, error) {
+	separator := "?"
+	if strings.Contains(uri, "?") {
+		separator = "&"
+	}
+	next := fmt.Sprintf("%s%sper_page=%d", uri, separator, gitLabPageSize)
+	var items []json.RawMessage
+	for next != "" {
+		body, header, err := s.generateRequest(next)
+		if err != nil {
+			return nil, err
+		}
+		if body == nil {
+			break
+		}
+		var pageItems []json.RawMessage
+		if err := json.Unmarshal(body, &pageItems); err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+		next = ""
+		if match := nextLinkRegexp.FindStringSubmatch(header.Get("Link")); match != nil {
+			next = match[1]
+		}
+	}
+	return items, nil
+}
+
+// listInto lists all items of uri and decodes them into items, pointer to slice
+func (s *GitLabService) listInto(uri string, items interface{}) error {
+	rawItems, err := s.listAll(uri)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(rawItems)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, items)
+}
+
+// pathID escapes group or project path for use as id in API paths
+func pathID(id string) string {
+	return url.PathEscape(id)
+}
+
+// sensitiveVariable declares sensitive variable for attribute key of resource and returns reference to it
+func sensitiveVariable(r *terraformutils.Resource, key, description string) string {
+	return r.AddSensitiveVariable(strings.TrimPrefix(r.ResourceName, "tfer--")+"_"+key, description)
+}
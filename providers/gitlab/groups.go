@@ -0,0 +1,171 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gitlab
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type GroupsGenerator struct {
+	GitLabService
+}
+
+type gitLabGroup struct {
+	ID       int    `json:"id"`
+	FullPath string `json:"full_path"`
+	ParentID *int   `json:"parent_id"`
+}
+
+type gitLabMember struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+type gitLabVariable struct {
+	Key              string `json:"key"`
+	EnvironmentScope string `json:"environment_scope"`
+}
+
+// listGroups returns group passed with --groups and all its subgroups
+func (s *GitLabService) listGroups() ([]gitLabGroup, error) {
+	body, _, err := s.generateRequest("groups/" + pathID(s.Args["group"].(string)) + "?with_projects=false")
+	if err != nil {
+		return nil, err
+	}
+	var group gitLabGroup
+	if err := json.Unmarshal(body, &group); err != nil {
+		return nil, err
+	}
+	var descendants []gitLabGroup
+	if err := s.listInto("groups/"+strconv.Itoa(group.ID)+"/descendant_groups?all_available=true", &descendants); err != nil {
+		return nil, err
+	}
+	return append([]gitLabGroup{group}, descendants...), nil
+}
+
+func (g *GroupsGenerator) createGroupResources(group gitLabGroup) []terraformutils.Resource {
+	groupID := strconv.Itoa(group.ID)
+	return []terraformutils.Resource{terraformutils.NewSimpleResource(
+		groupID,
+		group.FullPath,
+		"gitlab_group",
+		g.ProviderName,
+		[]string{},
+	)}
+}
+
+// createMembershipResources creates direct members of group, inherited members are imported with their own group
+func (g *GroupsGenerator) createMembershipResources(group gitLabGroup) ([]terraformutils.Resource, error) {
+	var members []gitLabMember
+	groupID := strconv.Itoa(group.ID)
+	if err := g.listInto("groups/"+groupID+"/members", &members); err != nil {
+		return nil, err
+	}
+	var resources []terraformutils.Resource
+	for _, member := range members {
+		userID := strconv.Itoa(member.ID)
+		resources = append(resources, terraformutils.NewResource(
+			groupID+":"+userID,
+			group.FullPath+"_"+member.Username,
+			"gitlab_group_membership",
+			g.ProviderName,
+			map[string]string{
+				"group_id": groupID,
+				"user_id":  userID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return resources, nil
+}
+
+func (g *GroupsGenerator) createVariableResources(group gitLabGroup) ([]terraformutils.Resource, error) {
+	var variables []gitLabVariable
+	groupID := strconv.Itoa(group.ID)
+	if err := g.listInto("groups/"+groupID+"/variables", &variables); err != nil {
+		return nil, err
+	}
+	var resources []terraformutils.Resource
+	for _, variable := range variables {
+		resources = append(resources, terraformutils.NewResource(
+			groupID+":"+variable.Key+":"+variable.EnvironmentScope,
+			group.FullPath+"_"+variable.Key+"_"+variable.EnvironmentScope,
+			"gitlab_group_variable",
+			g.ProviderName,
+			map[string]string{
+				"group":             groupID,
+				"key":               variable.Key,
+				"environment_scope": variable.EnvironmentScope,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return resources, nil
+}
+
+func (g *GroupsGenerator) InitResources() error {
+	groups, err := g.listGroups()
+	if err != nil {
+		return err
+	}
+	for _, group := range groups {
+		g.Resources = append(g.Resources, g.createGroupResources(group)...)
+		memberships, err := g.createMembershipResources(group)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, memberships...)
+		variables, err := g.createVariableResources(group)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, variables...)
+	}
+	return nil
+}
+
+// PostConvertHook links subgroups to parents and memberships and variables to groups,
+// values of variables are replaced by sensitive variables, masked and protected flags stay as imported
+func (g *GroupsGenerator) PostConvertHook() error {
+	groups := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "gitlab_group" {
+			groups[r.InstanceState.ID] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		switch r.InstanceInfo.Type {
+		case "gitlab_group":
+			if resourceName, exist := groups[r.InstanceState.Attributes["parent_id"]]; exist {
+				g.Resources[i].Item["parent_id"] = "${gitlab_group." + resourceName + ".id}"
+			}
+		case "gitlab_group_membership":
+			if resourceName, exist := groups[r.InstanceState.Attributes["group_id"]]; exist {
+				g.Resources[i].Item["group_id"] = "${gitlab_group." + resourceName + ".id}"
+			}
+		case "gitlab_group_variable":
+			if resourceName, exist := groups[r.InstanceState.Attributes["group"]]; exist {
+				g.Resources[i].Item["group"] = "${gitlab_group." + resourceName + ".id}"
+			}
+			g.Resources[i].Item["value"] = sensitiveVariable(&g.Resources[i], "value",
+				"Value of variable "+r.InstanceState.Attributes["key"]+" of group "+r.InstanceState.Attributes["group"])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gitlab
+
+import (
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type ProjectsGenerator struct {
+	GitLabService
+}
+
+type gitLabProject struct {
+	ID                int    `json:"id"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	DefaultBranch     string `json:"default_branch"`
+}
+
+type gitLabDeployKey struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+type gitLabHook struct {
+	ID  int    `json:"id"`
+	URL string `json:"url"`
+}
+
+func (g *ProjectsGenerator) listProjects() ([]gitLabProject, error) {
+	var projects []gitLabProject
+	uri := "groups/" + pathID(g.Args["group"].(string)) + "/projects?include_subgroups=true&with_shared=false&order_by=id&sort=asc"
+	if err := g.listInto(uri, &projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (g *ProjectsGenerator) createProjectResources(project gitLabProject) []terraformutils.Resource {
+	return []terraformutils.Resource{terraformutils.NewSimpleResource(
+		strconv.Itoa(project.ID),
+		project.PathWithNamespace,
+		"gitlab_project",
+		g.ProviderName,
+		[]string{},
+	)}
+}
+
+// createBranchProtectionResources creates protection of default branch, other protected branches
+// are managed outside of project settings
+func (g *ProjectsGenerator) createBranchProtectionResources(project gitLabProject) ([]terraformutils.Resource, error) {
+	if project.DefaultBranch == "" {
+		return nil, nil
+	}
+	projectID := strconv.Itoa(project.ID)
+	body, _, err := g.generateRequest("projects/" + projectID + "/protected_branches/" + pathID(project.DefaultBranch))
+	if err != nil || body == nil {
+		return nil, err
+	}
+	return []terraformutils.Resource{terraformutils.NewResource(
+		projectID+":"+project.DefaultBranch,
+		project.PathWithNamespace+"_"+project.DefaultBranch,
+		"gitlab_branch_protection",
+		g.ProviderName,
+		map[string]string{
+			"project": projectID,
+			"branch":  project.DefaultBranch,
+		},
+		[]string{},
+		map[string]interface{}{},
+	)}, nil
+}
+
+func (g *ProjectsGenerator) createVariableResources(project gitLabProject) ([]terraformutils.Resource, error) {
+	var variables []gitLabVariable
+	projectID := strconv.Itoa(project.ID)
+	if err := g.listInto("projects/"+projectID+"/variables", &variables); err != nil {
+		return nil, err
+	}
+	var resources []terraformutils.Resource
+	for _, variable := range variables {
+		resources = append(resources, terraformutils.NewResource(
+			projectID+":"+variable.Key+":"+variable.EnvironmentScope,
+			project.PathWithNamespace+"_"+variable.Key+"_"+variable.EnvironmentScope,
+			"gitlab_project_variable",
+			g.ProviderName,
+			map[string]string{
+				"project":           projectID,
+				"key":               variable.Key,
+				"environment_scope": variable.EnvironmentScope,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return resources, nil
+}
+
+func (g *ProjectsGenerator) createDeployKeyResources(project gitLabProject) ([]terraformutils.Resource, error) {
+	var keys []gitLabDeployKey
+	projectID := strconv.Itoa(project.ID)
+	if err := g.listInto("projects/"+projectID+"/deploy_keys", &keys); err != nil {
+		return nil, err
+	}
+	var resources []terraformutils.Resource
+	for _, key := range keys {
+		resources = append(resources, terraformutils.NewResource(
+			strconv.Itoa(key.ID),
+			project.PathWithNamespace+"_"+key.Title,
+			"gitlab_deploy_key",
+			g.ProviderName,
+			map[string]string{
+				"project": projectID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return resources, nil
+}
+
+func (g *ProjectsGenerator) createHookResources(project gitLabProject) ([]terraformutils.Resource, error) {
+	var hooks []gitLabHook
+	projectID := strconv.Itoa(project.ID)
+	if err := g.listInto("projects/"+projectID+"/hooks", &hooks); err != nil {
+		return nil, err
+	}
+	var resources []terraformutils.Resource
+	for _, hook := range hooks {
+		resources = append(resources, terraformutils.NewResource(
+			strconv.Itoa(hook.ID),
+			project.PathWithNamespace+"_"+strconv.Itoa(hook.ID),
+			"gitlab_project_hook",
+			g.ProviderName,
+			map[string]string{
+				"project": projectID,
+				"url":     hook.URL,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return resources, nil
+}
+
+func (g *ProjectsGenerator) InitResources() error {
+	projects, err := g.listProjects()
+	if err != nil {
+		return err
+	}
+	for _, project := range projects {
+		g.Resources = append(g.Resources, g.createProjectResources(project)...)
+		for _, f := range []func(gitLabProject) ([]terraformutils.Resource, error){
+			g.createBranchProtectionResources,
+			g.createVariableResources,
+			g.createDeployKeyResources,
+			g.createHookResources,
+		} {
+			resources, err := f(project)
+			if err != nil {
+				return err
+			}
+			g.Resources = append(g.Resources, resources...)
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links project resources to projects, values of variables are replaced by sensitive variables,
+// API doesn't return hook tokens so they are left out
+func (g *ProjectsGenerator) PostConvertHook() error {
+	projects := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "gitlab_project" {
+			projects[r.InstanceState.ID] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type == "gitlab_project" {
+			continue
+		}
+		if resourceName, exist := projects[r.InstanceState.Attributes["project"]]; exist {
+			g.Resources[i].Item["project"] = "${gitlab_project." + resourceName + ".id}"
+		}
+		switch r.InstanceInfo.Type {
+		case "gitlab_project_variable":
+			g.Resources[i].Item["value"] = sensitiveVariable(&g.Resources[i], "value",
+				"Value of variable "+r.InstanceState.Attributes["key"]+" of project "+r.InstanceState.Attributes["project"])
+		case "gitlab_project_hook":
+			delete(g.Resources[i].Item, "token")
+		}
+	}
+	return nil
+}
+
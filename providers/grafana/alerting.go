@@ -0,0 +1,120 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grafana
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// AlertingGenerator imports unified alerting rule groups and notification policy tree
+// through provisioning API of Grafana 9.1 and newer
+type AlertingGenerator struct {
+	GrafanaService
+}
+
+type grafanaAlertRule struct {
+	FolderUID string `json:"folderUID"`
+	RuleGroup string `json:"ruleGroup"`
+}
+
+type grafanaNotificationPolicy struct {
+	Receiver string `json:"receiver"`
+}
+
+// loadRuleGroups creates rule group for each folder and group of alert rules, rules are generated within their groups
+func (g *AlertingGenerator) loadRuleGroups() error {
+	var rules []grafanaAlertRule
+	status, err := g.getJSON("/api/v1/provisioning/alert-rules", &rules)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusNotFound {
+		log.Println("grafana: provisioning API of unified alerting isn't available, skipping alert rules")
+		return nil
+	}
+	groups := map[string]bool{}
+	for _, rule := range rules {
+		id := rule.FolderUID + ";" + rule.RuleGroup
+		if groups[id] {
+			continue
+		}
+		groups[id] = true
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			id,
+			rule.FolderUID+"_"+rule.RuleGroup,
+			"grafana_rule_group",
+			g.ProviderName,
+			map[string]string{
+				"folder_uid": rule.FolderUID,
+				"name":       rule.RuleGroup,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
+
+// loadNotificationPolicy creates notification policy, there is single policy tree per organization
+func (g *AlertingGenerator) loadNotificationPolicy() error {
+	var policy grafanaNotificationPolicy
+	status, err := g.getJSON("/api/v1/provisioning/policies", &policy)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK || policy.Receiver == "" {
+		return nil
+	}
+	g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+		"policy",
+		"policy",
+		"grafana_notification_policy",
+		g.ProviderName,
+		[]string{},
+	))
+	return nil
+}
+
+func (g *AlertingGenerator) InitResources() error {
+	if err := g.loadRuleGroups(); err != nil {
+		return err
+	}
+	return g.loadNotificationPolicy()
+}
+
+// PostConvertHook writes models of alert rule queries as heredocs with sorted keys
+func (g *AlertingGenerator) PostConvertHook() error {
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type != "grafana_rule_group" {
+			continue
+		}
+		for _, rule := range terraformutils.WalkAndGet("rule", r.Item) {
+			for _, data := range terraformutils.WalkAndGet("data", rule) {
+				query, ok := data.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if model, ok := query["model"].(string); ok {
+					if heredoc, ok := jsonHeredoc(model); ok {
+						query["model"] = heredoc
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
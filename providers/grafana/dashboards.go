@@ -0,0 +1,130 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grafana
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+const (
+	grafanaFolderPageSize    = 1000
+	grafanaDashboardPageSize = 5000
+)
+
+// DashboardsGenerator imports folders together with dashboards so dashboards can reference folders directly
+type DashboardsGenerator struct {
+	GrafanaService
+}
+
+type grafanaFolder struct {
+	ID    int    `json:"id"`
+	UID   string `json:"uid"`
+	Title string `json:"title"`
+}
+
+type grafanaDashboard struct {
+	UID         string `json:"uid"`
+	Title       string `json:"title"`
+	FolderTitle string `json:"folderTitle"`
+}
+
+func (g *DashboardsGenerator) loadFolders() error {
+	for page := 1; ; page++ {
+		var folders []grafanaFolder
+		if _, err := g.getJSON(fmt.Sprintf("/api/folders?limit=%d&page=%d", grafanaFolderPageSize, page), &folders); err != nil {
+			return err
+		}
+		for _, folder := range folders {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				strconv.Itoa(folder.ID),
+				folder.Title,
+				"grafana_folder",
+				g.ProviderName,
+				[]string{},
+			))
+		}
+		if len(folders) < grafanaFolderPageSize {
+			return nil
+		}
+	}
+}
+
+func (g *DashboardsGenerator) loadDashboards() error {
+	for page := 1; ; page++ {
+		var dashboards []grafanaDashboard
+		if _, err := g.getJSON(fmt.Sprintf("/api/search?type=dash-db&limit=%d&page=%d", grafanaDashboardPageSize, page), &dashboards); err != nil {
+			return err
+		}
+		for _, dashboard := range dashboards {
+			name := dashboard.Title
+			if dashboard.FolderTitle != "" {
+				name = dashboard.FolderTitle + "_" + name
+			}
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				dashboard.UID,
+				name,
+				"grafana_dashboard",
+				g.ProviderName,
+				[]string{},
+			))
+		}
+		if len(dashboards) < grafanaDashboardPageSize {
+			return nil
+		}
+	}
+}
+
+func (g *DashboardsGenerator) InitResources() error {
+	if err := g.loadFolders(); err != nil {
+		return err
+	}
+	return g.loadDashboards()
+}
+
+// PostConvertHook writes dashboard JSON as heredoc with sorted keys and without id, version
+// and iteration, they change on each save, and links dashboards to folders by numeric id or uid,
+// whichever the dashboard uses
+func (g *DashboardsGenerator) PostConvertHook() error {
+	foldersByID := map[string]string{}
+	foldersByUID := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "grafana_folder" {
+			foldersByID[r.InstanceState.ID] = r.ResourceName
+			foldersByUID[r.InstanceState.Attributes["uid"]] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type != "grafana_dashboard" {
+			continue
+		}
+		if config, ok := r.Item["config_json"].(string); ok {
+			if heredoc, ok := jsonHeredoc(config, "id", "version", "iteration"); ok {
+				g.Resources[i].Item["config_json"] = heredoc
+			}
+		}
+		folder := r.InstanceState.Attributes["folder"]
+		if folder == "" {
+			continue
+		}
+		if resourceName, exist := foldersByID[folder]; exist {
+			g.Resources[i].Item["folder"] = "${grafana_folder." + resourceName + ".id}"
+		} else if resourceName, exist := foldersByUID[folder]; exist {
+			g.Resources[i].Item["folder"] = "${grafana_folder." + resourceName + ".uid}"
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grafana
+
+import (
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// identifierRegexp matches secure field names usable as object keys without quotes
+var identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type DataSourceGenerator struct {
+	GrafanaService
+	// secureFields keeps names of secure JSON fields set on data source by its id,
+	// API tells only that they are set, not their values
+	secureFields map[string][]string
+}
+
+type grafanaDataSource struct {
+	ID               int             `json:"id"`
+	Name             string          `json:"name"`
+	SecureJSONFields map[string]bool `json:"secureJsonFields"`
+}
+
+func (g *DataSourceGenerator) InitResources() error {
+	var dataSources []grafanaDataSource
+	if _, err := g.getJSON("/api/datasources", &dataSources); err != nil {
+		return err
+	}
+	g.secureFields = map[string][]string{}
+	for _, dataSource := range dataSources {
+		id := strconv.Itoa(dataSource.ID)
+		var details grafanaDataSource
+		if _, err := g.getJSON("/api/datasources/"+id, &details); err != nil {
+			return err
+		}
+		for field, set := range details.SecureJSONFields {
+			if set {
+				g.secureFields[id] = append(g.secureFields[id], field)
+			}
+		}
+		sort.Strings(g.secureFields[id])
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			id,
+			dataSource.Name,
+			"grafana_data_source",
+			g.ProviderName,
+			[]string{},
+		))
+	}
+	return nil
+}
+
+// PostConvertHook replaces passwords and secure JSON data of data sources by sensitive variables
+func (g *DataSourceGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		name := r.InstanceState.Attributes["name"]
+		for _, key := range []string{"password", "basic_auth_password"} {
+			if value, ok := r.Item[key].(string); ok && value != "" {
+				variable := sensitiveVariable(&g.Resources[i], key, strings.ReplaceAll(key, "_", " ")+" of data source "+name)
+				g.Resources[i].Item[key] = "${var." + variable + "}"
+			}
+		}
+		fields := g.secureFields[r.InstanceState.ID]
+		if len(fields) == 0 {
+			continue
+		}
+		delete(g.Resources[i].Item, "secure_json_data")
+		var secureData []string
+		for _, field := range fields {
+			if !identifierRegexp.MatchString(field) {
+				log.Printf("WARNING: grafana: secure field %s of data source %s can't be generated, set it manually", field, name)
+				continue
+			}
+			variable := sensitiveVariable(&g.Resources[i], field, field+" of data source "+name)
+			secureData = append(secureData, field+" = var."+variable)
+		}
+		if len(secureData) == 0 {
+			continue
+		}
+		// jsonencode keeps references to variables, they would be quoted in literal JSON
+		g.Resources[i].Item["secure_json_data_encoded"] = "${jsonencode({" + strings.Join(secureData, ", ") + "})}"
+	}
+	return nil
+}
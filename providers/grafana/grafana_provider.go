@@ -0,0 +1,126 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grafana
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type GrafanaProvider struct { //nolint
+	terraformutils.Provider
+	url   string
+	auth  string
+	orgID int
+}
+
+// Init GrafanaProvider with URL of instance, API key or user:password and optional organization id
+func (p *GrafanaProvider) Init(args []string) error {
+	p.url = os.Getenv("GRAFANA_URL")
+	if len(args) > 0 && args[0] != "" {
+		p.url = args[0]
+	}
+	if p.url == "" {
+		return errors.New("set GRAFANA_URL env var or --url")
+	}
+	p.url = strings.TrimSuffix(p.url, "/")
+
+	p.auth = os.Getenv("GRAFANA_AUTH")
+	if len(args) > 1 && args[1] != "" {
+		p.auth = args[1]
+	}
+	if p.auth == "" {
+		return errors.New("set GRAFANA_AUTH env var or --auth")
+	}
+
+	orgID := os.Getenv("GRAFANA_ORG_ID")
+	if len(args) > 2 && args[2] != "" {
+		orgID = args[2]
+	}
+	if orgID != "" {
+		var err error
+		if p.orgID, err = strconv.Atoi(orgID); err != nil {
+			return errors.New("organization id must be a number")
+		}
+	}
+	return nil
+}
+
+func (p *GrafanaProvider) GetName() string {
+	return "grafana"
+}
+
+func (p *GrafanaProvider) GetProviderData(arg ...string) map[string]interface{} {
+	grafanaConfig := map[string]interface{}{
+		"url": p.url,
+	}
+	if p.orgID != 0 {
+		grafanaConfig["org_id"] = p.orgID
+	}
+	return map[string]interface{}{
+		"provider": map[string]interface{}{
+			"grafana": grafanaConfig,
+		},
+	}
+}
+
+func (p *GrafanaProvider) GetConfig() cty.Value {
+	config := map[string]cty.Value{
+		"url":  cty.StringVal(p.url),
+		"auth": cty.StringVal(p.auth),
+	}
+	if p.orgID != 0 {
+		config["org_id"] = cty.NumberIntVal(int64(p.orgID))
+	}
+	return cty.ObjectVal(config)
+}
+
+func (p *GrafanaProvider) InitService(serviceName string, verbose bool) error {
+	var isSupported bool
+	if _, isSupported = p.GetSupportedService()[serviceName]; !isSupported {
+		return errors.New(p.GetName() + ": " + serviceName + " not supported service")
+	}
+	p.Service = p.GetSupportedService()[serviceName]
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"url":    p.url,
+		"auth":   p.auth,
+		"org_id": p.orgID,
+	})
+	return nil
+}
+
+func (p *GrafanaProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	return map[string]terraformutils.ServiceGenerator{
+		"alerting":      &AlertingGenerator{},
+		"dashboards":    &DashboardsGenerator{},
+		"data_sources":  &DataSourceGenerator{},
+		"organizations": &OrganizationGenerator{},
+	}
+}
+
+func (GrafanaProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{
+		"alerting": {
+			"dashboards": []string{"folder_uid", "uid"},
+		},
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grafana
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type GrafanaService struct { //nolint
+	terraformutils.Service
+}
+
+// getJSON decodes response of API path into v and returns status code, only 2xx, 401, 403 and 404
+// are returned without error so callers can skip resources their credentials can't read
+func (s *GrafanaService) getJSON(path string, v interface{}) (int, error) {
+	req, err := http.NewRequest("GET", s.Args["url"].(string)+path, nil)
+	if err != nil {
+		return 0, err
+	}
+	auth := s.Args["auth"].(string)
+	if user := strings.SplitN(auth, ":", 2); len(user) == 2 {
+		req.SetBasicAuth(user[0], user[1])
+	} else {
+		req.Header.Set("Authorization", "Bearer "+auth)
+	}
+	if orgID, ok := s.Args["org_id"].(int); ok && orgID != 0 {
+		req.Header.Set("X-Grafana-Org-Id", strconv.Itoa(orgID))
+	}
+	req.Header.Set("Accept", "application/json")
+	client := &http.Client{Transport: terraformutils.NewRetryTransport(http.DefaultTransport)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return resp.StatusCode, json.Unmarshal(body, v)
+	case resp.StatusCode == http.StatusUnauthorized, resp.StatusCode == http.StatusForbidden, resp.StatusCode == http.StatusNotFound:
+		return resp.StatusCode, nil
+	}
+	return resp.StatusCode, fmt.Errorf("grafana: %s returned %s: %s", path, resp.Status, body)
+}
+
+// jsonHeredoc formats JSON document as indented heredoc, keys are sorted so output is stable across imports,
+// top level keys in prune are removed
+func jsonHeredoc(document string, prune ...string) (string, bool) {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(document), &parsed); err != nil {
+		return "", false
+	}
+	if object, ok := parsed.(map[string]interface{}); ok {
+		for _, key := range prune {
+			delete(object, key)
+		}
+	}
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(parsed); err != nil {
+		return "", false
+	}
+	return terraformutils.Heredoc("EOT", buffer.String()), true
+}
+
+// sensitiveVariable declares sensitive variable for key of resource and returns its name
+func sensitiveVariable(r *terraformutils.Resource, key, description string) string {
+	variable := strings.TrimPrefix(r.ResourceName, "tfer--") + "_" + key
+	r.AddSensitiveVariable(variable, description)
+	return variable
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grafana
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// OrganizationGenerator imports organizations, listing them requires server admin credentials
+type OrganizationGenerator struct {
+	GrafanaService
+}
+
+type grafanaOrganization struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func (g *OrganizationGenerator) InitResources() error {
+	var organizations []grafanaOrganization
+	status, err := g.getJSON("/api/orgs", &organizations)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusUnauthorized || status == http.StatusForbidden {
+		log.Println("grafana: listing organizations requires server admin credentials, skipping")
+		return nil
+	}
+	for _, organization := range organizations {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			strconv.Itoa(organization.ID),
+			organization.Name,
+			"grafana_organization",
+			g.ProviderName,
+			[]string{},
+		))
+	}
+	return nil
+}
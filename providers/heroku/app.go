@@ -28,12 +28,15 @@ type AppGenerator struct {
 func (g AppGenerator) createResources(appList []heroku.App) []terraformutils.Resource {
 	var resources []terraformutils.Resource
 	for _, app := range appList {
-		resources = append(resources, terraformutils.NewSimpleResource(
+		resource := terraformutils.NewSimpleResource(
 			app.ID,
 			app.Name,
 			"heroku_app",
 			"heroku",
-			[]string{}))
+			[]string{})
+		// config vars are managed by heroku_app_config_association
+		resource.IgnoreKeys = append(resource.IgnoreKeys, "^config_vars", "^sensitive_config_vars", "^all_config_vars")
+		resources = append(resources, resource)
 	}
 	return resources
 }
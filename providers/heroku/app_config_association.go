@@ -18,15 +18,37 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	heroku "github.com/heroku/heroku-go/v5"
 )
 
+var variableNameRegexp = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
 type AppConfigAssociationGenerator struct {
 	HerokuService
 }
 
+// addOnConfigVars returns names of config vars add-ons of app expose to it, they are managed by add-ons
+func addOnConfigVars(svc *heroku.Service, app heroku.App) map[string]bool {
+	configVars := map[string]bool{}
+	addOns, err := svc.AddOnListByApp(context.TODO(), app.ID, &heroku.ListRange{Field: "id"})
+	if err != nil {
+		log.Println(err)
+		return configVars
+	}
+	for _, addOn := range addOns {
+		for _, name := range addOn.ConfigVars {
+			configVars[name] = true
+		}
+	}
+	return configVars
+}
+
+// createResources creates config association of each app, config vars managed by add-ons are skipped
 func (g AppConfigAssociationGenerator) createResources(svc *heroku.Service, appList []heroku.App) []terraformutils.Resource {
 	var resources []terraformutils.Resource
 	for _, app := range appList {
@@ -34,6 +56,13 @@ func (g AppConfigAssociationGenerator) createResources(svc *heroku.Service, appL
 		if err != nil {
 			log.Println(err)
 		}
+		managed := addOnConfigVars(svc, app)
+		configVars := map[string]interface{}{}
+		for name, value := range output {
+			if !managed[name] && value != nil {
+				configVars[name] = *value
+			}
+		}
 		resources = append(resources, terraformutils.NewResource(
 			fmt.Sprintf("%s-config-association", app.Name),
 			fmt.Sprintf("%s-config-association", app.Name),
@@ -44,14 +73,43 @@ func (g AppConfigAssociationGenerator) createResources(svc *heroku.Service, appL
 			},
 			[]string{},
 			map[string]interface{}{
-				// since Heroku does not distinguish between non-sensitive and sensitive config variables, set output to vars
-				"vars":           output,
-				"sensitive_vars": map[string]interface{}{},
+				"vars":           map[string]interface{}{},
+				"sensitive_vars": configVars,
 			}))
 	}
 	return resources
 }
 
+// redactConfigVars replaces each config var value by sensitive variable declared in resource data files,
+// Heroku doesn't tell secrets from plain settings so all of them are redacted. Values are written
+// to tfvars file of app only with --config-vars-tfvars
+func redactConfigVars(r *terraformutils.Resource, writeValues bool) {
+	configVars, ok := r.Item["sensitive_vars"].(map[string]interface{})
+	if !ok || len(configVars) == 0 {
+		return
+	}
+	names := make([]string, 0, len(configVars))
+	for name := range configVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	appName := strings.TrimSuffix(strings.TrimPrefix(r.ResourceName, "tfer--"), "-config-association")
+	redacted := map[string]interface{}{}
+	var tfvars strings.Builder
+	for _, name := range names {
+		variable := variableNameRegexp.ReplaceAllString(appName+"_"+strings.ToLower(name), "_")
+		redacted[name] = r.AddSensitiveVariable(variable, "Config var "+name+" of app "+r.InstanceState.Attributes["app_id"])
+		if writeValues {
+			tfvars.WriteString(variable + " = " + terraformutils.HCLString(fmt.Sprint(configVars[name])) + "\n")
+		}
+	}
+	if tfvars.Len() > 0 {
+		r.DataFiles[appName+"_config_vars.auto.tfvars"] = []byte(tfvars.String())
+	}
+	log.Printf("heroku: config vars of app %s replaced by variables", r.InstanceState.Attributes["app_id"])
+	r.Item["sensitive_vars"] = redacted
+}
+
 func (g *AppConfigAssociationGenerator) InitResources() error {
 	svc := g.generateService()
 	output, err := svc.AppList(context.TODO(), &heroku.ListRange{Field: "id"})
@@ -61,3 +119,11 @@ func (g *AppConfigAssociationGenerator) InitResources() error {
 	g.Resources = g.createResources(svc, output)
 	return nil
 }
+
+func (g *AppConfigAssociationGenerator) PostConvertHook() error {
+	writeValues, _ := g.Args["write_config_vars"].(bool)
+	for i := range g.Resources {
+		redactConfigVars(&g.Resources[i], writeValues)
+	}
+	return nil
+}
@@ -17,14 +17,16 @@ package heroku
 import (
 	"errors"
 	"os"
+	"strconv"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 )
 
 type HerokuProvider struct { //nolint
 	terraformutils.Provider
-	email  string
-	apiKey string
+	email           string
+	apiKey          string
+	writeConfigVars bool
 }
 
 func (p *HerokuProvider) Init(args []string) error {
@@ -38,6 +40,13 @@ func (p *HerokuProvider) Init(args []string) error {
 	}
 	p.apiKey = os.Getenv("HEROKU_API_KEY")
 
+	if len(args) > 0 {
+		writeConfigVars, err := strconv.ParseBool(args[0])
+		if err != nil {
+			return err
+		}
+		p.writeConfigVars = writeConfigVars
+	}
 	return nil
 }
 
@@ -56,7 +65,37 @@ func (p *HerokuProvider) GetProviderData(arg ...string) map[string]interface{} {
 }
 
 func (HerokuProvider) GetResourceConnections() map[string]map[string][]string {
-	return map[string]map[string][]string{}
+	return map[string]map[string][]string{
+		"addon": {
+			"app": []string{"app", "id", "app", "name"},
+		},
+		"addon_attachment": {
+			"app":   []string{"app_id", "id"},
+			"addon": []string{"addon_id", "id"},
+		},
+		"app_config_association": {
+			"app": []string{"app_id", "id"},
+		},
+		"app_feature": {
+			"app": []string{"app", "id", "app", "name"},
+		},
+		"app_webhook": {
+			"app": []string{"app_id", "id"},
+		},
+		"domain": {
+			"app": []string{"app", "id", "app", "name"},
+		},
+		"drain": {
+			"app": []string{"app", "id", "app", "name"},
+		},
+		"formation": {
+			"app": []string{"app", "id", "app", "name"},
+		},
+		"pipeline_coupling": {
+			"app":      []string{"app", "id", "app", "name"},
+			"pipeline": []string{"pipeline", "id"},
+		},
+	}
 }
 
 func (p *HerokuProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
@@ -90,8 +129,9 @@ func (p *HerokuProvider) InitService(serviceName string, verbose bool) error {
 	p.Service.SetVerbose(verbose)
 	p.Service.SetProviderName(p.GetName())
 	p.Service.SetArgs(map[string]interface{}{
-		"email":   p.email,
-		"api_key": p.apiKey,
+		"email":             p.email,
+		"api_key":           p.apiKey,
+		"write_config_vars": p.writeConfigVars,
 	})
 	return nil
 }
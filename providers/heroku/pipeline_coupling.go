@@ -25,12 +25,16 @@ type PipelineCouplingGenerator struct {
 	HerokuService
 }
 
-func (g PipelineCouplingGenerator) createResources(pipelineCouplingList []heroku.PipelineCoupling) []terraformutils.Resource {
+func (g PipelineCouplingGenerator) createResources(pipelineCouplingList []heroku.PipelineCoupling, appNames map[string]string) []terraformutils.Resource {
 	var resources []terraformutils.Resource
 	for _, pipelineCoupling := range pipelineCouplingList {
+		name := pipelineCoupling.ID
+		if appName, exist := appNames[pipelineCoupling.App.ID]; exist {
+			name = appName + "_" + pipelineCoupling.Stage
+		}
 		resources = append(resources, terraformutils.NewSimpleResource(
 			pipelineCoupling.ID,
-			pipelineCoupling.ID,
+			name,
 			"heroku_pipeline_coupling",
 			"heroku",
 			[]string{}))
@@ -44,6 +48,14 @@ func (g *PipelineCouplingGenerator) InitResources() error {
 	if err != nil {
 		return err
 	}
-	g.Resources = g.createResources(output)
+	apps, err := svc.AppList(context.TODO(), &heroku.ListRange{Field: "id"})
+	if err != nil {
+		return err
+	}
+	appNames := map[string]string{}
+	for _, app := range apps {
+		appNames[app.ID] = app.Name
+	}
+	g.Resources = g.createResources(output, appNames)
 	return nil
 }
@@ -0,0 +1,153 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// serverPopulatedKeys are metadata attributes set by API server, they would never match in plan
+var serverPopulatedKeys = []string{
+	`^metadata\.\d+\.(resource_version|uid|generation|self_link)$`,
+}
+
+// defaultTolerationKeys are tolerations added to pods by DefaultTolerationSeconds admission plugin
+var defaultTolerationKeys = map[string]bool{
+	"node.kubernetes.io/not-ready":   true,
+	"node.kubernetes.io/unreachable": true,
+}
+
+var variableNameRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// isAutoCreated returns true for objects created by cluster itself in each namespace,
+// e.g. default service account and its token secret
+func isAutoCreated(kind string, item reflect.Value) bool {
+	name := item.FieldByName("Name").String()
+	switch kind {
+	case "Secret":
+		return item.FieldByName("Type").String() == "kubernetes.io/service-account-token"
+	case "ServiceAccount":
+		return name == "default"
+	case "ConfigMap":
+		return name == "kube-root-ca.crt"
+	case "Service":
+		return name == "kubernetes" && item.FieldByName("Namespace").String() == "default"
+	}
+	return false
+}
+
+// pruneDefaultTolerations removes default tolerations from all toleration blocks of value
+func pruneDefaultTolerations(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if tolerations, ok := nested.([]interface{}); ok && key == "toleration" {
+				kept := []interface{}{}
+				for _, toleration := range tolerations {
+					if !isDefaultToleration(toleration) {
+						kept = append(kept, toleration)
+					}
+				}
+				if len(kept) == 0 {
+					delete(v, key)
+				} else {
+					v[key] = kept
+				}
+				continue
+			}
+			pruneDefaultTolerations(nested)
+		}
+	case []interface{}:
+		for _, nested := range v {
+			pruneDefaultTolerations(nested)
+		}
+	}
+}
+
+func isDefaultToleration(toleration interface{}) bool {
+	t, ok := toleration.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	return defaultTolerationKeys[fmt.Sprint(t["key"])] && t["effect"] == "NoExecute" && fmt.Sprint(t["toleration_seconds"]) == "300"
+}
+
+// pruneServiceAccountTokens removes references to token secrets generated for service account
+func pruneServiceAccountTokens(item map[string]interface{}) {
+	metadata, ok := item["metadata"].([]interface{})
+	if !ok || len(metadata) == 0 {
+		return
+	}
+	name := fmt.Sprint(metadata[0].(map[string]interface{})["name"])
+	secrets, ok := item["secret"].([]interface{})
+	if !ok {
+		return
+	}
+	kept := []interface{}{}
+	for _, secret := range secrets {
+		if secret, ok := secret.(map[string]interface{}); ok && strings.HasPrefix(fmt.Sprint(secret["name"]), name+"-token-") {
+			continue
+		}
+		kept = append(kept, secret)
+	}
+	if len(kept) == 0 {
+		delete(item, "secret")
+	} else {
+		item["secret"] = kept
+	}
+}
+
+// redactSecretData replaces each value of secret data by sensitive variable declared in resource data files,
+// keys with dots unflattened to nested maps are joined back
+func redactSecretData(r *terraformutils.Resource) {
+	data, ok := r.Item["data"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	values := map[string]interface{}{}
+	flattenSecretData("", data, values)
+	if len(values) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	redacted := map[string]interface{}{}
+	for _, key := range keys {
+		variable := strings.TrimPrefix(r.ResourceName, "tfer--") + "_" + variableNameRegexp.ReplaceAllString(strings.ToLower(key), "_")
+		redacted[key] = r.AddSensitiveVariable(variable, "Key "+key+" of secret "+r.InstanceState.ID)
+	}
+	log.Printf("kubernetes: data of secret %s replaced by variables", r.InstanceState.ID)
+	r.Item["data"] = redacted
+}
+
+func flattenSecretData(prefix string, data map[string]interface{}, values map[string]interface{}) {
+	for key, value := range data {
+		if nested, ok := value.(map[string]interface{}); ok {
+			flattenSecretData(prefix+key+".", nested, values)
+			continue
+		}
+		values[prefix+key] = value
+	}
+}
@@ -34,8 +34,9 @@ type Kind struct {
 // Generate TerraformResources from Kubernetes API,
 // from each kubernetes object 1 TerraformResource.
 // Use UID as the resource IDs.
+// Namespace and label selector of provider args narrow listed objects
 func (k *Kind) InitResources() error {
-	config, _, err := initClientAndConfig()
+	config, _, err := initClientAndConfig(k.stringArg("context"))
 	if err != nil {
 		return err
 	}
@@ -50,14 +51,14 @@ func (k *Kind) InitResources() error {
 		[]reflect.Value{})[0]
 
 	param := []reflect.Value{}
-	namespace := ""
+	namespace := k.stringArg("namespace")
 	if k.Namespaced {
 		param = append(param, reflect.ValueOf(namespace))
 	}
 	resource := group.MethodByName(extractClientSetFuncTypeName(k.Name)).Call(param)[0]
 
 	results := resource.MethodByName("List").Call([]reflect.Value{
-		reflect.ValueOf(metav1.ListOptions{})})
+		reflect.ValueOf(metav1.ListOptions{LabelSelector: k.stringArg("label_selector")})})
 
 	if !results[1].IsNil() {
 		return results[1].Interface().(error)
@@ -70,6 +71,12 @@ func (k *Kind) InitResources() error {
 		if item.FieldByName("OwnerReferences").Len() > 0 {
 			continue
 		}
+		if isAutoCreated(k.Name, item) {
+			continue
+		}
+		if k.Name == "Namespace" && namespace != "" && item.FieldByName("Name").String() != namespace {
+			continue
+		}
 
 		name := ""
 		if k.Namespaced {
@@ -78,13 +85,35 @@ func (k *Kind) InitResources() error {
 			name = item.FieldByName("Name").String()
 		}
 
-		k.Resources = append(k.Resources, terraformutils.NewSimpleResource(
+		newResource := terraformutils.NewSimpleResource(
 			name,
 			name,
 			extractTfResourceName(k.Name),
 			"kubernetes",
 			[]string{},
-		))
+		)
+		newResource.IgnoreKeys = append(newResource.IgnoreKeys, serverPopulatedKeys...)
+		k.Resources = append(k.Resources, newResource)
 	}
 	return nil
 }
+
+// PostConvertHook prunes tolerations added by admission controllers and token secrets of service accounts,
+// data of secrets is replaced by sensitive variables
+func (k *Kind) PostConvertHook() error {
+	for i, r := range k.Resources {
+		pruneDefaultTolerations(r.Item)
+		switch r.InstanceInfo.Type {
+		case "kubernetes_secret":
+			redactSecretData(&k.Resources[i])
+		case "kubernetes_service_account":
+			pruneServiceAccountTokens(r.Item)
+		}
+	}
+	return nil
+}
+
+func (k *Kind) stringArg(name string) string {
+	value, _ := k.GetArgs()[name].(string)
+	return value
+}
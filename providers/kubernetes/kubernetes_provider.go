@@ -40,7 +40,10 @@ import (
 
 type KubernetesProvider struct { //nolint
 	terraformutils.Provider
-	verbose string
+	verbose       string
+	context       string
+	namespace     string
+	labelSelector string
 }
 
 func (p KubernetesProvider) GetResourceConnections() map[string]map[string][]string {
@@ -53,6 +56,11 @@ func (p KubernetesProvider) GetProviderData(arg ...string) map[string]interface{
 
 func (p *KubernetesProvider) Init(args []string) error {
 	p.verbose = args[0]
+	if len(args) > 3 {
+		p.context = args[1]
+		p.namespace = args[2]
+		p.labelSelector = args[3]
+	}
 	return nil
 }
 
@@ -69,6 +77,11 @@ func (p *KubernetesProvider) InitService(serviceName string, verbose bool) error
 	p.Service.SetName(serviceName)
 	p.Service.SetVerbose(verbose)
 	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"context":        p.context,
+		"namespace":      p.namespace,
+		"label_selector": p.labelSelector,
+	})
 	return nil
 }
 
@@ -76,7 +89,7 @@ func (p *KubernetesProvider) InitService(serviceName string, verbose bool) error
 func (p *KubernetesProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
 	resources := make(map[string]terraformutils.ServiceGenerator)
 
-	config, _, err := initClientAndConfig()
+	config, _, err := initClientAndConfig(p.context)
 	if err != nil {
 		return resources
 	}
@@ -136,8 +149,9 @@ func (p *KubernetesProvider) GetSupportedService() map[string]terraformutils.Ser
 
 // InitClientAndConfig uses the KUBECONFIG environment variable to create
 // a new rest client and config object based on the existing kubectl config
-// and options passed from the plugin framework via environment variables
-func initClientAndConfig() (*restclient.Config, clientcmd.ClientConfig, error) { //nolint
+// and options passed from the plugin framework via environment variables,
+// non-empty context overrides current context of kubeconfig
+func initClientAndConfig(context string) (*restclient.Config, clientcmd.ClientConfig, error) { //nolint
 	// resolve kubeconfig location, prioritizing the --config global flag,
 	// then the value of the KUBECONFIG env var (if any), and defaulting
 	// to ~/.kube/config as a last resort.
@@ -167,7 +181,7 @@ func initClientAndConfig() (*restclient.Config, clientcmd.ClientConfig, error) {
 		return nil, nil, fmt.Errorf("error initializing config. The KUBECONFIG environment variable must be defined")
 	}
 
-	config, err := configFromPath(kubeconfig)
+	config, err := configFromPath(kubeconfig, context)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error obtaining kubectl config: %v", err)
 	}
@@ -184,7 +198,7 @@ func initClientAndConfig() (*restclient.Config, clientcmd.ClientConfig, error) {
 	return client, config, nil
 }
 
-func configFromPath(path string) (clientcmd.ClientConfig, error) {
+func configFromPath(path, context string) (clientcmd.ClientConfig, error) {
 	rules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: path}
 	credentials, err := rules.Load()
 	if err != nil {
@@ -198,7 +212,9 @@ func configFromPath(path string) (clientcmd.ClientConfig, error) {
 	}
 
 	var cfg clientcmd.ClientConfig
-	context := os.Getenv("KUBECTL_PLUGINS_GLOBAL_FLAG_CONTEXT")
+	if len(context) == 0 {
+		context = os.Getenv("KUBECTL_PLUGINS_GLOBAL_FLAG_CONTEXT")
+	}
 	if len(context) > 0 {
 		rules := clientcmd.NewDefaultClientConfigLoadingRules()
 		cfg = clientcmd.NewNonInteractiveClientConfig(*credentials, context, overrides, rules)
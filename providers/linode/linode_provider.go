@@ -44,20 +44,30 @@ func (p *LinodeProvider) GetProviderData(arg ...string) map[string]interface{} {
 }
 
 func (LinodeProvider) GetResourceConnections() map[string]map[string][]string {
-	return map[string]map[string][]string{}
+	return map[string]map[string][]string{
+		"firewall": {
+			"instance": []string{"linodes", "id"},
+		},
+		"volume": {
+			"instance": []string{"linode_id", "id"},
+		},
+	}
 }
 
 func (p *LinodeProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
 	return map[string]terraformutils.ServiceGenerator{
-		"domain":       &DomainGenerator{},
-		"image":        &ImageGenerator{},
-		"instance":     &InstanceGenerator{},
-		"nodebalancer": &NodeBalancerGenerator{},
-		"rdns":         &RDNSGenerator{},
-		"sshkey":       &SSHKeyGenerator{},
-		"stackscript":  &StackScriptGenerator{},
-		"token":        &TokenGenerator{},
-		"volume":       &VolumeGenerator{},
+		"domain":                &DomainGenerator{},
+		"firewall":              &FirewallGenerator{},
+		"image":                 &ImageGenerator{},
+		"instance":              &InstanceGenerator{},
+		"lke_cluster":           &LKEClusterGenerator{},
+		"nodebalancer":          &NodeBalancerGenerator{},
+		"object_storage_bucket": &ObjectStorageBucketGenerator{},
+		"rdns":                  &RDNSGenerator{},
+		"sshkey":                &SSHKeyGenerator{},
+		"stackscript":           &StackScriptGenerator{},
+		"token":                 &TokenGenerator{},
+		"volume":                &VolumeGenerator{},
 	}
 }
 
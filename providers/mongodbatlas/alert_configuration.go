@@ -0,0 +1,68 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlas
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+const alertNotificationSecrets = `^notification\.[0-9]+\.(api_token|datadog_api_key|flowdock_api_token|ops_genie_api_key|service_key|victor_ops_api_key)$`
+
+type AlertConfigurationGenerator struct {
+	MongoDBAtlasService
+}
+
+func (g *AlertConfigurationGenerator) InitResources() error {
+	client := g.atlasClient()
+	projects, err := g.projects(client)
+	if err != nil {
+		return err
+	}
+	for _, project := range projects {
+		var alertConfigurations []mongodbatlas.AlertConfiguration
+		err := listAll(func(options *mongodbatlas.ListOptions) (*mongodbatlas.Response, error) {
+			page, resp, err := client.AlertConfigurations.List(context.Background(), project.ID, options)
+			alertConfigurations = append(alertConfigurations, page...)
+			return resp, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, alertConfiguration := range alertConfigurations {
+			resource := terraformutils.NewResource(
+				encodeStateID(map[string]string{
+					"project_id": project.ID,
+					"id":         alertConfiguration.ID,
+				}),
+				project.Name+"_"+alertConfiguration.EventTypeName+"_"+alertConfiguration.ID,
+				"mongodbatlas_alert_configuration",
+				"mongodbatlas",
+				map[string]string{
+					"project_id":             project.ID,
+					"alert_configuration_id": alertConfiguration.ID,
+				},
+				[]string{},
+				map[string]interface{}{},
+			)
+			// integration secrets are returned redacted
+			resource.IgnoreKeys = append(resource.IgnoreKeys, alertNotificationSecrets)
+			g.Resources = append(g.Resources, resource)
+		}
+	}
+	return nil
+}
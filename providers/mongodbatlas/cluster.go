@@ -0,0 +1,63 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlas
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+type ClusterGenerator struct {
+	MongoDBAtlasService
+}
+
+func (g *ClusterGenerator) InitResources() error {
+	client := g.atlasClient()
+	projects, err := g.projects(client)
+	if err != nil {
+		return err
+	}
+	for _, project := range projects {
+		var clusters []mongodbatlas.Cluster
+		err := listAll(func(options *mongodbatlas.ListOptions) (*mongodbatlas.Response, error) {
+			page, resp, err := client.Clusters.List(context.Background(), project.ID, options)
+			clusters = append(clusters, page...)
+			return resp, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, cluster := range clusters {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				encodeStateID(map[string]string{
+					"project_id":   project.ID,
+					"cluster_name": cluster.Name,
+				}),
+				project.Name+"_"+cluster.Name,
+				"mongodbatlas_cluster",
+				"mongodbatlas",
+				map[string]string{
+					"project_id": project.ID,
+					"name":       cluster.Name,
+				},
+				[]string{},
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
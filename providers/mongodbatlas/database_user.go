@@ -0,0 +1,65 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlas
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+type DatabaseUserGenerator struct {
+	MongoDBAtlasService
+}
+
+func (g *DatabaseUserGenerator) InitResources() error {
+	client := g.atlasClient()
+	projects, err := g.projects(client)
+	if err != nil {
+		return err
+	}
+	for _, project := range projects {
+		var users []mongodbatlas.DatabaseUser
+		err := listAll(func(options *mongodbatlas.ListOptions) (*mongodbatlas.Response, error) {
+			page, resp, err := client.DatabaseUsers.List(context.Background(), project.ID, options)
+			users = append(users, page...)
+			return resp, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				encodeStateID(map[string]string{
+					"project_id":         project.ID,
+					"username":           user.Username,
+					"auth_database_name": user.DatabaseName,
+				}),
+				project.Name+"_"+user.Username,
+				"mongodbatlas_database_user",
+				"mongodbatlas",
+				map[string]string{
+					"project_id":         project.ID,
+					"username":           user.Username,
+					"auth_database_name": user.DatabaseName,
+				},
+				[]string{},
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlas
+
+import (
+	"errors"
+	"os"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type MongoDBAtlasProvider struct { //nolint
+	terraformutils.Provider
+	publicKey  string
+	privateKey string
+}
+
+func (p *MongoDBAtlasProvider) Init(args []string) error {
+	p.publicKey = os.Getenv("MONGODB_ATLAS_PUBLIC_KEY")
+	if len(args) > 0 && args[0] != "" {
+		p.publicKey = args[0]
+	}
+	p.privateKey = os.Getenv("MONGODB_ATLAS_PRIVATE_KEY")
+	if len(args) > 1 && args[1] != "" {
+		p.privateKey = args[1]
+	}
+	if p.publicKey == "" || p.privateKey == "" {
+		return errors.New("set MONGODB_ATLAS_PUBLIC_KEY and MONGODB_ATLAS_PRIVATE_KEY env vars")
+	}
+	return nil
+}
+
+func (p *MongoDBAtlasProvider) GetName() string {
+	return "mongodbatlas"
+}
+
+func (p *MongoDBAtlasProvider) GetProviderData(arg ...string) map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (p *MongoDBAtlasProvider) GetConfig() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"public_key":  cty.StringVal(p.publicKey),
+		"private_key": cty.StringVal(p.privateKey),
+	})
+}
+
+func (p *MongoDBAtlasProvider) InitService(serviceName string, verbose bool) error {
+	var isSupported bool
+	if _, isSupported = p.GetSupportedService()[serviceName]; !isSupported {
+		return errors.New(p.GetName() + ": " + serviceName + " not supported service")
+	}
+	p.Service = p.GetSupportedService()[serviceName]
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"public_key":  p.publicKey,
+		"private_key": p.privateKey,
+	})
+	return nil
+}
+
+func (p *MongoDBAtlasProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	return map[string]terraformutils.ServiceGenerator{
+		"alert_configuration": &AlertConfigurationGenerator{},
+		"cluster":             &ClusterGenerator{},
+		"database_user":       &DatabaseUserGenerator{},
+		"network_peering":     &NetworkPeeringGenerator{},
+		"project":             &ProjectGenerator{},
+	}
+}
+
+func (MongoDBAtlasProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{
+		"alert_configuration": {
+			"project": []string{"project_id", "id"},
+		},
+		"cluster": {
+			"project": []string{"project_id", "id"},
+		},
+		"database_user": {
+			"project": []string{"project_id", "id"},
+		},
+		"network_peering": {
+			"project": []string{"project_id", "id"},
+		},
+	}
+}
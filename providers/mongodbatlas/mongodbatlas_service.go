@@ -0,0 +1,79 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/mongodb-forks/digest"
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+const mongoDBAtlasPageSize = 500
+
+type MongoDBAtlasService struct { //nolint
+	terraformutils.Service
+}
+
+// atlasClient authenticates with programmatic API keys through HTTP digest authentication
+func (s *MongoDBAtlasService) atlasClient() *mongodbatlas.Client {
+	transport := digest.NewTransport(s.Args["public_key"].(string), s.Args["private_key"].(string))
+	return mongodbatlas.NewClient(&http.Client{Transport: transport})
+}
+
+// listAll calls list for each page until Atlas reports there is no next page
+func listAll(list func(options *mongodbatlas.ListOptions) (*mongodbatlas.Response, error)) error {
+	for page := 1; ; page++ {
+		resp, err := list(&mongodbatlas.ListOptions{PageNum: page, ItemsPerPage: mongoDBAtlasPageSize})
+		if err != nil {
+			return err
+		}
+		if resp == nil || resp.IsLastPage() {
+			return nil
+		}
+	}
+}
+
+func (s *MongoDBAtlasService) projects(client *mongodbatlas.Client) ([]*mongodbatlas.Project, error) {
+	var projects []*mongodbatlas.Project
+	err := listAll(func(options *mongodbatlas.ListOptions) (*mongodbatlas.Response, error) {
+		page, resp, err := client.Projects.GetAllProjects(context.Background(), options)
+		if err == nil {
+			projects = append(projects, page.Results...)
+		}
+		return resp, err
+	})
+	return projects, err
+}
+
+// encodeStateID builds resource id the way the Atlas terraform provider stores it in state
+func encodeStateID(values map[string]string) string {
+	encode := func(e string) string { return base64.StdEncoding.EncodeToString([]byte(e)) }
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	encodedValues := make([]string, 0, len(keys))
+	for _, key := range keys {
+		encodedValues = append(encodedValues, encode(key)+":"+encode(values[key]))
+	}
+	return strings.Join(encodedValues, "-")
+}
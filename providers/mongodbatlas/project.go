@@ -0,0 +1,40 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlas
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type ProjectGenerator struct {
+	MongoDBAtlasService
+}
+
+func (g *ProjectGenerator) InitResources() error {
+	projects, err := g.projects(g.atlasClient())
+	if err != nil {
+		return err
+	}
+	for _, project := range projects {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			project.ID,
+			project.Name,
+			"mongodbatlas_project",
+			"mongodbatlas",
+			[]string{},
+		))
+	}
+	return nil
+}
@@ -16,6 +16,9 @@ package newrelic
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	newrelic "github.com/paultyng/go-newrelic/v4/api"
@@ -25,6 +28,10 @@ type AlertGenerator struct {
 	NewRelicService
 }
 
+// alertChannelSecrets are config keys of alert channels carrying credentials, webhook and Slack
+// URLs embed their tokens
+var alertChannelSecrets = []string{"url", "auth_password", "api_key", "service_key"}
+
 func (g *AlertGenerator) createAlertChannelResources(client *newrelic.Client) error {
 	alertChannels, err := client.ListAlertChannels()
 	if err != nil {
@@ -68,6 +75,30 @@ func (g *AlertGenerator) createAlertConditionResources(client *newrelic.Client)
 	return nil
 }
 
+func (g *AlertGenerator) createNrqlAlertConditionResources(client *newrelic.Client) error {
+	alertPolicies, err := client.ListAlertPolicies()
+	if err != nil {
+		return err
+	}
+
+	for _, alertPolicy := range alertPolicies {
+		nrqlAlertConditions, err := client.ListAlertNrqlConditions(alertPolicy.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, nrqlAlertCondition := range nrqlAlertConditions {
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				fmt.Sprintf("%d:%d", alertPolicy.ID, nrqlAlertCondition.ID),
+				fmt.Sprintf("%s-%d", normalizeResourceName(nrqlAlertCondition.Name), nrqlAlertCondition.ID),
+				"newrelic_nrql_alert_condition",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+	return nil
+}
+
 func (g *AlertGenerator) createAlertPolicyResources(client *newrelic.Client) error {
 	alertPolicies, err := client.ListAlertPolicies()
 	if err != nil {
@@ -75,14 +106,59 @@ func (g *AlertGenerator) createAlertPolicyResources(client *newrelic.Client) err
 	}
 
 	for _, alertPolicy := range alertPolicies {
-		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+		resource := terraformutils.NewSimpleResource(
 			fmt.Sprintf("%d", alertPolicy.ID),
 			fmt.Sprintf("%s-%d", normalizeResourceName(alertPolicy.Name), alertPolicy.ID),
 			"newrelic_alert_policy",
 			g.ProviderName,
-			[]string{}))
+			[]string{})
+		// channels are subscribed by newrelic_alert_policy_channel
+		resource.IgnoreKeys = append(resource.IgnoreKeys, "^channel_ids")
+		g.Resources = append(g.Resources, resource)
+	}
+
+	return nil
+}
+
+// createAlertPolicyChannelResources creates one subscription for all channels of each policy,
+// channels list policies they are subscribed by
+func (g *AlertGenerator) createAlertPolicyChannelResources(client *newrelic.Client) error {
+	alertChannels, err := client.ListAlertChannels()
+	if err != nil {
+		return err
+	}
+	alertPolicies, err := client.ListAlertPolicies()
+	if err != nil {
+		return err
 	}
 
+	channelsByPolicy := map[int][]int{}
+	for _, channel := range alertChannels {
+		for _, policyID := range channel.Links.PolicyIDs {
+			channelsByPolicy[policyID] = append(channelsByPolicy[policyID], channel.ID)
+		}
+	}
+	for _, alertPolicy := range alertPolicies {
+		channelIDs := channelsByPolicy[alertPolicy.ID]
+		if len(channelIDs) == 0 {
+			continue
+		}
+		sort.Ints(channelIDs)
+		ids := []string{strconv.Itoa(alertPolicy.ID)}
+		for _, channelID := range channelIDs {
+			ids = append(ids, strconv.Itoa(channelID))
+		}
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			strings.Join(ids, ":"),
+			fmt.Sprintf("%s-%d-channels", normalizeResourceName(alertPolicy.Name), alertPolicy.ID),
+			"newrelic_alert_policy_channel",
+			g.ProviderName,
+			map[string]string{
+				"policy_id": strconv.Itoa(alertPolicy.ID),
+			},
+			[]string{},
+			map[string]interface{}{}))
+	}
 	return nil
 }
 
@@ -95,7 +171,9 @@ func (g *AlertGenerator) InitResources() error {
 	funcs := []func(*newrelic.Client) error{
 		g.createAlertChannelResources,
 		g.createAlertConditionResources,
+		g.createNrqlAlertConditionResources,
 		g.createAlertPolicyResources,
+		g.createAlertPolicyChannelResources,
 	}
 
 	for _, f := range funcs {
@@ -108,14 +186,128 @@ func (g *AlertGenerator) InitResources() error {
 	return nil
 }
 
+// PostConvertHook links conditions and subscriptions to policies and channels, makes NRQL queries
+// readable and replaces credentials of channels by sensitive variables. With --modernize-conditions
+// deprecated blocks of NRQL conditions are converted to their replacements
 func (g *AlertGenerator) PostConvertHook() error {
+	policies := map[string]string{}
+	channels := map[string]string{}
+	for _, resource := range g.Resources {
+		switch resource.InstanceInfo.Type {
+		case "newrelic_alert_policy":
+			policies[resource.InstanceState.ID] = resource.ResourceName
+		case "newrelic_alert_channel":
+			channels[resource.InstanceState.ID] = resource.ResourceName
+		}
+	}
+	modernize, _ := g.Args["modernize_conditions"].(bool)
+
 	for i, resource := range g.Resources {
-		if resource.InstanceInfo.Type == "newrelic_alert_condition" {
+		switch resource.InstanceInfo.Type {
+		case "newrelic_alert_channel":
+			redactAlertChannelConfig(&g.Resources[i])
+			continue
+		case "newrelic_alert_policy_channel":
+			if channelIDs, ok := resource.Item["channel_ids"].([]interface{}); ok {
+				for j, channelID := range channelIDs {
+					if name, ok := channels[fmt.Sprint(channelID)]; ok {
+						channelIDs[j] = "${newrelic_alert_channel." + name + ".id}"
+					}
+				}
+			}
+		case "newrelic_alert_condition":
 			if resource.Item["violation_close_timer"] == "0" {
 				delete(g.Resources[i].Item, "violation_close_timer")
 			}
+		case "newrelic_nrql_alert_condition":
+			nrqls, _ := resource.Item["nrql"].([]interface{})
+			for _, nrql := range nrqls {
+				nrql := nrql.(map[string]interface{})
+				if query, ok := nrql["query"].(string); ok {
+					nrql["query"] = terraformutils.Heredoc("NRQL", query)
+				}
+			}
+			if modernize {
+				modernizeNrqlAlertCondition(resource.Item)
+			}
+		default:
+			continue
+		}
+		if name, ok := policies[resource.InstanceState.Attributes["policy_id"]]; ok {
+			g.Resources[i].Item["policy_id"] = "${newrelic_alert_policy." + name + ".id}"
 		}
 	}
 
 	return nil
 }
+
+// redactAlertChannelConfig replaces credentials in config of alert channel by sensitive variables
+// declared in resource data files
+func redactAlertChannelConfig(r *terraformutils.Resource) {
+	configs, _ := r.Item["config"].([]interface{})
+	for _, config := range configs {
+		config, ok := config.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range alertChannelSecrets {
+			if value, ok := config[key].(string); !ok || value == "" {
+				continue
+			}
+			variable := strings.TrimPrefix(r.ResourceName, "tfer--") + "_" + key
+			config[key] = r.AddSensitiveVariable(variable, "Config "+key+" of alert channel "+r.InstanceState.ID)
+		}
+	}
+}
+
+// modernizeNrqlAlertCondition converts deprecated term blocks to critical and warning blocks,
+// durations in minutes to seconds, time functions to occurrences and since_value to evaluation_offset
+func modernizeNrqlAlertCondition(item map[string]interface{}) {
+	if terms, ok := item["term"].([]interface{}); ok {
+		for _, term := range terms {
+			term, ok := term.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			priority := fmt.Sprint(term["priority"])
+			delete(term, "priority")
+			if duration, ok := term["duration"]; ok {
+				if _, exist := term["threshold_duration"]; !exist {
+					if minutes, err := strconv.Atoi(fmt.Sprint(duration)); err == nil {
+						term["threshold_duration"] = minutes * 60
+					}
+				}
+				delete(term, "duration")
+			}
+			if timeFunction, ok := term["time_function"]; ok {
+				if _, exist := term["threshold_occurrences"]; !exist {
+					if fmt.Sprint(timeFunction) == "any" {
+						term["threshold_occurrences"] = "AT_LEAST_ONCE"
+					} else {
+						term["threshold_occurrences"] = "ALL"
+					}
+				}
+				delete(term, "time_function")
+			}
+			if priority == "warning" {
+				item["warning"] = []interface{}{term}
+			} else {
+				item["critical"] = []interface{}{term}
+			}
+		}
+		delete(item, "term")
+	}
+	nrqls, _ := item["nrql"].([]interface{})
+	for _, nrql := range nrqls {
+		nrql, ok := nrql.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if sinceValue, ok := nrql["since_value"]; ok {
+			if offset, err := strconv.Atoi(fmt.Sprint(sinceValue)); err == nil {
+				nrql["evaluation_offset"] = offset
+			}
+			delete(nrql, "since_value")
+		}
+	}
+}
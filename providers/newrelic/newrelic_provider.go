@@ -16,15 +16,42 @@ package newrelic
 
 import (
 	"errors"
+	"os"
+	"strconv"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 )
 
 type NewRelicProvider struct { //nolint
 	terraformutils.Provider
+	accountID           string
+	modernizeConditions bool
 }
 
+// Init reads account id, from args or NEW_RELIC_ACCOUNT_ID env var, which scopes NerdGraph
+// queries and refresh by Terraform provider
 func (p *NewRelicProvider) Init(args []string) error {
+	if len(args) > 0 && args[0] != "" {
+		p.accountID = args[0]
+	} else {
+		p.accountID = os.Getenv("NEW_RELIC_ACCOUNT_ID")
+	}
+	if p.accountID != "" {
+		if _, err := strconv.Atoi(p.accountID); err != nil {
+			return errors.New("newrelic: account id must be a number")
+		}
+		if err := os.Setenv("NEW_RELIC_ACCOUNT_ID", p.accountID); err != nil {
+			return err
+		}
+	}
+	if os.Getenv("NEW_RELIC_API_KEY") == "" && os.Getenv("NEWRELIC_API_KEY") != "" {
+		if err := os.Setenv("NEW_RELIC_API_KEY", os.Getenv("NEWRELIC_API_KEY")); err != nil {
+			return err
+		}
+	}
+	if len(args) > 1 {
+		p.modernizeConditions, _ = strconv.ParseBool(args[1])
+	}
 	return nil
 }
 
@@ -33,7 +60,17 @@ func (p *NewRelicProvider) GetName() string {
 }
 
 func (p *NewRelicProvider) GetProviderData(arg ...string) map[string]interface{} {
-	return map[string]interface{}{}
+	if p.accountID == "" {
+		return map[string]interface{}{}
+	}
+	accountID, _ := strconv.Atoi(p.accountID)
+	return map[string]interface{}{
+		"provider": map[string]interface{}{
+			"newrelic": map[string]interface{}{
+				"account_id": accountID,
+			},
+		},
+	}
 }
 
 func (NewRelicProvider) GetResourceConnections() map[string]map[string][]string {
@@ -42,10 +79,11 @@ func (NewRelicProvider) GetResourceConnections() map[string]map[string][]string
 
 func (p *NewRelicProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
 	return map[string]terraformutils.ServiceGenerator{
-		"alert":      &AlertGenerator{},
-		"dashboard":  &DashboardGenerator{},
-		"infra":      &InfraGenerator{},
-		"synthetics": &SyntheticsGenerator{},
+		"alert":         &AlertGenerator{},
+		"dashboard":     &DashboardGenerator{},
+		"infra":         &InfraGenerator{},
+		"one_dashboard": &OneDashboardGenerator{},
+		"synthetics":    &SyntheticsGenerator{},
 	}
 }
 
@@ -58,6 +96,10 @@ func (p *NewRelicProvider) InitService(serviceName string, verbose bool) error {
 	p.Service.SetName(serviceName)
 	p.Service.SetVerbose(verbose)
 	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"account_id":           p.accountID,
+		"modernize_conditions": p.modernizeConditions,
+	})
 
 	return nil
 }
@@ -15,8 +15,14 @@
 package newrelic
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	synthetics "github.com/dollarshaveclub/new-relic-synthetics-go"
@@ -66,3 +72,58 @@ func (s *NewRelicService) SyntheticsClient() (*synthetics.Client, error) {
 
 	return synthetics.NewClient(conf)
 }
+
+// nerdGraphURL returns NerdGraph endpoint of region set by NEW_RELIC_REGION, US by default
+func nerdGraphURL() string {
+	if strings.EqualFold(os.Getenv("NEW_RELIC_REGION"), "EU") {
+		return "https://api.eu.newrelic.com/graphql"
+	}
+	return "https://api.newrelic.com/graphql"
+}
+
+// nerdGraphQuery runs GraphQL query against NerdGraph API and decodes its data into result
+func (s *NewRelicService) nerdGraphQuery(query string, variables map[string]interface{}, result interface{}) error {
+	apiKey := os.Getenv("NEWRELIC_API_KEY")
+	if apiKey == "" {
+		return errors.New("No NEWRELIC_API_KEY environment set")
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", nerdGraphURL(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("API-Key", apiKey)
+	client := &http.Client{Transport: terraformutils.NewRetryTransport(http.DefaultTransport)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("newrelic: NerdGraph returned %s: %s", resp.Status, data)
+	}
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		return fmt.Errorf("newrelic: NerdGraph error: %s", response.Errors[0].Message)
+	}
+	return json.Unmarshal(response.Data, result)
+}
@@ -0,0 +1,143 @@
+// Copyright 2019 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package newrelic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+const dashboardEntitiesQuery = `query($query: String, $cursor: String) {
+  actor {
+    entitySearch(query: $query) {
+      results(cursor: $cursor) {
+        nextCursor
+        entities {
+          guid
+          name
+          ... on DashboardEntityOutline {
+            dashboardParentGuid
+          }
+        }
+      }
+    }
+  }
+}`
+
+type OneDashboardGenerator struct {
+	NewRelicService
+}
+
+type dashboardEntity struct {
+	GUID                string `json:"guid"`
+	Name                string `json:"name"`
+	DashboardParentGUID string `json:"dashboardParentGuid"`
+}
+
+// listDashboardEntities walks NerdGraph cursor pagination of dashboards, pages of dashboards are
+// entities of their own and are skipped, they are nested in their dashboards
+func (g *OneDashboardGenerator) listDashboardEntities() ([]dashboardEntity, error) {
+	query := "type = 'DASHBOARD'"
+	if accountID, _ := g.Args["account_id"].(string); accountID != "" {
+		query += " AND accountId = " + accountID
+	}
+	var entities []dashboardEntity
+	var cursor interface{}
+	for {
+		var result struct {
+			Actor struct {
+				EntitySearch struct {
+					Results struct {
+						NextCursor string            `json:"nextCursor"`
+						Entities   []dashboardEntity `json:"entities"`
+					} `json:"results"`
+				} `json:"entitySearch"`
+			} `json:"actor"`
+		}
+		err := g.nerdGraphQuery(dashboardEntitiesQuery, map[string]interface{}{
+			"query":  query,
+			"cursor": cursor,
+		}, &result)
+		if err != nil {
+			return nil, err
+		}
+		for _, entity := range result.Actor.EntitySearch.Results.Entities {
+			if entity.DashboardParentGUID == "" {
+				entities = append(entities, entity)
+			}
+		}
+		if result.Actor.EntitySearch.Results.NextCursor == "" {
+			return entities, nil
+		}
+		cursor = result.Actor.EntitySearch.Results.NextCursor
+	}
+}
+
+func (g *OneDashboardGenerator) InitResources() error {
+	entities, err := g.listDashboardEntities()
+	if err != nil {
+		return err
+	}
+	for _, entity := range entities {
+		resource := terraformutils.NewSimpleResource(
+			entity.GUID,
+			fmt.Sprintf("%s-%s", normalizeResourceName(entity.Name), entity.GUID),
+			"newrelic_one_dashboard",
+			g.ProviderName,
+			[]string{})
+		resource.SlowQueryRequired = true
+		g.Resources = append(g.Resources, resource)
+	}
+	return nil
+}
+
+// PostConvertHook makes NRQL queries and markdown of widgets readable heredocs
+func (g *OneDashboardGenerator) PostConvertHook() error {
+	for _, resource := range g.Resources {
+		pages, _ := resource.Item["page"].([]interface{})
+		for _, page := range pages {
+			page, ok := page.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for key, widgets := range page {
+				if !strings.HasPrefix(key, "widget_") {
+					continue
+				}
+				widgets, _ := widgets.([]interface{})
+				for _, widget := range widgets {
+					widget, ok := widget.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if text, ok := widget["text"].(string); ok && strings.Contains(text, "\n") {
+						widget["text"] = terraformutils.Heredoc("MARKDOWN", text)
+					}
+					queries, _ := widget["nrql_query"].([]interface{})
+					for _, query := range queries {
+						if query, ok := query.(map[string]interface{}); ok {
+							if nrql, ok := query["query"].(string); ok {
+								query["query"] = terraformutils.Heredoc("NRQL", nrql)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
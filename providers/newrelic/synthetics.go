@@ -67,6 +67,18 @@ func (g *SyntheticsGenerator) createSyntheticsMonitorResources(client *synthetic
 				"newrelic_synthetics_monitor",
 				g.ProviderName,
 				[]string{}))
+			if monitor.Type == "SCRIPT_API" || monitor.Type == "SCRIPT_BROWSER" {
+				g.Resources = append(g.Resources, terraformutils.NewResource(
+					monitor.ID,
+					fmt.Sprintf("%s-%s", normalizeResourceName(monitor.Name), monitor.ID),
+					"newrelic_synthetics_monitor_script",
+					g.ProviderName,
+					map[string]string{
+						"monitor_id": monitor.ID,
+					},
+					[]string{},
+					map[string]interface{}{}))
+			}
 		}
 
 		offset += allMonitors.Count
@@ -101,3 +113,27 @@ func (g *SyntheticsGenerator) InitResources() error {
 
 	return nil
 }
+
+// PostConvertHook emits scripts as heredoc and links scripts to monitors
+func (g *SyntheticsGenerator) PostConvertHook() error {
+	monitors := map[string]string{}
+	for _, resource := range g.Resources {
+		if resource.InstanceInfo.Type == "newrelic_synthetics_monitor" {
+			monitors[resource.InstanceState.ID] = resource.ResourceName
+		}
+	}
+
+	for i, resource := range g.Resources {
+		if resource.InstanceInfo.Type != "newrelic_synthetics_monitor_script" {
+			continue
+		}
+		if text, ok := resource.Item["text"].(string); ok {
+			g.Resources[i].Item["text"] = terraformutils.Heredoc("SCRIPT", text)
+		}
+		if name, ok := monitors[resource.InstanceState.Attributes["monitor_id"]]; ok {
+			g.Resources[i].Item["monitor_id"] = "${newrelic_synthetics_monitor." + name + ".id}"
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package okta
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// listApps returns applications using given sign on mode
+func (s *OktaService) listApps(signOnMode string) ([]*okta.Application, error) {
+	ctx, client, err := s.oktaClient()
+	if err != nil {
+		return nil, err
+	}
+	apps, resp, err := client.Application.ListApplications(ctx, query.NewQueryParams(query.WithLimit(200)))
+	if err != nil {
+		return nil, err
+	}
+	var filtered []*okta.Application
+	for _, app := range apps {
+		if app, ok := app.(*okta.Application); ok && app.SignOnMode == signOnMode {
+			filtered = append(filtered, app)
+		}
+	}
+	for resp.HasNextPage() {
+		var page []okta.Application
+		if resp, err = resp.Next(ctx, &page); err != nil {
+			return nil, err
+		}
+		for i := range page {
+			if page[i].SignOnMode == signOnMode {
+				filtered = append(filtered, &page[i])
+			}
+		}
+	}
+	return filtered, nil
+}
+
+type AppOAuthGenerator struct {
+	OktaService
+}
+
+func (g *AppOAuthGenerator) InitResources() error {
+	apps, err := g.listApps("OPENID_CONNECT")
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			app.Id,
+			app.Label+"_"+app.Id,
+			"okta_app_oauth",
+			"okta",
+			[]string{},
+		))
+	}
+	return nil
+}
+
+type AppSamlGenerator struct {
+	OktaService
+}
+
+func (g *AppSamlGenerator) InitResources() error {
+	apps, err := g.listApps("SAML_2_0")
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			app.Id,
+			app.Label+"_"+app.Id,
+			"okta_app_saml",
+			"okta",
+			[]string{},
+		))
+	}
+	return nil
+}
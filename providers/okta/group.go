@@ -0,0 +1,86 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package okta
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+type GroupGenerator struct {
+	OktaService
+}
+
+func (g *GroupGenerator) InitResources() error {
+	ctx, client, err := g.oktaClient()
+	if err != nil {
+		return err
+	}
+	// built-in Everyone group and app groups are managed by Okta
+	groups, resp, err := client.Group.ListGroups(ctx, query.NewQueryParams(query.WithFilter(`type eq "OKTA_GROUP"`), query.WithLimit(200)))
+	for err == nil && resp.HasNextPage() {
+		var page []*okta.Group
+		resp, err = resp.Next(ctx, &page)
+		groups = append(groups, page...)
+	}
+	if err != nil {
+		return err
+	}
+	for _, group := range groups {
+		name := group.Id
+		if group.Profile != nil {
+			name = group.Profile.Name
+		}
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			group.Id,
+			name,
+			"okta_group",
+			"okta",
+			[]string{},
+		))
+	}
+	return nil
+}
+
+type GroupRuleGenerator struct {
+	OktaService
+}
+
+func (g *GroupRuleGenerator) InitResources() error {
+	ctx, client, err := g.oktaClient()
+	if err != nil {
+		return err
+	}
+	rules, resp, err := client.Group.ListGroupRules(ctx, query.NewQueryParams(query.WithLimit(200)))
+	for err == nil && resp.HasNextPage() {
+		var page []*okta.GroupRule
+		resp, err = resp.Next(ctx, &page)
+		rules = append(rules, page...)
+	}
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			rule.Id,
+			rule.Name,
+			"okta_group_rule",
+			"okta",
+			[]string{},
+		))
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package okta
+
+import (
+	"errors"
+	"os"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const defaultOktaBaseURL = "okta.com"
+
+type OktaProvider struct { //nolint
+	terraformutils.Provider
+	orgName  string
+	baseURL  string
+	apiToken string
+}
+
+func (p *OktaProvider) Init(args []string) error {
+	p.orgName = os.Getenv("OKTA_ORG_NAME")
+	if len(args) > 0 && args[0] != "" {
+		p.orgName = args[0]
+	}
+	if p.orgName == "" {
+		return errors.New("set OKTA_ORG_NAME env var")
+	}
+
+	p.baseURL = os.Getenv("OKTA_BASE_URL")
+	if len(args) > 1 && args[1] != "" {
+		p.baseURL = args[1]
+	}
+	if p.baseURL == "" {
+		p.baseURL = defaultOktaBaseURL
+	}
+
+	p.apiToken = os.Getenv("OKTA_API_TOKEN")
+	if len(args) > 2 && args[2] != "" {
+		p.apiToken = args[2]
+	}
+	if p.apiToken == "" {
+		return errors.New("set OKTA_API_TOKEN env var")
+	}
+	return nil
+}
+
+func (p *OktaProvider) GetName() string {
+	return "okta"
+}
+
+func (p *OktaProvider) GetProviderData(arg ...string) map[string]interface{} {
+	return map[string]interface{}{
+		"provider": map[string]interface{}{
+			"okta": map[string]interface{}{
+				"org_name": p.orgName,
+				"base_url": p.baseURL,
+			},
+		},
+	}
+}
+
+func (p *OktaProvider) GetConfig() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"org_name":  cty.StringVal(p.orgName),
+		"base_url":  cty.StringVal(p.baseURL),
+		"api_token": cty.StringVal(p.apiToken),
+	})
+}
+
+func (p *OktaProvider) InitService(serviceName string, verbose bool) error {
+	var isSupported bool
+	if _, isSupported = p.GetSupportedService()[serviceName]; !isSupported {
+		return errors.New(p.GetName() + ": " + serviceName + " not supported service")
+	}
+	p.Service = p.GetSupportedService()[serviceName]
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"org_name":  p.orgName,
+		"base_url":  p.baseURL,
+		"api_token": p.apiToken,
+	})
+	return nil
+}
+
+func (p *OktaProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	return map[string]terraformutils.ServiceGenerator{
+		"app_oauth":            &AppOAuthGenerator{},
+		"app_saml":             &AppSamlGenerator{},
+		"group":                &GroupGenerator{},
+		"group_rule":           &GroupRuleGenerator{},
+		"policy_rule_password": &PolicyRulePasswordGenerator{},
+		"user_schema_property": &UserSchemaPropertyGenerator{},
+	}
+}
+
+func (OktaProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{
+		"group_rule": {
+			"group": []string{"group_assignments", "id"},
+		},
+		"policy_rule_password": {
+			"group": []string{"groups_included", "id"},
+		},
+	}
+}
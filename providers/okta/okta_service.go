@@ -0,0 +1,94 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type OktaService struct { //nolint
+	terraformutils.Service
+	ctx    context.Context
+	client *okta.Client
+}
+
+// oktaClient returns SDK client of org, it retries requests hitting rate limits
+func (s *OktaService) oktaClient() (context.Context, *okta.Client, error) {
+	if s.client != nil {
+		return s.ctx, s.client, nil
+	}
+	ctx, client, err := okta.NewClient(
+		context.Background(),
+		okta.WithOrgUrl(fmt.Sprintf("https://%s.%s", s.Args["org_name"], s.Args["base_url"])),
+		okta.WithToken(s.Args["api_token"].(string)),
+		okta.WithCache(false),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.ctx, s.client = ctx, client
+	return ctx, client, nil
+}
+
+// listAll decodes every page of API listing into items, it's used for listings SDK models lack fields of
+func (s *OktaService) listAll(uri string, items interface{}) error {
+	ctx, client, err := s.oktaClient()
+	if err != nil {
+		return err
+	}
+	executor := client.GetRequestExecutor()
+	req, err := executor.WithAccept("application/json").NewRequest("GET", "/api/v1/"+uri, nil)
+	if err != nil {
+		return err
+	}
+	var all, page []json.RawMessage
+	resp, err := executor.Do(ctx, req, &page)
+	for {
+		if err != nil {
+			return err
+		}
+		all = append(all, page...)
+		if !resp.HasNextPage() {
+			break
+		}
+		page = nil
+		resp, err = resp.Next(ctx, &page)
+	}
+	data, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, items)
+}
+
+// get decodes a single API object
+func (s *OktaService) get(uri string, item interface{}) error {
+	ctx, client, err := s.oktaClient()
+	if err != nil {
+		return err
+	}
+	executor := client.GetRequestExecutor()
+	req, err := executor.WithAccept("application/json").NewRequest("GET", "/api/v1/"+uri, nil)
+	if err != nil {
+		return err
+	}
+	_, err = executor.Do(ctx, req, item)
+	return err
+}
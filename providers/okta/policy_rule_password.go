@@ -0,0 +1,64 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package okta
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+type PolicyRulePasswordGenerator struct {
+	OktaService
+}
+
+func (g *PolicyRulePasswordGenerator) InitResources() error {
+	ctx, client, err := g.oktaClient()
+	if err != nil {
+		return err
+	}
+	policies, _, err := client.Policy.ListPolicies(ctx, query.NewQueryParams(query.WithType("PASSWORD")))
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		// SDK policy rule model has no name
+		var rules []struct {
+			ID     string `json:"id"`
+			Name   string `json:"name"`
+			System bool   `json:"system"`
+		}
+		if err := g.listAll("policies/"+policy.Id+"/rules", &rules); err != nil {
+			return err
+		}
+		for _, rule := range rules {
+			if rule.System {
+				continue // default rules can't be changed
+			}
+			// state keeps rule id, policy is read from policyid
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				rule.ID,
+				policy.Name+"_"+rule.Name,
+				"okta_policy_rule_password",
+				"okta",
+				map[string]string{
+					"policyid": policy.Id,
+				},
+				[]string{},
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package okta
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type UserSchemaPropertyGenerator struct {
+	OktaService
+}
+
+// InitResources imports custom properties only, base ones are defined by Okta
+func (g *UserSchemaPropertyGenerator) InitResources() error {
+	var schema struct {
+		Definitions struct {
+			Custom struct {
+				Properties map[string]interface{} `json:"properties"`
+			} `json:"custom"`
+		} `json:"definitions"`
+	}
+	if err := g.get("meta/schemas/user/default", &schema); err != nil {
+		return err
+	}
+	for index := range schema.Definitions.Custom.Properties {
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			index,
+			index,
+			"okta_user_schema_property",
+			"okta",
+			map[string]string{
+				"index": index,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
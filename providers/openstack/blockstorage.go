@@ -97,21 +97,12 @@ func newBlockStorageClent(provider *gophercloud.ProviderClient, eo gophercloud.E
 
 // Generate TerraformResources from OpenStack API,
 func (g *BlockStorageGenerator) InitResources() error {
-	opts, err := openstack.AuthOptionsFromEnv()
+	provider, err := g.authenticatedClient()
 	if err != nil {
 		return err
 	}
 
-	provider, err := openstack.AuthenticatedClient(opts)
-	if err != nil {
-		return err
-	}
-
-	eo := gophercloud.EndpointOpts{
-		Region: g.GetArgs()["region"].(string),
-	}
-
-	client, err := newBlockStorageClent(provider, eo)
+	client, err := newBlockStorageClent(provider, g.endpointOpts())
 	if err != nil {
 		return err
 	}
@@ -126,26 +126,18 @@ func (g *ComputeGenerator) createResources(list *pagination.Pager, volclient *go
 
 // Generate TerraformResources from OpenStack API,
 func (g *ComputeGenerator) InitResources() error {
-	opts, err := openstack.AuthOptionsFromEnv()
+	provider, err := g.authenticatedClient()
 	if err != nil {
 		return err
 	}
 
-	provider, err := openstack.AuthenticatedClient(opts)
-	if err != nil {
-		return err
-	}
-
-	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
-		Region: g.GetArgs()["region"].(string),
-	})
+	client, err := openstack.NewComputeV2(provider, g.endpointOpts())
 	if err != nil {
 		return err
 	}
 
 	list := servers.List(client, nil)
-	volclient, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{
-		Region: g.GetArgs()["region"].(string)})
+	volclient, err := openstack.NewBlockStorageV3(provider, g.endpointOpts())
 	if err != nil {
 		log.Println("VolumeImageMetadata requires blockStorage API v3")
 		volclient = nil
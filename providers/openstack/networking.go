@@ -20,8 +20,13 @@ import (
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
@@ -29,6 +34,14 @@ type NetworkingGenerator struct {
 	OpenStackService
 }
 
+// resourceName returns name of OpenStack object, ID is used for objects without name
+func resourceName(name, id string) string {
+	if name == "" {
+		return id
+	}
+	return name
+}
+
 // createResources iterate on all openstack_networking_secgroup_v2
 func (g *NetworkingGenerator) createSecgroupResources(list *pagination.Pager) []terraformutils.Resource {
 	resources := []terraformutils.Resource{}
@@ -75,44 +88,214 @@ func (g *NetworkingGenerator) createSecgroupRuleResources(rules []rules.SecGroup
 	return resources
 }
 
-// Generate TerraformResources from OpenStack API,
-func (g *NetworkingGenerator) InitResources() error {
-	opts, err := openstack.AuthOptionsFromEnv()
+// createNetworkResources iterate on all openstack_networking_network_v2 and openstack_networking_subnet_v2
+// of project, shared and external networks of other projects aren't imported
+func (g *NetworkingGenerator) createNetworkResources(client *gophercloud.ServiceClient, projectID string) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	err := networks.List(client, networks.ListOpts{ProjectID: projectID}).EachPage(func(page pagination.Page) (bool, error) {
+		networks, err := networks.ExtractNetworks(page)
+		if err != nil {
+			return false, err
+		}
+		for _, network := range networks {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				network.ID,
+				resourceName(network.Name, network.ID),
+				"openstack_networking_network_v2",
+				"openstack",
+				[]string{},
+			))
+		}
+		return true, nil
+	})
 	if err != nil {
-		return err
+		log.Println(err)
 	}
 
-	provider, err := openstack.AuthenticatedClient(opts)
+	err = subnets.List(client, subnets.ListOpts{ProjectID: projectID}).EachPage(func(page pagination.Page) (bool, error) {
+		subnets, err := subnets.ExtractSubnets(page)
+		if err != nil {
+			return false, err
+		}
+		for _, subnet := range subnets {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				subnet.ID,
+				resourceName(subnet.Name, subnet.ID),
+				"openstack_networking_subnet_v2",
+				"openstack",
+				[]string{},
+			))
+		}
+		return true, nil
+	})
 	if err != nil {
-		return err
+		log.Println(err)
+	}
+	return resources
+}
+
+// createRouterResources iterate on all openstack_networking_router_v2 and their
+// openstack_networking_router_interface_v2, interfaces are router ports
+func (g *NetworkingGenerator) createRouterResources(client *gophercloud.ServiceClient, projectID string) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	routerNames := map[string]string{}
+	err := routers.List(client, routers.ListOpts{ProjectID: projectID}).EachPage(func(page pagination.Page) (bool, error) {
+		routers, err := routers.ExtractRouters(page)
+		if err != nil {
+			return false, err
+		}
+		for _, router := range routers {
+			routerNames[router.ID] = resourceName(router.Name, router.ID)
+			resources = append(resources, terraformutils.NewSimpleResource(
+				router.ID,
+				routerNames[router.ID],
+				"openstack_networking_router_v2",
+				"openstack",
+				[]string{},
+			))
+		}
+		return true, nil
+	})
+	if err != nil {
+		log.Println(err)
+	}
+
+	err = ports.List(client, ports.ListOpts{
+		ProjectID:   projectID,
+		DeviceOwner: "network:router_interface",
+	}).EachPage(func(page pagination.Page) (bool, error) {
+		ports, err := ports.ExtractPorts(page)
+		if err != nil {
+			return false, err
+		}
+		for _, port := range ports {
+			routerName, ok := routerNames[port.DeviceID]
+			if !ok {
+				continue
+			}
+			resources = append(resources, terraformutils.NewResource(
+				port.ID,
+				routerName+"_"+port.ID,
+				"openstack_networking_router_interface_v2",
+				"openstack",
+				map[string]string{
+					"router_id": port.DeviceID,
+				},
+				[]string{},
+				map[string]interface{}{},
+			))
+		}
+		return true, nil
+	})
+	if err != nil {
+		log.Println(err)
 	}
+	return resources
+}
 
-	client, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{
-		Region: g.GetArgs()["region"].(string),
+// createFloatingIPResources iterate on all openstack_networking_floatingip_v2, associations of floating IPs
+// with ports are generated as openstack_networking_floatingip_associate_v2
+func (g *NetworkingGenerator) createFloatingIPResources(client *gophercloud.ServiceClient, projectID string) []terraformutils.Resource {
+	resources := []terraformutils.Resource{}
+	err := floatingips.List(client, floatingips.ListOpts{ProjectID: projectID}).EachPage(func(page pagination.Page) (bool, error) {
+		floatingIPs, err := floatingips.ExtractFloatingIPs(page)
+		if err != nil {
+			return false, err
+		}
+		for _, floatingIP := range floatingIPs {
+			resource := terraformutils.NewSimpleResource(
+				floatingIP.ID,
+				floatingIP.FloatingIP,
+				"openstack_networking_floatingip_v2",
+				"openstack",
+				[]string{},
+			)
+			if floatingIP.PortID != "" {
+				// association is managed by its own resource
+				resource.IgnoreKeys = append(resource.IgnoreKeys, "^port_id$", "^fixed_ip$")
+				resources = append(resources, terraformutils.NewResource(
+					floatingIP.ID,
+					floatingIP.FloatingIP,
+					"openstack_networking_floatingip_associate_v2",
+					"openstack",
+					map[string]string{
+						"floating_ip": floatingIP.FloatingIP,
+						"port_id":     floatingIP.PortID,
+					},
+					[]string{},
+					map[string]interface{}{},
+				))
+			}
+			resources = append(resources, resource)
+		}
+		return true, nil
 	})
+	if err != nil {
+		log.Println(err)
+	}
+	return resources
+}
+
+// Generate TerraformResources from OpenStack API,
+func (g *NetworkingGenerator) InitResources() error {
+	provider, err := g.authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	client, err := openstack.NewNetworkV2(provider, g.endpointOpts())
 	if err != nil {
 		return err
 	}
+	projectID := g.projectID(provider)
 
-	list := groups.List(client, groups.ListOpts{})
+	list := groups.List(client, groups.ListOpts{ProjectID: projectID})
 
 	g.Resources = g.createSecgroupResources(&list)
+	g.Resources = append(g.Resources, g.createNetworkResources(client, projectID)...)
+	g.Resources = append(g.Resources, g.createRouterResources(client, projectID)...)
+	g.Resources = append(g.Resources, g.createFloatingIPResources(client, projectID)...)
 
 	return nil
 }
 
+// PostConvertHook links secgroup rules, subnets, router interfaces and floating IP associations
+// to resources they belong to
 func (g *NetworkingGenerator) PostConvertHook() error {
-	for i, r := range g.Resources {
-		if r.InstanceInfo.Type != "openstack_networking_secgroup_rule_v2" {
-			continue
+	resourceNames := map[string]map[string]string{}
+	for _, r := range g.Resources {
+		if resourceNames[r.InstanceInfo.Type] == nil {
+			resourceNames[r.InstanceInfo.Type] = map[string]string{}
 		}
-		for _, sg := range g.Resources {
-			if sg.InstanceInfo.Type != "openstack_networking_secgroup_v2" {
-				continue
-			}
-			if r.InstanceState.Attributes["security_group_id"] == sg.InstanceState.Attributes["id"] {
-				g.Resources[i].Item["security_group_id"] = "${openstack_networking_secgroup_v2." + sg.ResourceName + ".id}"
+		resourceNames[r.InstanceInfo.Type][r.InstanceState.ID] = r.ResourceName
+	}
+	link := func(i int, attribute, resourceType, id, referenceAttribute string) {
+		if name, ok := resourceNames[resourceType][id]; ok {
+			g.Resources[i].Item[attribute] = "${" + resourceType + "." + name + "." + referenceAttribute + "}"
+		}
+	}
+	floatingIPs := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "openstack_networking_floatingip_v2" {
+			floatingIPs[r.InstanceState.Attributes["address"]] = r.InstanceState.ID
+		}
+	}
+	for i, r := range g.Resources {
+		attributes := r.InstanceState.Attributes
+		switch r.InstanceInfo.Type {
+		case "openstack_networking_secgroup_rule_v2":
+			link(i, "security_group_id", "openstack_networking_secgroup_v2", attributes["security_group_id"], "id")
+		case "openstack_networking_subnet_v2":
+			link(i, "network_id", "openstack_networking_network_v2", attributes["network_id"], "id")
+		case "openstack_networking_router_interface_v2":
+			link(i, "router_id", "openstack_networking_router_v2", attributes["router_id"], "id")
+			if attributes["subnet_id"] != "" {
+				// port is created by router for subnet
+				delete(g.Resources[i].Item, "port_id")
+				link(i, "subnet_id", "openstack_networking_subnet_v2", attributes["subnet_id"], "id")
 			}
+		case "openstack_networking_floatingip_associate_v2":
+			link(i, "floating_ip", "openstack_networking_floatingip_v2", floatingIPs[attributes["floating_ip"]], "address")
 		}
 	}
 
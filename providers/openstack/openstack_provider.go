@@ -23,19 +23,29 @@ import (
 
 type OpenStackProvider struct { //nolint
 	terraformutils.Provider
-	region string
+	region  string
+	project string
 }
 
 func (p OpenStackProvider) GetResourceConnections() map[string]map[string][]string {
-	return map[string]map[string][]string{}
+	return map[string]map[string][]string{
+		"compute": {
+			"networking":   []string{"network.uuid", "id", "security_groups", "name"},
+			"blockstorage": []string{"volume_id", "id"},
+		},
+	}
 }
 
 func (p OpenStackProvider) GetProviderData(arg ...string) map[string]interface{} {
+	config := map[string]interface{}{
+		"region": p.region,
+	}
+	if p.project != "" {
+		config["tenant_id"] = p.project
+	}
 	return map[string]interface{}{
 		"provider": map[string]interface{}{
-			"openstack": map[string]interface{}{
-				"region": p.region,
-			},
+			"openstack": config,
 		},
 	}
 }
@@ -48,6 +58,13 @@ func (p *OpenStackProvider) Init(args []string) error {
 	if err != nil {
 		return err
 	}
+	if len(args) > 1 && args[1] != "" {
+		p.project = args[1]
+		// project ID takes precedence over project name of env and clouds.yaml in terraform
+		if err := os.Setenv("OS_PROJECT_ID", p.project); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -65,7 +82,8 @@ func (p *OpenStackProvider) InitService(serviceName string, verbose bool) error
 	p.Service.SetVerbose(verbose)
 	p.Service.SetProviderName(p.GetName())
 	p.Service.SetArgs(map[string]interface{}{
-		"region": p.region,
+		"region":  p.region,
+		"project": p.project,
 	})
 	return nil
 }
@@ -14,8 +14,150 @@
 
 package openstack
 
-import "github.com/GoogleCloudPlatform/terraformer/terraformutils"
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"gopkg.in/yaml.v2"
+)
 
 type OpenStackService struct { //nolint
 	terraformutils.Service
 }
+
+// cloudConfig is cloud entry of clouds.yaml, only keys needed for authentication are read
+type cloudConfig struct {
+	Auth struct {
+		AuthURL                     string `yaml:"auth_url"`
+		Username                    string `yaml:"username"`
+		UserID                      string `yaml:"user_id"`
+		Password                    string `yaml:"password"`
+		ProjectID                   string `yaml:"project_id"`
+		ProjectName                 string `yaml:"project_name"`
+		DomainID                    string `yaml:"domain_id"`
+		DomainName                  string `yaml:"domain_name"`
+		UserDomainID                string `yaml:"user_domain_id"`
+		UserDomainName              string `yaml:"user_domain_name"`
+		ProjectDomainID             string `yaml:"project_domain_id"`
+		ProjectDomainName           string `yaml:"project_domain_name"`
+		ApplicationCredentialID     string `yaml:"application_credential_id"`
+		ApplicationCredentialName   string `yaml:"application_credential_name"`
+		ApplicationCredentialSecret string `yaml:"application_credential_secret"`
+	} `yaml:"auth"`
+}
+
+// cloudsFiles returns clouds.yaml locations in order OpenStack clients look them up
+func cloudsFiles() []string {
+	if file := os.Getenv("OS_CLIENT_CONFIG_FILE"); file != "" {
+		return []string{file}
+	}
+	files := []string{"clouds.yaml"}
+	if home, err := os.UserHomeDir(); err == nil {
+		files = append(files, filepath.Join(home, ".config", "openstack", "clouds.yaml"))
+	}
+	return append(files, "/etc/openstack/clouds.yaml")
+}
+
+// cloudAuthOptions reads authentication of cloud from first clouds.yaml defining it
+func cloudAuthOptions(cloud string) (gophercloud.AuthOptions, error) {
+	for _, file := range cloudsFiles() {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		var clouds struct {
+			Clouds map[string]cloudConfig `yaml:"clouds"`
+		}
+		if err := yaml.Unmarshal(data, &clouds); err != nil {
+			return gophercloud.AuthOptions{}, err
+		}
+		config, ok := clouds.Clouds[cloud]
+		if !ok {
+			continue
+		}
+		auth := config.Auth
+		opts := gophercloud.AuthOptions{
+			IdentityEndpoint:            auth.AuthURL,
+			Username:                    auth.Username,
+			UserID:                      auth.UserID,
+			Password:                    auth.Password,
+			TenantID:                    auth.ProjectID,
+			TenantName:                  auth.ProjectName,
+			DomainID:                    firstNonEmpty(auth.UserDomainID, auth.DomainID),
+			DomainName:                  firstNonEmpty(auth.UserDomainName, auth.DomainName),
+			ApplicationCredentialID:     auth.ApplicationCredentialID,
+			ApplicationCredentialName:   auth.ApplicationCredentialName,
+			ApplicationCredentialSecret: auth.ApplicationCredentialSecret,
+		}
+		if auth.ProjectID == "" && auth.ProjectName != "" {
+			opts.Scope = &gophercloud.AuthScope{
+				ProjectName: auth.ProjectName,
+				DomainID:    firstNonEmpty(auth.ProjectDomainID, auth.DomainID),
+				DomainName:  firstNonEmpty(auth.ProjectDomainName, auth.DomainName),
+			}
+		}
+		return opts, nil
+	}
+	return gophercloud.AuthOptions{}, errors.New("openstack: cloud " + cloud + " not found in clouds.yaml")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// authenticatedClient authenticates with cloud of clouds.yaml named by OS_CLOUD or with OS_* environment
+// variables, token is scoped to project of service when it's set
+func (s *OpenStackService) authenticatedClient() (*gophercloud.ProviderClient, error) {
+	var opts gophercloud.AuthOptions
+	var err error
+	if cloud := os.Getenv("OS_CLOUD"); cloud != "" {
+		opts, err = cloudAuthOptions(cloud)
+	} else {
+		opts, err = openstack.AuthOptionsFromEnv()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if project := s.project(); project != "" {
+		opts.TenantID = project
+		opts.TenantName = ""
+		opts.Scope = nil
+	}
+	return openstack.AuthenticatedClient(opts)
+}
+
+func (s *OpenStackService) endpointOpts() gophercloud.EndpointOpts {
+	return gophercloud.EndpointOpts{
+		Region: s.GetArgs()["region"].(string),
+	}
+}
+
+func (s *OpenStackService) project() string {
+	project, _ := s.GetArgs()["project"].(string)
+	return project
+}
+
+// projectID returns ID of project resources are imported from, token project is used when project isn't set,
+// empty ID lists resources of all projects visible to user
+func (s *OpenStackService) projectID(provider *gophercloud.ProviderClient) string {
+	if project := s.project(); project != "" {
+		return project
+	}
+	if result, ok := provider.GetAuthResult().(tokens.CreateResult); ok {
+		if project, err := result.ExtractProject(); err == nil && project != nil {
+			return project.ID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagerduty
+
+import (
+	"encoding/json"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type EscalationPolicyGenerator struct {
+	PagerDutyService
+}
+
+type EscalationPolicy struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+var EscalationPolicyAllowEmptyValues = []string{}
+
+func (g *EscalationPolicyGenerator) createResources(items []EscalationPolicy) []terraformutils.Resource {
+	var resources []terraformutils.Resource
+	for _, item := range items {
+		resources = append(resources, terraformutils.NewSimpleResource(
+			item.ID,
+			item.Name,
+			"pagerduty_escalation_policy",
+			"pagerduty",
+			EscalationPolicyAllowEmptyValues,
+		))
+	}
+	return resources
+}
+
+func (g *EscalationPolicyGenerator) InitResources() error {
+	rawItems, err := g.listAll("/escalation_policies", "escalation_policies")
+	if err != nil {
+		return err
+	}
+	var items []EscalationPolicy
+	for _, rawItem := range rawItems {
+		var item EscalationPolicy
+		if err := json.Unmarshal(rawItem, &item); err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	g.Resources = g.createResources(items)
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+const (
+	pagerDutyAPIURL   = "https://api.pagerduty.com"
+	pagerDutyPageSize = 100
+)
+
+type PagerDutyService struct { //nolint
+	terraformutils.Service
+}
+
+func (s *PagerDutyService) generateRequest(uri string) ([]byte, error) {
+	client := &http.Client{}
+	req, err := http.NewRequest("GET", pagerDutyAPIURL+uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.pagerduty+json;version=2")
+	req.Header.Set("Authorization", "Token token="+s.Args["token"].(string))
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pagerduty: %s returned %s: %s", uri, resp.Status, body)
+	}
+	return body, nil
+}
+
+// listAll walks PagerDuty offset pagination and returns raw items stored under key
+func (s *PagerDutyService) listAll(uri, key string) ([]json.RawMessage, error) {
+	separator := "?"
+	if strings.Contains(uri, "?") {
+		separator = "&"
+	}
+	var items []json.RawMessage
+	offset := 0
+	for {
+		body, err := s.generateRequest(fmt.Sprintf("%s%slimit=%d&offset=%d", uri, separator, pagerDutyPageSize, offset))
+		if err != nil {
+			return nil, err
+		}
+		var page map[string]json.RawMessage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+		var pageItems []json.RawMessage
+		if err := json.Unmarshal(page[key], &pageItems); err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+		var more bool
+		if raw, ok := page["more"]; ok {
+			if err := json.Unmarshal(raw, &more); err != nil {
+				return nil, err
+			}
+		}
+		if !more || len(pageItems) == 0 {
+			break
+		}
+		offset += len(pageItems)
+	}
+	return items, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagerduty
+
+import (
+	"encoding/json"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type RulesetGenerator struct {
+	PagerDutyService
+}
+
+type Ruleset struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+var RulesetAllowEmptyValues = []string{}
+
+func (g *RulesetGenerator) createResources(rulesets []Ruleset) []terraformutils.Resource {
+	var resources []terraformutils.Resource
+	for _, ruleset := range rulesets {
+		if ruleset.Type == "default_global" {
+			continue // the default global ruleset can't be managed by terraform
+		}
+		resources = append(resources, terraformutils.NewSimpleResource(
+			ruleset.ID,
+			ruleset.Name,
+			"pagerduty_ruleset",
+			"pagerduty",
+			RulesetAllowEmptyValues,
+		))
+	}
+	return resources
+}
+
+func (g *RulesetGenerator) InitResources() error {
+	items, err := g.listAll("/rulesets", "rulesets")
+	if err != nil {
+		return err
+	}
+	var rulesets []Ruleset
+	for _, item := range items {
+		var ruleset Ruleset
+		if err := json.Unmarshal(item, &ruleset); err != nil {
+			return err
+		}
+		rulesets = append(rulesets, ruleset)
+	}
+	g.Resources = g.createResources(rulesets)
+	return nil
+}
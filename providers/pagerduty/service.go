@@ -0,0 +1,112 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagerduty
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type ServiceGenerator struct {
+	PagerDutyService
+}
+
+type Service struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	Integrations []Integration `json:"integrations"`
+}
+
+type Integration struct {
+	ID      string `json:"id"`
+	Summary string `json:"summary"`
+}
+
+var ServiceAllowEmptyValues = []string{}
+
+func (g *ServiceGenerator) createResources(services []Service) []terraformutils.Resource {
+	var resources []terraformutils.Resource
+	for _, service := range services {
+		resources = append(resources, terraformutils.NewSimpleResource(
+			service.ID,
+			service.Name,
+			"pagerduty_service",
+			"pagerduty",
+			ServiceAllowEmptyValues,
+		))
+		for _, integration := range service.Integrations {
+			resources = append(resources, terraformutils.NewResource(
+				integration.ID,
+				service.Name+"_"+integration.ID,
+				"pagerduty_service_integration",
+				"pagerduty",
+				map[string]string{
+					"service": service.ID,
+				},
+				ServiceAllowEmptyValues,
+				map[string]interface{}{},
+			))
+		}
+	}
+	return resources
+}
+
+func (g *ServiceGenerator) InitResources() error {
+	items, err := g.listAll("/services", "services")
+	if err != nil {
+		return err
+	}
+	var services []Service
+	for _, item := range items {
+		var service Service
+		if err := json.Unmarshal(item, &service); err != nil {
+			return err
+		}
+		services = append(services, service)
+	}
+	g.Resources = g.createResources(services)
+	return nil
+}
+
+// PostConvertHook links integrations to services imported in the same run and replaces
+// integration keys by sensitive variables, anyone knowing a key can trigger incidents
+func (g *ServiceGenerator) PostConvertHook() error {
+	for i, integration := range g.Resources {
+		if integration.InstanceInfo.Type != "pagerduty_service_integration" {
+			continue
+		}
+		if _, ok := integration.Item["integration_key"]; ok {
+			g.Resources[i].Item["integration_key"] = integrationKeyVariable(&g.Resources[i])
+		}
+		for _, service := range g.Resources {
+			if service.InstanceInfo.Type != "pagerduty_service" {
+				continue
+			}
+			if service.InstanceState.ID == integration.InstanceState.Attributes["service"] {
+				g.Resources[i].Item["service"] = "${pagerduty_service." + service.ResourceName + ".id}"
+			}
+		}
+	}
+	return nil
+}
+
+// integrationKeyVariable declares sensitive variable for integration key in resource data files
+// and returns reference to it
+func integrationKeyVariable(r *terraformutils.Resource) string {
+	variable := strings.TrimPrefix(r.ResourceName, "tfer--") + "_integration_key"
+	return r.AddSensitiveVariable(variable, "Integration key of service integration "+r.InstanceState.ID)
+}
@@ -0,0 +1,132 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagerduty
+
+import (
+	"encoding/json"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type UserGenerator struct {
+	PagerDutyService
+}
+
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ContactMethod struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Label string `json:"label"`
+}
+
+var UserAllowEmptyValues = []string{}
+
+func (g *UserGenerator) createResources(items []User) []terraformutils.Resource {
+	var resources []terraformutils.Resource
+	for _, item := range items {
+		resources = append(resources, terraformutils.NewSimpleResource(
+			item.ID,
+			item.Name,
+			"pagerduty_user",
+			"pagerduty",
+			UserAllowEmptyValues,
+		))
+	}
+	return resources
+}
+
+func (g *UserGenerator) InitResources() error {
+	rawItems, err := g.listAll("/users", "users")
+	if err != nil {
+		return err
+	}
+	var items []User
+	for _, rawItem := range rawItems {
+		var item User
+		if err := json.Unmarshal(rawItem, &item); err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	g.Resources = g.createResources(items)
+	if includeContactMethods, _ := g.Args["contact_methods"].(bool); includeContactMethods {
+		for _, item := range items {
+			contactMethods, err := g.listContactMethods(item.ID)
+			if err != nil {
+				return err
+			}
+			g.Resources = append(g.Resources, g.createContactMethodResources(item, contactMethods)...)
+		}
+	}
+	return nil
+}
+
+// listContactMethods returns contact methods of user, the endpoint isn't paginated
+func (g *UserGenerator) listContactMethods(userID string) ([]ContactMethod, error) {
+	body, err := g.generateRequest("/users/" + userID + "/contact_methods")
+	if err != nil {
+		return nil, err
+	}
+	var page struct {
+		ContactMethods []ContactMethod `json:"contact_methods"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, err
+	}
+	return page.ContactMethods, nil
+}
+
+// createContactMethodResources creates contact methods of user, they hold personal
+// phone numbers and addresses so they are imported only with --contact-methods
+func (g *UserGenerator) createContactMethodResources(user User, contactMethods []ContactMethod) []terraformutils.Resource {
+	var resources []terraformutils.Resource
+	for _, contactMethod := range contactMethods {
+		resources = append(resources, terraformutils.NewResource(
+			contactMethod.ID,
+			user.Name+"_"+contactMethod.Label+"_"+contactMethod.ID,
+			"pagerduty_user_contact_method",
+			"pagerduty",
+			map[string]string{
+				"user_id": user.ID,
+			},
+			UserAllowEmptyValues,
+			map[string]interface{}{},
+		))
+	}
+	return resources
+}
+
+// PostConvertHook links contact methods to users
+func (g *UserGenerator) PostConvertHook() error {
+	users := map[string]string{}
+	for _, r := range g.Resources {
+		if r.InstanceInfo.Type == "pagerduty_user" {
+			users[r.InstanceState.ID] = r.ResourceName
+		}
+	}
+	for i, r := range g.Resources {
+		if r.InstanceInfo.Type != "pagerduty_user_contact_method" {
+			continue
+		}
+		if resourceName, exist := users[r.InstanceState.Attributes["user_id"]]; exist {
+			g.Resources[i].Item["user_id"] = "${pagerduty_user." + resourceName + ".id}"
+		}
+	}
+	return nil
+}
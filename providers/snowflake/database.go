@@ -0,0 +1,44 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snowflake
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type DatabaseGenerator struct {
+	SnowflakeService
+}
+
+func (g *DatabaseGenerator) InitResources() error {
+	databases, err := g.query("SHOW DATABASES")
+	if err != nil {
+		return err
+	}
+	for _, database := range databases {
+		// databases created from shares are managed by their provider
+		if systemDatabases[database["name"]] || database["origin"] != "" {
+			continue
+		}
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			database["name"],
+			database["name"],
+			"snowflake_database",
+			"snowflake",
+			[]string{},
+		))
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snowflake
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type GrantPrivilegesToRoleGenerator struct {
+	SnowflakeService
+}
+
+type grantTarget struct {
+	grantedOn       string
+	name            string
+	withGrantOption bool
+}
+
+// InitResources groups SHOW GRANTS output of every role into one resource per granted object
+func (g *GrantPrivilegesToRoleGenerator) InitResources() error {
+	roles, err := g.query("SHOW ROLES")
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if systemRoles[role["name"]] {
+			continue
+		}
+		grants, err := g.query(`SHOW GRANTS TO ROLE "` + role["name"] + `"`)
+		if err != nil {
+			return err
+		}
+		privileges := map[grantTarget][]string{}
+		var targets []grantTarget
+		for _, grant := range grants {
+			// ownership is transferred, not granted, and role grants are role hierarchy
+			if grant["privilege"] == "OWNERSHIP" || grant["granted_on"] == "ROLE" {
+				continue
+			}
+			target := grantTarget{
+				grantedOn:       grant["granted_on"],
+				name:            grant["name"],
+				withGrantOption: grant["grant_option"] == "true",
+			}
+			if _, ok := privileges[target]; !ok {
+				targets = append(targets, target)
+			}
+			privileges[target] = append(privileges[target], grant["privilege"])
+		}
+		for _, target := range targets {
+			sort.Strings(privileges[target])
+			g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+				grantID(role["name"], privileges[target], target),
+				role["name"]+"_"+target.grantedOn+"_"+target.name,
+				"snowflake_grant_privileges_to_role",
+				"snowflake",
+				[]string{},
+			))
+		}
+	}
+	return nil
+}
+
+// grantID builds pipe separated id: role_name|privileges|all_privileges|with_grant_option|on_account|
+// on_account_object|on_schema|on_schema_object|all|future|object_type|object_name|object_type_plural|schema_name|database_name
+func grantID(role string, privileges []string, target grantTarget) string {
+	var onAccount, onAccountObject, onSchema, onSchemaObject bool
+	var objectType, objectName, schemaName string
+	switch target.grantedOn {
+	case "ACCOUNT":
+		onAccount = true
+	case "DATABASE", "WAREHOUSE", "INTEGRATION", "RESOURCE_MONITOR", "USER", "FAILOVER_GROUP", "REPLICATION_GROUP":
+		onAccountObject = true
+		objectType, objectName = target.grantedOn, target.name
+	case "SCHEMA":
+		onSchema = true
+		schemaName = target.name
+	default:
+		onSchemaObject = true
+		objectType, objectName = strings.ReplaceAll(target.grantedOn, "_", " "), target.name
+	}
+	return strings.Join([]string{
+		role,
+		strings.Join(privileges, ","),
+		"false",
+		strconv.FormatBool(target.withGrantOption),
+		strconv.FormatBool(onAccount),
+		strconv.FormatBool(onAccountObject),
+		strconv.FormatBool(onSchema),
+		strconv.FormatBool(onSchemaObject),
+		"false",
+		"false",
+		objectType,
+		objectName,
+		"",
+		schemaName,
+		"",
+	}, "|")
+}
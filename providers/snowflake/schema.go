@@ -0,0 +1,48 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snowflake
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type SchemaGenerator struct {
+	SnowflakeService
+}
+
+func (g *SchemaGenerator) InitResources() error {
+	schemas, err := g.query("SHOW SCHEMAS IN ACCOUNT")
+	if err != nil {
+		return err
+	}
+	for _, schema := range schemas {
+		if systemDatabases[schema["database_name"]] || schema["name"] == "INFORMATION_SCHEMA" {
+			continue
+		}
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			schema["database_name"]+"|"+schema["name"],
+			schema["database_name"]+"_"+schema["name"],
+			"snowflake_schema",
+			"snowflake",
+			map[string]string{
+				"database": schema["database_name"],
+				"name":     schema["name"],
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snowflake
+
+import (
+	"errors"
+	"os"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type SnowflakeProvider struct { //nolint
+	terraformutils.Provider
+	account string
+	region  string
+	user    string
+	role    string
+	token   string
+}
+
+func (p *SnowflakeProvider) Init(args []string) error {
+	p.account = os.Getenv("SNOWFLAKE_ACCOUNT")
+	p.region = os.Getenv("SNOWFLAKE_REGION")
+	p.user = os.Getenv("SNOWFLAKE_USER")
+	p.role = os.Getenv("SNOWFLAKE_ROLE")
+	p.token = os.Getenv("SNOWFLAKE_OAUTH_ACCESS_TOKEN")
+	for i, value := range []*string{&p.account, &p.region, &p.user, &p.role} {
+		if len(args) > i && args[i] != "" {
+			*value = args[i]
+		}
+	}
+	if p.account == "" || p.user == "" {
+		return errors.New("set SNOWFLAKE_ACCOUNT and SNOWFLAKE_USER env vars")
+	}
+	if p.token == "" {
+		return errors.New("set SNOWFLAKE_OAUTH_ACCESS_TOKEN env var")
+	}
+	return nil
+}
+
+func (p *SnowflakeProvider) GetName() string {
+	return "snowflake"
+}
+
+func (p *SnowflakeProvider) GetProviderData(arg ...string) map[string]interface{} {
+	snowflakeConfig := map[string]interface{}{
+		"account":  p.account,
+		"username": p.user,
+	}
+	if p.region != "" {
+		snowflakeConfig["region"] = p.region
+	}
+	if p.role != "" {
+		snowflakeConfig["role"] = p.role
+	}
+	return map[string]interface{}{
+		"provider": map[string]interface{}{
+			"snowflake": snowflakeConfig,
+		},
+	}
+}
+
+func (p *SnowflakeProvider) GetConfig() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"account":            cty.StringVal(p.account),
+		"region":             cty.StringVal(p.region),
+		"username":           cty.StringVal(p.user),
+		"role":               cty.StringVal(p.role),
+		"oauth_access_token": cty.StringVal(p.token),
+	})
+}
+
+func (p *SnowflakeProvider) InitService(serviceName string, verbose bool) error {
+	var isSupported bool
+	if _, isSupported = p.GetSupportedService()[serviceName]; !isSupported {
+		return errors.New(p.GetName() + ": " + serviceName + " not supported service")
+	}
+	p.Service = p.GetSupportedService()[serviceName]
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"account": p.account,
+		"region":  p.region,
+		"role":    p.role,
+		"token":   p.token,
+	})
+	return nil
+}
+
+func (p *SnowflakeProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	return map[string]terraformutils.ServiceGenerator{
+		"database":                 &DatabaseGenerator{},
+		"grant_privileges_to_role": &GrantPrivilegesToRoleGenerator{},
+		"role":                     &RoleGenerator{},
+		"schema":                   &SchemaGenerator{},
+		"table":                    &TableGenerator{},
+		"warehouse":                &WarehouseGenerator{},
+	}
+}
+
+func (SnowflakeProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{
+		"grant_privileges_to_role": {
+			"role": []string{"role_name", "name"},
+		},
+		"schema": {
+			"database": []string{"database", "name"},
+		},
+		"table": {
+			"database": []string{"database", "name"},
+		},
+	}
+}
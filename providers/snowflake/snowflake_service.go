@@ -0,0 +1,150 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snowflake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type SnowflakeService struct { //nolint
+	terraformutils.Service
+}
+
+// systemDatabases are shared by Snowflake and can't be managed
+var systemDatabases = map[string]bool{
+	"SNOWFLAKE":             true,
+	"SNOWFLAKE_SAMPLE_DATA": true,
+}
+
+// systemRoles are created by Snowflake for every account
+var systemRoles = map[string]bool{
+	"ACCOUNTADMIN":  true,
+	"ORGADMIN":      true,
+	"PUBLIC":        true,
+	"SECURITYADMIN": true,
+	"SYSADMIN":      true,
+	"USERADMIN":     true,
+}
+
+type statementResponse struct {
+	StatementHandle   string `json:"statementHandle"`
+	ResultSetMetaData struct {
+		RowType []struct {
+			Name string `json:"name"`
+		} `json:"rowType"`
+		PartitionInfo []json.RawMessage `json:"partitionInfo"`
+	} `json:"resultSetMetaData"`
+	Data [][]*string `json:"data"`
+}
+
+func (s *SnowflakeService) baseURL() string {
+	host := s.Args["account"].(string)
+	if region := s.Args["region"].(string); region != "" {
+		host += "." + region
+	}
+	return "https://" + host + ".snowflakecomputing.com/api/v2/statements"
+}
+
+func (s *SnowflakeService) generateRequest(method, url string, payload []byte) ([]byte, int, error) {
+	client := &http.Client{}
+	req, err := http.NewRequest(method, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.Args["token"].(string))
+	req.Header.Set("X-Snowflake-Authorization-Token-Type", "OAUTH")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, resp.StatusCode, fmt.Errorf("snowflake: %s %s returned %s: %s", method, url, resp.Status, body)
+	}
+	return body, resp.StatusCode, nil
+}
+
+// query runs statement through SQL API and returns rows keyed by lower case column names
+func (s *SnowflakeService) query(statement string) ([]map[string]string, error) {
+	request := map[string]interface{}{
+		"statement": statement,
+		"timeout":   60,
+	}
+	if role := s.Args["role"].(string); role != "" {
+		request["role"] = role
+	}
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	body, status, err := s.generateRequest("POST", s.baseURL(), payload)
+	if err != nil {
+		return nil, err
+	}
+	var response statementResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	// long running statements are answered asynchronously
+	for status == http.StatusAccepted {
+		time.Sleep(time.Second)
+		body, status, err = s.generateRequest("GET", s.baseURL()+"/"+response.StatementHandle, nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+	}
+
+	data := response.Data
+	for partition := 1; partition < len(response.ResultSetMetaData.PartitionInfo); partition++ {
+		body, _, err := s.generateRequest("GET", fmt.Sprintf("%s/%s?partition=%d", s.baseURL(), response.StatementHandle, partition), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page statementResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+		data = append(data, page.Data...)
+	}
+
+	var rows []map[string]string
+	for _, values := range data {
+		row := map[string]string{}
+		for i, column := range response.ResultSetMetaData.RowType {
+			if i < len(values) && values[i] != nil {
+				row[strings.ToLower(column.Name)] = *values[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snowflake
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type TableGenerator struct {
+	SnowflakeService
+}
+
+func (g *TableGenerator) InitResources() error {
+	tables, err := g.query("SHOW TABLES IN ACCOUNT")
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if systemDatabases[table["database_name"]] {
+			continue
+		}
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			table["database_name"]+"|"+table["schema_name"]+"|"+table["name"],
+			table["database_name"]+"_"+table["schema_name"]+"_"+table["name"],
+			"snowflake_table",
+			"snowflake",
+			map[string]string{
+				"database": table["database_name"],
+				"schema":   table["schema_name"],
+				"name":     table["name"],
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snowflake
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type WarehouseGenerator struct {
+	SnowflakeService
+}
+
+// InitResources lists warehouses, sizing, auto suspend/resume and scaling settings are read by terraform
+func (g *WarehouseGenerator) InitResources() error {
+	warehouses, err := g.query("SHOW WAREHOUSES")
+	if err != nil {
+		return err
+	}
+	for _, warehouse := range warehouses {
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			warehouse["name"],
+			warehouse["name"],
+			"snowflake_warehouse",
+			"snowflake",
+			[]string{},
+		))
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type AuthBackendGenerator struct {
+	VaultService
+}
+
+func (g *AuthBackendGenerator) InitResources() error {
+	mounts, err := g.authMounts()
+	if err != nil {
+		return err
+	}
+	for path, mount := range mounts {
+		if mount.Type == "token" {
+			continue // token auth method is always mounted
+		}
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			path,
+			g.resourceName(path),
+			"vault_auth_backend",
+			"vault",
+			map[string]string{
+				"path": path,
+				"type": mount.Type,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
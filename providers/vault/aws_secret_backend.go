@@ -0,0 +1,87 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type AwsSecretBackendGenerator struct {
+	VaultService
+}
+
+func (g *AwsSecretBackendGenerator) InitResources() error {
+	mounts, err := g.secretMounts()
+	if err != nil {
+		return err
+	}
+	for path, mount := range mounts {
+		if mount.Type != "aws" {
+			continue
+		}
+		resource := terraformutils.NewResource(
+			path,
+			g.resourceName(path),
+			"vault_aws_secret_backend",
+			"vault",
+			map[string]string{
+				"path": path,
+			},
+			[]string{},
+			map[string]interface{}{},
+		)
+		resource.IgnoreKeys = append(resource.IgnoreKeys, "^secret_key$")
+		g.Resources = append(g.Resources, resource)
+
+		roles, err := g.list(path + "/roles")
+		if err != nil {
+			return err
+		}
+		for _, role := range roles {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				path+"/roles/"+role,
+				g.resourceName(path+"_"+role),
+				"vault_aws_secret_backend_role",
+				"vault",
+				map[string]string{
+					"backend": path,
+					"name":    role,
+				},
+				[]string{},
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links roles to their backends
+func (g *AwsSecretBackendGenerator) PostConvertHook() error {
+	backends := map[string]string{}
+	for _, resource := range g.Resources {
+		if resource.InstanceInfo.Type == "vault_aws_secret_backend" {
+			backends[resource.InstanceState.ID] = resource.ResourceName
+		}
+	}
+	for i, resource := range g.Resources {
+		if resource.InstanceInfo.Type != "vault_aws_secret_backend_role" {
+			continue
+		}
+		if name, ok := backends[resource.InstanceState.Attributes["backend"]]; ok {
+			g.Resources[i].Item["backend"] = "${vault_aws_secret_backend." + name + ".path}"
+		}
+	}
+	return nil
+}
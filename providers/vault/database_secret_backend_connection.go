@@ -0,0 +1,100 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package vault
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type DatabaseSecretBackendConnectionGenerator struct {
+	VaultService
+}
+
+func (g *DatabaseSecretBackendConnectionGenerator) InitResources() error {
+	mounts, err := g.secretMounts()
+	if err != nil {
+		return err
+	}
+	for path, mount := range mounts {
+		if mount.Type != "database" {
+			continue
+		}
+		connections, err := g.list(path + "/config")
+		if err != nil {
+			return err
+		}
+		for _, connection := range connections {
+			resource := terraformutils.NewResource(
+				path+"/config/"+connection,
+				g.resourceName(path+"_"+connection),
+				"vault_database_secret_backend_connection",
+				"vault",
+				map[string]string{
+					"backend": path,
+					"name":    connection,
+				},
+				[]string{},
+				map[string]interface{}{},
+			)
+			resource.IgnoreKeys = append(resource.IgnoreKeys, `\.password$`)
+			g.Resources = append(g.Resources, resource)
+		}
+	}
+	return nil
+}
+
+// PostConvertHook replaces connection URLs embedding credentials by sensitive variables,
+// URLs with {{username}} and {{password}} templates are kept
+func (g *DatabaseSecretBackendConnectionGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		for key, value := range resource.Item {
+			blocks, ok := value.([]interface{})
+			if !ok {
+				continue
+			}
+			for _, block := range blocks {
+				block, ok := block.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				connectionURL, ok := block["connection_url"].(string)
+				if !ok || !hasCredentials(connectionURL) {
+					continue
+				}
+				block["connection_url"] = connectionURLVariable(&g.Resources[i], key)
+			}
+		}
+	}
+	return nil
+}
+
+// hasCredentials tells if connection URL carries password, DSNs like user:password@tcp(host) aren't URLs
+func hasCredentials(connectionURL string) bool {
+	if parsed, err := url.Parse(connectionURL); err == nil && parsed.User != nil {
+		password, set := parsed.User.Password()
+		return set && password != "{{password}}"
+	}
+	userInfo := strings.SplitN(connectionURL, "@", 2)
+	return len(userInfo) == 2 && strings.Contains(userInfo[0], ":") && !strings.Contains(userInfo[0], "{{password}}")
+}
+
+// connectionURLVariable declares sensitive variable for connection URL in resource data files
+// and returns reference to it
+func connectionURLVariable(r *terraformutils.Resource, plugin string) string {
+	variable := strings.TrimPrefix(r.ResourceName, "tfer--") + "_" + plugin + "_connection_url"
+	return r.AddSensitiveVariable(variable, "Connection URL of database connection "+r.InstanceState.ID)
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type KubernetesAuthBackendRoleGenerator struct {
+	VaultService
+}
+
+func (g *KubernetesAuthBackendRoleGenerator) InitResources() error {
+	mounts, err := g.authMounts()
+	if err != nil {
+		return err
+	}
+	for path, mount := range mounts {
+		if mount.Type != "kubernetes" {
+			continue
+		}
+		roles, err := g.list("auth/" + path + "/role")
+		if err != nil {
+			return err
+		}
+		for _, role := range roles {
+			g.Resources = append(g.Resources, terraformutils.NewResource(
+				"auth/"+path+"/role/"+role,
+				g.resourceName(path+"_"+role),
+				"vault_kubernetes_auth_backend_role",
+				"vault",
+				map[string]string{
+					"backend":   path,
+					"role_name": role,
+				},
+				[]string{},
+				map[string]interface{}{},
+			))
+		}
+	}
+	return nil
+}
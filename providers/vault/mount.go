@@ -0,0 +1,56 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package vault
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// builtinMountTypes are secret engines mounted by Vault itself, they can't be managed
+var builtinMountTypes = map[string]bool{
+	"cubbyhole": true,
+	"identity":  true,
+	"system":    true,
+}
+
+type MountGenerator struct {
+	VaultService
+}
+
+// InitResources creates mounts of secret engines, AWS engines are created by aws_secret_backend,
+// data stored in engines is never read
+func (g *MountGenerator) InitResources() error {
+	mounts, err := g.secretMounts()
+	if err != nil {
+		return err
+	}
+	for path, mount := range mounts {
+		if builtinMountTypes[mount.Type] || mount.Type == "aws" {
+			continue
+		}
+		g.Resources = append(g.Resources, terraformutils.NewResource(
+			path,
+			g.resourceName(path),
+			"vault_mount",
+			"vault",
+			map[string]string{
+				"path": path,
+				"type": mount.Type,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+type PolicyGenerator struct {
+	VaultService
+}
+
+func (g *PolicyGenerator) InitResources() error {
+	policies, err := g.list("sys/policies/acl")
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		if policy == "root" {
+			continue // root policy can't be read nor changed
+		}
+		g.Resources = append(g.Resources, terraformutils.NewSimpleResource(
+			policy,
+			g.resourceName(policy),
+			"vault_policy",
+			"vault",
+			[]string{},
+		))
+	}
+	return nil
+}
+
+// PostConvertHook renders policy documents as heredoc, template sequences are escaped
+func (g *PolicyGenerator) PostConvertHook() error {
+	for i, resource := range g.Resources {
+		policy, ok := resource.Item["policy"].(string)
+		if !ok || policy == "" {
+			continue
+		}
+		g.Resources[i].Item["policy"] = terraformutils.Heredoc("EOT", policy)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/hashicorp/vault/api"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const defaultVaultAddress = "http://127.0.0.1:8200"
+
+type VaultProvider struct { //nolint
+	terraformutils.Provider
+	address string
+	token   string
+	// namespace is Vault Enterprise namespace, paths of API calls are prefixed with it
+	namespace string
+}
+
+func (p *VaultProvider) Init(args []string) error {
+	p.address = os.Getenv("VAULT_ADDR")
+	if len(args) > 0 && args[0] != "" {
+		p.address = args[0]
+	}
+	if p.address == "" {
+		p.address = defaultVaultAddress
+	}
+	p.address = strings.TrimSuffix(p.address, "/")
+
+	p.namespace = os.Getenv("VAULT_NAMESPACE")
+	if len(args) > 4 && args[4] != "" {
+		p.namespace = args[4]
+	}
+	p.namespace = strings.Trim(p.namespace, "/")
+
+	roleID, secretID := os.Getenv("VAULT_ROLE_ID"), os.Getenv("VAULT_SECRET_ID")
+	if len(args) > 3 && args[2] != "" {
+		roleID, secretID = args[2], args[3]
+	}
+
+	if len(args) > 1 && args[1] != "" {
+		p.token = args[1]
+	} else if token := os.Getenv("VAULT_TOKEN"); token != "" {
+		p.token = token
+	} else if roleID != "" {
+		token, err := p.approleLogin(roleID, secretID)
+		if err != nil {
+			return err
+		}
+		p.token = token
+	} else {
+		return errors.New("set VAULT_TOKEN env var or AppRole credentials")
+	}
+	return nil
+}
+
+// approleLogin exchanges AppRole role id and secret id for token, token is renewed in background
+// while resources are imported
+func (p *VaultProvider) approleLogin(roleID, secretID string) (string, error) {
+	client, err := newClient(p.address, p.namespace)
+	if err != nil {
+		return "", err
+	}
+	secret, err := client.Logical().Write("auth/approle/login", map[string]interface{}{
+		"role_id":   roleID,
+		"secret_id": secretID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("vault: AppRole login: %v", err)
+	}
+	if secret == nil || secret.Auth == nil {
+		return "", errors.New("vault: AppRole login returned no token")
+	}
+	client.SetToken(secret.Auth.ClientToken)
+	if secret.Auth.Renewable {
+		renewer, err := client.NewRenewer(&api.RenewerInput{Secret: secret})
+		if err != nil {
+			return "", err
+		}
+		go renewer.Renew()
+		go func() {
+			for {
+				select {
+				case err := <-renewer.DoneCh():
+					if err != nil {
+						log.Println("vault: token renewal stopped:", err)
+					}
+					return
+				case <-renewer.RenewCh():
+				}
+			}
+		}()
+	}
+	return secret.Auth.ClientToken, nil
+}
+
+func (p *VaultProvider) GetName() string {
+	return "vault"
+}
+
+func (p *VaultProvider) GetProviderData(arg ...string) map[string]interface{} {
+	return map[string]interface{}{
+		"provider": map[string]interface{}{
+			"vault": p.providerConfig(),
+		},
+	}
+}
+
+// providerConfig carries namespace of imported resources, generated code is applied in it
+func (p *VaultProvider) providerConfig() map[string]interface{} {
+	config := map[string]interface{}{
+		"address": p.address,
+	}
+	if p.namespace != "" {
+		config["namespace"] = p.namespace
+	}
+	return config
+}
+
+func (p *VaultProvider) GetConfig() cty.Value {
+	config := map[string]cty.Value{
+		"address": cty.StringVal(p.address),
+		"token":   cty.StringVal(p.token),
+	}
+	if p.namespace != "" {
+		config["namespace"] = cty.StringVal(p.namespace)
+	}
+	return cty.ObjectVal(config)
+}
+
+func (p *VaultProvider) InitService(serviceName string, verbose bool) error {
+	var isSupported bool
+	if _, isSupported = p.GetSupportedService()[serviceName]; !isSupported {
+		return errors.New(p.GetName() + ": " + serviceName + " not supported service")
+	}
+	p.Service = p.GetSupportedService()[serviceName]
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	p.Service.SetProviderName(p.GetName())
+	p.Service.SetArgs(map[string]interface{}{
+		"address":   p.address,
+		"token":     p.token,
+		"namespace": p.namespace,
+	})
+	return nil
+}
+
+func (p *VaultProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	return map[string]terraformutils.ServiceGenerator{
+		"auth_backend":                       &AuthBackendGenerator{},
+		"aws_secret_backend":                 &AwsSecretBackendGenerator{},
+		"database_secret_backend_connection": &DatabaseSecretBackendConnectionGenerator{},
+		"kubernetes_auth_backend_role":       &KubernetesAuthBackendRoleGenerator{},
+		"mount":                              &MountGenerator{},
+		"policy":                             &PolicyGenerator{},
+	}
+}
+
+func (VaultProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{
+		"database_secret_backend_connection": {
+			"mount": []string{"backend", "path"},
+		},
+		"kubernetes_auth_backend_role": {
+			"auth_backend": []string{"backend", "path"},
+		},
+	}
+}
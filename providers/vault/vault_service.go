@@ -0,0 +1,132 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/hashicorp/vault/api"
+)
+
+type VaultService struct { //nolint
+	terraformutils.Service
+	client *api.Client
+}
+
+// Mount is an entry of sys/mounts and sys/auth listings
+type Mount struct {
+	Type    string
+	Options map[string]string
+}
+
+// newClient returns Vault API client sending requests in namespace
+func newClient(address, namespace string) (*api.Client, error) {
+	config := api.DefaultConfig()
+	if config.Error != nil {
+		return nil, config.Error
+	}
+	config.Address = address
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	if namespace != "" {
+		client.SetNamespace(namespace)
+	}
+	return client, nil
+}
+
+func (s *VaultService) vaultClient() (*api.Client, error) {
+	if s.client != nil {
+		return s.client, nil
+	}
+	client, err := newClient(s.Args["address"].(string), s.namespace())
+	if err != nil {
+		return nil, err
+	}
+	client.SetToken(s.Args["token"].(string))
+	s.client = client
+	return client, nil
+}
+
+func (s *VaultService) namespace() string {
+	namespace, _ := s.Args["namespace"].(string)
+	return namespace
+}
+
+// resourceName prefixes name with namespace, same paths exist in each namespace
+func (s *VaultService) resourceName(name string) string {
+	if s.namespace() == "" {
+		return name
+	}
+	return strings.Trim(s.namespace(), "/") + "_" + name
+}
+
+// list returns keys under uri, Vault answers 404 for empty listings
+func (s *VaultService) list(uri string) ([]string, error) {
+	client, err := s.vaultClient()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := client.Logical().List(uri)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return []string{}, nil
+	}
+	values, _ := secret.Data["keys"].([]interface{})
+	keys := make([]string, 0, len(values))
+	for _, value := range values {
+		keys = append(keys, fmt.Sprint(value))
+	}
+	return keys, nil
+}
+
+// secretMounts returns paths of secret engines without trailing slash
+func (s *VaultService) secretMounts() (map[string]Mount, error) {
+	client, err := s.vaultClient()
+	if err != nil {
+		return nil, err
+	}
+	listing, err := client.Sys().ListMounts()
+	if err != nil {
+		return nil, err
+	}
+	mounts := map[string]Mount{}
+	for path, mount := range listing {
+		mounts[strings.TrimSuffix(path, "/")] = Mount{Type: mount.Type, Options: mount.Options}
+	}
+	return mounts, nil
+}
+
+// authMounts returns paths of auth methods without trailing slash
+func (s *VaultService) authMounts() (map[string]Mount, error) {
+	client, err := s.vaultClient()
+	if err != nil {
+		return nil, err
+	}
+	listing, err := client.Sys().ListAuth()
+	if err != nil {
+		return nil, err
+	}
+	mounts := map[string]Mount{}
+	for path, mount := range listing {
+		mounts[strings.TrimSuffix(path, "/")] = Mount{Type: mount.Type, Options: mount.Options}
+	}
+	return mounts, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// parameters which values must not be written to audit log
+var auditSensitiveParameterRegexp = regexp.MustCompile(`(?i)(token|key|secret|password|signature|credential)`)
+
+// APIAuditEntry is one API call written to audit log as JSON line
+type APIAuditEntry struct {
+	Time       time.Time           `json:"time"`
+	Method     string              `json:"method"`
+	URL        string              `json:"url"`
+	Operation  string              `json:"operation,omitempty"`
+	Parameters map[string][]string `json:"parameters,omitempty"`
+	StatusCode int                 `json:"status_code,omitempty"`
+	DurationMs int64               `json:"duration_ms"`
+	Error      string              `json:"error,omitempty"`
+}
+
+// APIAuditLogger writes all cloud API calls made during import to w
+type APIAuditLogger struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+func NewAPIAuditLogger(w io.Writer) *APIAuditLogger {
+	return &APIAuditLogger{encoder: json.NewEncoder(w)}
+}
+
+var apiAuditLogger *APIAuditLogger
+
+// SetAPIAuditLogger sets logger used by providers, nil disables audit log
+func SetAPIAuditLogger(logger *APIAuditLogger) {
+	apiAuditLogger = logger
+}
+
+// GetAPIAuditLogger returns logger used by providers, nil when audit log is disabled
+func GetAPIAuditLogger() *APIAuditLogger {
+	return apiAuditLogger
+}
+
+func (l *APIAuditLogger) Log(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	entry := APIAuditEntry{
+		Time:       time.Now().UTC().Add(-duration),
+		Method:     req.Method,
+		Operation:  req.Header.Get("X-Amz-Target"),
+		Parameters: auditParameters(req),
+		DurationMs: duration.Milliseconds(),
+	}
+	if req.URL != nil {
+		u := *req.URL
+		u.RawQuery = ""
+		u.User = nil
+		entry.URL = u.String()
+	}
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.encoder.Encode(entry); err != nil {
+		log.Println("failed to write audit log:", err)
+	}
+}
+
+// auditParameters returns query and form parameters, form body is read from copy of request body
+func auditParameters(req *http.Request) map[string][]string {
+	parameters := url.Values{}
+	if req.URL != nil {
+		for k, v := range req.URL.Query() {
+			parameters[k] = v
+		}
+	}
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, err := ioutil.ReadAll(body)
+			_ = body.Close()
+			if form, e := url.ParseQuery(string(data)); err == nil && e == nil {
+				for k, v := range form {
+					parameters[k] = v
+				}
+			}
+		}
+	}
+	if len(parameters) == 0 {
+		return nil
+	}
+	for k := range parameters {
+		if auditSensitiveParameterRegexp.MatchString(k) {
+			parameters[k] = []string{"REDACTED"}
+		}
+	}
+	return parameters
+}
+
+type auditRoundTripper struct {
+	logger *APIAuditLogger
+	base   http.RoundTripper
+}
+
+func (t auditRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	t.logger.Log(req, resp, err, time.Since(start))
+	return resp, err
+}
+
+// RoundTripper logs calls made by base
+func (l *APIAuditLogger) RoundTripper(base http.RoundTripper) http.RoundTripper {
+	return auditRoundTripper{logger: l, base: base}
+}
+
+// HTTPDoer is client interface of AWS and Azure SDKs
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type auditDoer struct {
+	logger *APIAuditLogger
+	base   HTTPDoer
+}
+
+func (d auditDoer) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := d.base.Do(req)
+	d.logger.Log(req, resp, err, time.Since(start))
+	return resp, err
+}
+
+// Doer logs calls made by base
+func (l *APIAuditLogger) Doer(base HTTPDoer) HTTPDoer {
+	return auditDoer{logger: l, base: base}
+}
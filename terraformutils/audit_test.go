@@ -0,0 +1,56 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIAuditLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	var buffer bytes.Buffer
+	logger := NewAPIAuditLogger(&buffer)
+	client := &http.Client{Transport: logger.RoundTripper(http.DefaultTransport)}
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/list?page=2&access_token=secret", strings.NewReader("Action=DescribeInstances"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	var entry APIAuditEntry
+	if err := json.Unmarshal(buffer.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Method != http.MethodPost || entry.URL != server.URL+"/list" || entry.StatusCode != http.StatusAccepted {
+		t.Errorf("unexpected entry %+v", entry)
+	}
+	if entry.Parameters["page"][0] != "2" || entry.Parameters["Action"][0] != "DescribeInstances" {
+		t.Errorf("failed to log parameters %v", entry.Parameters)
+	}
+	if strings.Contains(buffer.String(), "secret") {
+		t.Errorf("failed to redact parameters %s", buffer.String())
+	}
+}
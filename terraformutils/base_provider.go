@@ -15,6 +15,9 @@
 package terraformutils
 
 import (
+	"context"
+	"io"
+
 	"github.com/zclconf/go-cty/cty"
 )
 
@@ -30,6 +33,13 @@ type ProviderGenerator interface {
 	GetProviderData(arg ...string) map[string]interface{}
 	GenerateOutputPath() error
 	GetResourceConnections() map[string]map[string][]string
+	StreamResources(ctx context.Context, w io.Writer, resources <-chan Resource) error
+}
+
+// AggregateServicesProvider is implemented by providers with services importing resources of other services together,
+// such services are left out when all services are imported, so resources aren't imported twice
+type AggregateServicesProvider interface {
+	GetAggregateServices() []string
 }
 
 type Provider struct {
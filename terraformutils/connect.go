@@ -14,6 +14,8 @@
 
 package terraformutils
 
+import "strings"
+
 func ConnectServices(importResources map[string][]Resource, isServicePath bool, resourceConnections map[string]map[string][]string) map[string][]Resource {
 	for resource, connection := range resourceConnections {
 		if _, exist := importResources[resource]; exist {
@@ -39,18 +41,27 @@ func ConnectServices(importResources map[string][]Resource, isServicePath bool,
 	return importResources
 }
 
+// ConnectionKey splits connected attribute of connection pair into prefix of referencing value and attribute,
+// e.g. serviceAccount:email links members like serviceAccount:<email> to email attribute
+func ConnectionKey(key string) (string, string) {
+	if i := strings.LastIndex(key, ":"); i != -1 {
+		return key[:i+1], key[i+1:]
+	}
+	return "", key
+}
+
 func mapResource(importResources map[string][]Resource, resource string, connectionPair []string, resourceToMap Resource, k string) {
+	prefix, key := ConnectionKey(connectionPair[1])
+	if key == "self_link" || key == "id" {
+		key = resourceToMap.GetIDKey()
+	}
 	for i := range importResources[resource] {
-		key := connectionPair[1]
-		if connectionPair[1] == "self_link" || connectionPair[1] == "id" {
-			key = resourceToMap.GetIDKey()
-		}
 		mappingResourceAttr := WalkAndGet(key, resourceToMap.InstanceState.Attributes)
 		keyValue := resourceToMap.InstanceInfo.Type + "_" + resourceToMap.ResourceName + "_" + key
-		linkValue := "${data.terraform_remote_state." + k + ".outputs." + keyValue + "}"
+		linkValue := prefix + "${data.terraform_remote_state." + k + ".outputs." + keyValue + "}"
 
 		if len(mappingResourceAttr) == 1 {
-			resourceIdentifier := mappingResourceAttr[0].(string)
+			resourceIdentifier := prefix + mappingResourceAttr[0].(string)
 			WalkAndOverride(connectionPair[0], resourceIdentifier, linkValue, importResources[resource][i].Item)
 		}
 	}
@@ -23,14 +23,7 @@ import (
 )
 
 func TestSimpleReference(t *testing.T) {
-	importResources := map[string][]Resource{
-		"type1": {prepare("ID1", "type1", map[string]string{
-			"type2_ref": "ID2",
-		}, map[string]interface{}{
-			"type2_ref": "ID2",
-		})},
-		"type2": {prepareNoAttrs("ID2", "type2")},
-	}
+	importResources := fixtureServices(t, "test_data/connect/simple")
 
 	resourceConnections := map[string]map[string][]string{
 		"type1": {
@@ -47,16 +40,7 @@ func TestSimpleReference(t *testing.T) {
 }
 
 func TestManyReferences(t *testing.T) {
-	importResources := map[string][]Resource{
-		"type1": {prepare("ID1", "type1", map[string]string{
-			"type2_ref1": "ID2",
-			"type2_ref2": "ID2",
-		}, map[string]interface{}{
-			"type2_ref1": "ID2",
-			"type2_ref2": "ID2",
-		})},
-		"type2": {prepareNoAttrs("ID2", "type2")},
-	}
+	importResources := fixtureServices(t, "test_data/connect/many")
 
 	resourceConnections := map[string]map[string][]string{
 		"type1": {
@@ -76,24 +60,26 @@ func TestManyReferences(t *testing.T) {
 	}
 }
 
-func TestResourceGroups(t *testing.T) {
-	importResources := map[string][]Resource{
-		"group1": {prepare("ID1", "type1", map[string]string{
-			"type2_ref1": "ID2",
-			"type2_ref2": "ID2",
-		}, map[string]interface{}{
-			"type2_ref1": "ID2",
-			"type2_ref2": "ID2",
-		}),
-			prepareNoAttrs("ID3", "type3")},
-		"group2": {
-			prepare("ID2", "type2", map[string]string{
-				"uid": "ID2",
-			}, map[string]interface{}{
-				"uid": "ID2",
-			}),
-			prepareNoAttrs("ID4", "type4")},
+func TestPrefixedReference(t *testing.T) {
+	// member of type3 in type2 fixture mustn\'t be linked as email
+	importResources := fixtureServices(t, "test_data/connect/prefixed")
+
+	resourceConnections := map[string]map[string][]string{
+		"type1": {
+			"type2": {"members", "serviceAccount:email"},
+		},
 	}
+	resources := ConnectServices(importResources, true, resourceConnections)
+
+	if !reflect.DeepEqual(resources["type1"][0].Item, map[string]interface{}{
+		"members": []interface{}{"user:a@example.com", "serviceAccount:${data.terraform_remote_state.type2.outputs.type2_tfer--name-002D-type2_email}"},
+	}) {
+		t.Errorf("failed to connect %v", resources["type1"][0].Item)
+	}
+}
+
+func TestResourceGroups(t *testing.T) {
+	importResources := fixtureServices(t, "test_data/connect/groups")
 
 	resourceConnections := map[string]map[string][]string{
 		"group1": {
@@ -114,12 +100,7 @@ func TestResourceGroups(t *testing.T) {
 }
 
 func TestNestedReference(t *testing.T) {
-	importResources := map[string][]Resource{
-		"type1": {prepare("ID1", "type1", map[string]string{
-			"nested.type2_ref": "ID2",
-		}, mapI("nested", mapI("type2_ref", "ID2")))},
-		"type2": {prepareNoAttrs("ID2", "type2")},
-	}
+	importResources := fixtureServices(t, "test_data/connect/nested")
 
 	resourceConnections := map[string]map[string][]string{
 		"type1": {
@@ -0,0 +1,65 @@
+// Copyright 2018 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package terraformutils
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// EscapeTemplate escapes terraform interpolation and directive sequences so value is kept verbatim
+// in quoted strings and heredocs
+func EscapeTemplate(value string) string {
+	value = strings.ReplaceAll(value, "${", "$${")
+	return strings.ReplaceAll(value, "%{", "%%{")
+}
+
+// Heredoc wraps multiline value like policies, scripts and JSON documents in heredoc with delimiter,
+// template sequences are escaped
+func Heredoc(delimiter, value string) string {
+	return fmt.Sprintf("<<%s\n%s\n%s", delimiter, strings.TrimSuffix(EscapeTemplate(value), "\n"), delimiter)
+}
+
+// HCLString quotes value as HCL string literal, unlike strconv.Quote it only uses escapes HCL knows
+// and template sequences are escaped
+func HCLString(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range EscapeTemplate(value) {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			switch {
+			case unicode.IsPrint(r):
+				b.WriteRune(r)
+			case r > 0xFFFF:
+				fmt.Fprintf(&b, `\U%08X`, r)
+			default:
+				fmt.Fprintf(&b, `\u%04X`, r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
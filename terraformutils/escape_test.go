@@ -0,0 +1,42 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package terraformutils
+
+import (
+	"testing"
+)
+
+func TestHCLString(t *testing.T) {
+	for value, expected := range map[string]string{
+		"plain":            `"plain"`,
+		`say "hi"`:         `"say \"hi\""`,
+		"C:\\path":         `"C:\\path"`,
+		"line\nbreak\ttab": `"line\nbreak\ttab"`,
+		"bell\a":           `"bell\u0007"`,
+		"${var.x} %{if}":   `"$${var.x} %%{if}"`,
+		"žluťoučký":        `"žluťoučký"`,
+	} {
+		if actual := HCLString(value); actual != expected {
+			t.Errorf("HCLString(%q) = %s, expected %s", value, actual, expected)
+		}
+	}
+}
+
+func TestHeredoc(t *testing.T) {
+	actual := Heredoc("EOT", "{\n  \"a\": \"${b}\"\n}\n")
+	expected := "<<EOT\n{\n  \"a\": \"$${b}\"\n}\nEOT"
+	if actual != expected {
+		t.Errorf("Heredoc = %q, expected %q", actual, expected)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// ResourcesFilter selects resources from list, ResourceFilter struct is kept for per-resource id and attribute filters
+type ResourcesFilter interface {
+	Filter(resources []Resource) []Resource
+}
+
+// FilterChain applies filters in order, resource has to pass all of them
+type FilterChain []ResourcesFilter
+
+func (c FilterChain) Filter(resources []Resource) []Resource {
+	for _, filter := range c {
+		resources = filter.Filter(resources)
+	}
+	return resources
+}
+
+// initialFilter is implemented by filters which only need type and ID of resource, they run before refresh
+type initialFilter interface {
+	isInitial() bool
+}
+
+func isInitialFilter(filter ResourcesFilter) bool {
+	initial, ok := filter.(initialFilter)
+	return ok && initial.isInitial()
+}
+
+// Phase returns filters of chain which run before refresh when initial is true, the others otherwise
+func (c FilterChain) Phase(initial bool) FilterChain {
+	chain := FilterChain{}
+	for _, filter := range c {
+		if isInitialFilter(filter) == initial {
+			chain = append(chain, filter)
+		}
+	}
+	return chain
+}
+
+// resourceFilters keeps resources passing all of id and attribute filters, duplicates are dropped
+type resourceFilters []ResourceFilter
+
+func (f resourceFilters) Filter(resources []Resource) []Resource {
+	var selected []Resource
+	for _, resource := range resources {
+		allPredicatesTrue := true
+		for _, filter := range f {
+			allPredicatesTrue = allPredicatesTrue && filter.Filter(resource)
+		}
+		if allPredicatesTrue && !ContainsResource(selected, resource) {
+			selected = append(selected, resource)
+		}
+	}
+	return selected
+}
+
+type resourcePredicate func(resource Resource) bool
+
+func selectResources(resources []Resource, predicate resourcePredicate) []Resource {
+	selected := []Resource{}
+	for _, resource := range resources {
+		if predicate(resource) {
+			selected = append(selected, resource)
+		}
+	}
+	return selected
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeFilter keeps resources of given types, full type (aws_instance) or service name (instance) is accepted
+type TypeFilter struct {
+	Types []string
+}
+
+func (f TypeFilter) isInitial() bool {
+	return true
+}
+
+func (f TypeFilter) Filter(resources []Resource) []Resource {
+	return selectResources(resources, func(resource Resource) bool {
+		return containsValue(f.Types, resource.InstanceInfo.Type) || containsValue(f.Types, resource.ServiceName())
+	})
+}
+
+// SkipTypeFilter drops resources with type matching one of glob patterns like aws_iam_*
+type SkipTypeFilter struct {
+	Patterns []string
+}
+
+func (f SkipTypeFilter) isInitial() bool {
+	return true
+}
+
+func (f SkipTypeFilter) Filter(resources []Resource) []Resource {
+	return selectResources(resources, func(resource Resource) bool {
+		for _, pattern := range f.Patterns {
+			if matched, _ := path.Match(pattern, resource.InstanceInfo.Type); matched {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// LoadSkipResourceTypes reads resource type patterns, one per line, empty lines and # comments are skipped
+func LoadSkipResourceTypes(r io.Reader) ([]string, error) {
+	patterns := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if _, err := path.Match(line, ""); err != nil {
+			return nil, fmt.Errorf("invalid resource type pattern %q: %v", line, err)
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns, scanner.Err()
+}
+
+// IDFilter keeps resources with given IDs
+type IDFilter struct {
+	IDs []string
+}
+
+func (f IDFilter) isInitial() bool {
+	return true
+}
+
+func (f IDFilter) Filter(resources []Resource) []Resource {
+	return selectResources(resources, func(resource Resource) bool {
+		return containsValue(f.IDs, resource.InstanceState.ID)
+	})
+}
+
+// TagFilter keeps resources having tag (or label) Key with one of Values
+type TagFilter struct {
+	Key    string
+	Values []string
+}
+
+func (f TagFilter) Filter(resources []Resource) []Resource {
+	return selectResources(resources, func(resource Resource) bool {
+		for _, tagsKey := range []string{"tags", "labels"} {
+			if value, exist := resource.InstanceState.Attributes[tagsKey+"."+f.Key]; exist {
+				return containsValue(f.Values, value)
+			}
+			if tags, ok := resource.Item[tagsKey].(map[string]interface{}); ok {
+				if value, exist := tags[f.Key].(string); exist {
+					return containsValue(f.Values, value)
+				}
+			}
+		}
+		return false
+	})
+}
+
+// NameRegexFilter keeps resources which name attribute (or ID when resource has no name) matches Regexp
+type NameRegexFilter struct {
+	Regexp *regexp.Regexp
+}
+
+func (f NameRegexFilter) Filter(resources []Resource) []Resource {
+	return selectResources(resources, func(resource Resource) bool {
+		name, exist := resource.InstanceState.Attributes["name"]
+		if !exist {
+			name = resource.InstanceState.ID
+		}
+		return f.Regexp.MatchString(name)
+	})
+}
+
+// RegionFilter keeps resources located in one of Regions,
+// resources without region, location or zone attribute are kept because provider is already scoped to region
+type RegionFilter struct {
+	Regions []string
+}
+
+func (f RegionFilter) Filter(resources []Resource) []Resource {
+	return selectResources(resources, func(resource Resource) bool {
+		for _, key := range []string{"region", "location", "zone"} {
+			value, exist := resource.InstanceState.Attributes[key]
+			if !exist || value == "" {
+				continue
+			}
+			for _, region := range f.Regions {
+				// zone europe-west1-b is part of region europe-west1
+				if value == region || strings.HasPrefix(value, region+"-") {
+					return true
+				}
+			}
+			return false
+		}
+		return true
+	})
+}
+
+// ParseFilterChain parses filter in form type=aws_instance,tag:Env=prod,
+// second return value is false when rawFilter uses other filter syntax
+func ParseFilterChain(rawFilter string) (FilterChain, bool) {
+	if rawFilter == "" || strings.Contains(rawFilter, ";") {
+		return nil, false
+	}
+	chain := FilterChain{}
+	for _, term := range strings.Split(rawFilter, ",") {
+		parts := strings.SplitN(term, "=", 2)
+		if len(parts) != 2 {
+			return nil, false
+		}
+		key, values := parts[0], ParseFilterValues(parts[1])
+		switch {
+		case key == "type":
+			chain = append(chain, TypeFilter{Types: values})
+		case key == "id":
+			chain = append(chain, IDFilter{IDs: values})
+		case key == "region":
+			chain = append(chain, RegionFilter{Regions: values})
+		case key == "name":
+			re, err := regexp.Compile(parts[1])
+			if err != nil {
+				return nil, false
+			}
+			chain = append(chain, NameRegexFilter{Regexp: re})
+		case strings.HasPrefix(key, "tag:") && len(key) > len("tag:"):
+			chain = append(chain, TagFilter{Key: strings.TrimPrefix(key, "tag:"), Values: values})
+		default:
+			return nil, false
+		}
+	}
+	return chain, true
+}
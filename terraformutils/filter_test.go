@@ -0,0 +1,139 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func filterTestResources() []Resource {
+	instance := NewResource("i-1", "web", "aws_instance", "aws", map[string]string{
+		"tags.Env": "prod",
+		"name":     "web-1",
+	}, []string{}, map[string]interface{}{})
+	volume := NewResource("vol-1", "data", "aws_ebs_volume", "aws", map[string]string{
+		"tags.Env": "dev",
+	}, []string{}, map[string]interface{}{})
+	bucket := NewResource("bucket", "bucket", "google_storage_bucket", "google", map[string]string{
+		"labels.Env": "prod",
+		"location":   "europe-west1",
+	}, []string{}, map[string]interface{}{})
+	return []Resource{instance, volume, bucket}
+}
+
+func filteredIDs(resources []Resource) []string {
+	ids := []string{}
+	for _, resource := range resources {
+		ids = append(ids, resource.InstanceState.ID)
+	}
+	return ids
+}
+
+func TestFilterChainParsing(t *testing.T) {
+	chain, ok := ParseFilterChain("type=aws_instance:aws_ebs_volume,tag:Env=prod")
+	if !ok {
+		t.Fatal("failed to parse filter chain")
+	}
+	expected := FilterChain{
+		TypeFilter{Types: []string{"aws_instance", "aws_ebs_volume"}},
+		TagFilter{Key: "Env", Values: []string{"prod"}},
+	}
+	if !reflect.DeepEqual(chain, expected) {
+		t.Errorf("failed to parse, got %v", chain)
+	}
+}
+
+func TestFilterChainIgnoresOtherSyntax(t *testing.T) {
+	for _, rawFilter := range []string{"aws_vpc=myid", "Type=sg;Name=vpc_id;Value=VPC_ID", "Name=tags.Env;Value=prod"} {
+		if _, ok := ParseFilterChain(rawFilter); ok {
+			t.Errorf("%s should not be parsed as filter chain", rawFilter)
+		}
+	}
+}
+
+func TestFilterChain(t *testing.T) {
+	cases := map[string][]string{
+		"type=aws_instance,tag:Env=prod": {"i-1"},
+		"tag:Env=prod":                   {"i-1", "bucket"},
+		"id=vol-1:bucket":                {"vol-1", "bucket"},
+		"name=^web-":                     {"i-1"},
+		"region=europe-west1":            {"i-1", "vol-1", "bucket"},
+		"region=us-east1":                {"i-1", "vol-1"},
+		"type=storage_bucket":            {"bucket"},
+	}
+	for rawFilter, expected := range cases {
+		chain, ok := ParseFilterChain(rawFilter)
+		if !ok {
+			t.Fatalf("failed to parse %s", rawFilter)
+		}
+		if ids := filteredIDs(chain.Filter(filterTestResources())); !reflect.DeepEqual(ids, expected) {
+			t.Errorf("%s: expected %v, got %v", rawFilter, expected, ids)
+		}
+	}
+}
+
+func TestServiceParsesFilterChain(t *testing.T) {
+	service := Service{}
+	service.ParseFilters([]string{"aws_vpc=myid", "type=aws_instance"})
+
+	if len(service.Filter) != 1 || len(service.FilterChain) != 1 {
+		t.Errorf("failed to split filters, got %v and %v", service.Filter, service.FilterChain)
+	}
+}
+
+func TestServiceAppliesTypeAndIDFiltersBeforeRefresh(t *testing.T) {
+	service := Service{Resources: filterTestResources()}
+	service.ParseFilters([]string{"id=i-1:vol-1", "tag:Env=prod"})
+
+	service.InitialCleanup()
+	if ids := filteredIDs(service.Resources); !reflect.DeepEqual(ids, []string{"i-1", "vol-1"}) {
+		t.Errorf("expected id filter before refresh, got %v", ids)
+	}
+	service.PostRefreshCleanup()
+	if ids := filteredIDs(service.Resources); !reflect.DeepEqual(ids, []string{"i-1"}) {
+		t.Errorf("expected tag filter after refresh, got %v", ids)
+	}
+}
+
+func TestSkipResourceTypes(t *testing.T) {
+	patterns, err := LoadSkipResourceTypes(strings.NewReader(`# IAM is managed in separate repository
+aws_iam_*
+
+*_policy_document # data sources
+  aws_route  
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(patterns, []string{"aws_iam_*", "*_policy_document", "aws_route"}) {
+		t.Fatalf("unexpected patterns %v", patterns)
+	}
+	resources := []Resource{
+		prepareNoAttrs("1", "aws_iam_role"),
+		prepareNoAttrs("2", "aws_iam_policy_document"),
+		prepareNoAttrs("3", "aws_route"),
+		prepareNoAttrs("4", "aws_route_table"),
+		prepareNoAttrs("5", "aws_vpc"),
+	}
+	filtered := SkipTypeFilter{Patterns: patterns}.Filter(resources)
+	if len(filtered) != 2 || filtered[0].InstanceState.ID != "4" || filtered[1].InstanceState.ID != "5" {
+		t.Errorf("unexpected resources %v", filtered)
+	}
+	if _, err := LoadSkipResourceTypes(strings.NewReader("aws_[iam")); err == nil {
+		t.Errorf("expected error for invalid pattern")
+	}
+}
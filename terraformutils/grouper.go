@@ -0,0 +1,68 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// UngroupedName is name of group of resources grouping function returns empty name for
+const UngroupedName = "ungrouped"
+
+var groupNameRegexp = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// ResourceGrouper splits resources into named groups, each group is written as separate Terraform module
+type ResourceGrouper struct {
+	// GroupBy returns group name of resource, e.g. VPC ID, environment tag or account ID
+	GroupBy func(Resource) string
+}
+
+func NewResourceGrouper(groupBy func(Resource) string) ResourceGrouper {
+	return ResourceGrouper{GroupBy: groupBy}
+}
+
+// Group returns resources by group name, names are sanitized to be valid directory and module names,
+// order of resources is kept in each group
+func (g ResourceGrouper) Group(resources []Resource) map[string][]Resource {
+	groups := map[string][]Resource{}
+	for _, r := range resources {
+		name := groupName(g.GroupBy(r))
+		groups[name] = append(groups[name], r)
+	}
+	return groups
+}
+
+func groupName(name string) string {
+	name = strings.Trim(groupNameRegexp.ReplaceAllString(name, "_"), "_")
+	if name == "" {
+		return UngroupedName
+	}
+	// module names must begin with letter or underscore
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "group_" + name
+	}
+	return name
+}
+
+// GroupByAttribute returns grouping function by value of attribute in resource state, e.g. vpc_id or tags.Environment
+func GroupByAttribute(attribute string) func(Resource) string {
+	return func(r Resource) string {
+		if r.InstanceState == nil {
+			return ""
+		}
+		return r.InstanceState.Attributes[attribute]
+	}
+}
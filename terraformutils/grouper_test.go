@@ -0,0 +1,67 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceGrouper(t *testing.T) {
+	newResource := func(name, vpcID string) Resource {
+		r := NewSimpleResource(name, name, "aws_subnet", "aws", []string{})
+		if vpcID != "" {
+			r.InstanceState.Attributes["vpc_id"] = vpcID
+		}
+		return r
+	}
+	resources := []Resource{
+		newResource("a", "vpc-1"),
+		newResource("b", "vpc-2"),
+		newResource("c", "vpc-1"),
+		newResource("d", ""),
+		newResource("e", "123456789012"),
+	}
+	groups := NewResourceGrouper(GroupByAttribute("vpc_id")).Group(resources)
+
+	expected := map[string][]string{
+		"vpc-1":              {"tfer--a", "tfer--c"},
+		"vpc-2":              {"tfer--b"},
+		UngroupedName:        {"tfer--d"},
+		"group_123456789012": {"tfer--e"},
+	}
+	actual := map[string][]string{}
+	for name, group := range groups {
+		for _, r := range group {
+			actual[name] = append(actual[name], r.ResourceName)
+		}
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestGroupName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"prod":            "prod",
+		"arn:aws:iam::1:": "arn_aws_iam_1",
+		"  ":              UngroupedName,
+		"1-app":           "group_1-app",
+	} {
+		if actual := groupName(name); actual != expected {
+			t.Errorf("expected %s for %q, got %s", expected, name, actual)
+		}
+	}
+}
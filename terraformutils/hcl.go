@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"regexp"
 	"strings"
@@ -34,6 +35,8 @@ const safeChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ012345678
 
 var unsafeChars = regexp.MustCompile(`[^0-9A-Za-z_]`)
 
+var providerReferenceRegexp = regexp.MustCompile(`(?m)^(\s*provider\s*=\s*)"([0-9A-Za-z_-]+\.[0-9A-Za-z_-]+)"$`)
+
 // sanitizer fixes up an invalid HCL AST, as produced by the HCL parser for JSON
 type astSanitizer struct{}
 
@@ -85,9 +88,8 @@ func (v *astSanitizer) visitObjectItem(o *ast.ObjectItem) {
 	switch t := o.Val.(type) {
 	case *ast.LiteralType: // heredoc support
 		if strings.HasPrefix(t.Token.Text, `"<<`) {
-			t.Token.Text = t.Token.Text[1:]
-			t.Token.Text = t.Token.Text[:len(t.Token.Text)-1]
-			t.Token.Text = strings.ReplaceAll(t.Token.Text, `\n`, "\n")
+			quoted := t.Token.Text[1 : len(t.Token.Text)-1]
+			t.Token.Text = strings.ReplaceAll(quoted, `\n`, "\n")
 			t.Token.Text = strings.ReplaceAll(t.Token.Text, `\t`, "")
 			t.Token.Type = 10
 			// check if text json for Unquote and Indent
@@ -109,6 +111,18 @@ func (v *astSanitizer) visitObjectItem(o *ast.ObjectItem) {
 					hereDoc := strings.Join(jsonData, "\n")
 					t.Token.Text = hereDoc
 				}
+			} else {
+				// heredoc content isn't escaped, keep quotes, backslashes and tabs as is
+				t.Token.Text = unescapeHeredoc(quoted)
+			}
+		} else if strings.Contains(t.Token.Text, "${") {
+			t.Token.Text = unescapeInterpolations(t.Token.Text)
+		}
+	case *ast.ListType: // heredoc support for list of statements
+		for _, item := range t.List {
+			if l, ok := item.(*ast.LiteralType); ok && strings.HasPrefix(l.Token.Text, `"<<`) {
+				l.Token.Text = unescapeHeredoc(l.Token.Text[1 : len(l.Token.Text)-1])
+				l.Token.Type = 10
 			}
 		}
 	default:
@@ -120,6 +134,85 @@ func (v *astSanitizer) visitObjectItem(o *ast.ObjectItem) {
 	v.visit(o.Val)
 }
 
+// unescapeHeredoc reverts string escaping done by the printer for heredoc values
+func unescapeHeredoc(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' && i+1 < len(text) {
+			i++
+			switch text[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(text[i])
+			}
+			continue
+		}
+		b.WriteByte(text[i])
+	}
+	return b.String()
+}
+
+// unescapeInterpolations removes quote and backslash escaping inside ${...} sequences,
+// terraform 0.12 doesn't accept escaped quotes in expressions like ${file(\"name\")}.
+// Escaped literals $${ are kept as is, both outside and inside strings of expressions
+func unescapeInterpolations(text string) string {
+	var b strings.Builder
+	// open contexts of expression, '{' for expressions and braces, '"' for strings inside them
+	var stack []byte
+	escaped := false
+	for i := 0; i < len(text); {
+		if len(stack) == 0 {
+			switch {
+			case strings.HasPrefix(text[i:], "$${"):
+				b.WriteString("$${")
+				i += 3
+			case strings.HasPrefix(text[i:], "${"):
+				b.WriteString("${")
+				stack = append(stack, '{')
+				i += 2
+			default:
+				b.WriteByte(text[i])
+				i++
+			}
+			continue
+		}
+		c, size := text[i], 1
+		if c == '\\' && i+1 < len(text) && (text[i+1] == '\\' || text[i+1] == '"') {
+			c, size = text[i+1], 2
+		}
+		top := stack[len(stack)-1]
+		switch {
+		case top == '"' && escaped:
+			escaped = false
+		case top == '"' && c == '\\':
+			escaped = true
+		case top == '"' && c == '"':
+			stack = stack[:len(stack)-1]
+		case top == '"' && strings.HasPrefix(text[i:], "$${"):
+			b.WriteString("$${")
+			i += 3
+			continue
+		case top == '"' && strings.HasPrefix(text[i:], "${"):
+			b.WriteString("${")
+			stack = append(stack, '{')
+			i += 2
+			continue
+		case c == '"':
+			stack = append(stack, '"')
+		case c == '{':
+			stack = append(stack, '{')
+		case c == '}':
+			stack = stack[:len(stack)-1]
+		}
+		b.WriteByte(c)
+		i += size
+	}
+	return b.String()
+}
+
 func Print(data interface{}, mapsObjects map[string]struct{}, format string) ([]byte, error) {
 	switch format {
 	case "hcl":
@@ -166,6 +259,7 @@ func hclPrint(data interface{}, mapsObjects map[string]struct{}) ([]byte, error)
 	formatted = terraform12Adjustments(formatted, mapsObjects)
 	// hack for support terraform 0.13
 	formatted = terraform13Adjustments(formatted)
+	formatted = dependsOnAdjustments(formatted)
 	if err != nil {
 		log.Println("Invalid HCL follows:")
 		for i, line := range strings.Split(s, "\n") {
@@ -195,8 +289,12 @@ func terraform12Adjustments(formatted []byte, mapsObjects map[string]struct{}) [
 			continue
 		}
 		key := strings.Trim(strings.Split(line, old)[0], " ")
-		prefix = append(prefix, key)
-		if _, exist := mapsObjects[strings.Join(prefix, ".")]; exist {
+		path := strings.Join(append(prefix[:len(prefix):len(prefix)], key), ".")
+		// empty blocks like `basic = {}` are closed on the same line
+		if !strings.HasSuffix(line, "}") {
+			prefix = append(prefix, key)
+		}
+		if _, exist := mapsObjects[path]; exist {
 			continue
 		}
 		lines[i] = strings.ReplaceAll(line, old, newEquals)
@@ -205,6 +303,26 @@ func terraform12Adjustments(formatted []byte, mapsObjects map[string]struct{}) [
 	return []byte(s)
 }
 
+// dependsOnAdjustments unquotes references in depends_on lists, HCL printer can only write them as strings
+func dependsOnAdjustments(formatted []byte) []byte {
+	lines := strings.Split(string(formatted), "\n")
+	inDependsOn := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "depends_on ") || strings.HasPrefix(trimmed, "depends_on=") {
+			inDependsOn = true
+		}
+		if !inDependsOn {
+			continue
+		}
+		lines[i] = strings.ReplaceAll(line, `"`, "")
+		if strings.HasSuffix(trimmed, "]") {
+			inDependsOn = false
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
 func terraform13Adjustments(formatted []byte) []byte {
 	s := string(formatted)
 	oldRequiredProviders := "\"required_providers\""
@@ -234,10 +352,15 @@ func TfSanitize(name string) string {
 }
 
 // Print hcl file from TerraformResource + provider
+// Output is byte-identical for the same input: maps are marshaled to JSON with sorted keys before
+// parsing, sanitizer walks the AST in source order and post-processing is line based.
+// Resources are sorted by ResourceSorter first, so output doesn't depend on their order either
 func HclPrintResource(resources []Resource, providerData map[string]interface{}, output string) ([]byte, error) {
+	resources = ResourceSorter{}.Sort(resources)
 	resourcesByType := map[string]map[string]interface{}{}
 	mapsObjects := map[string]struct{}{}
 	indexRe := regexp.MustCompile(`\.[0-9]+`)
+	hasProviderAlias := false
 	for _, res := range resources {
 		r := resourcesByType[res.InstanceInfo.Type]
 		if r == nil {
@@ -252,6 +375,15 @@ func HclPrintResource(resources []Resource, providerData map[string]interface{},
 		}
 
 		r[res.ResourceName] = res.Item
+		if res.ProviderAlias != "" {
+			item := map[string]interface{}{}
+			for k, v := range res.Item {
+				item[k] = v
+			}
+			item["provider"] = res.ProviderReference()
+			r[res.ResourceName] = item
+			hasProviderAlias = true
+		}
 
 		for k := range res.InstanceState.Attributes {
 			if strings.HasSuffix(k, ".%") {
@@ -274,5 +406,84 @@ func HclPrintResource(resources []Resource, providerData map[string]interface{},
 	if err != nil {
 		return []byte{}, err
 	}
+	if hasProviderAlias && output == "hcl" {
+		// provider reference is an expression, not a string
+		hclBytes = providerReferenceRegexp.ReplaceAll(hclBytes, []byte("$1$2"))
+	}
+	if output == "hcl" {
+		for _, res := range resources {
+			if res.Comment != "" {
+				hclBytes = addResourceComment(hclBytes, res)
+			}
+		}
+	}
 	return hclBytes, nil
 }
+
+// addResourceComment inserts comment of resource above its block
+func addResourceComment(hclBytes []byte, res Resource) []byte {
+	block := []byte(fmt.Sprintf("resource %q %q {", res.InstanceInfo.Type, res.ResourceName))
+	var comment bytes.Buffer
+	for _, line := range strings.Split(strings.TrimRight(res.Comment, "\n"), "\n") {
+		comment.WriteString("# " + line + "\n")
+	}
+	comment.Write(block)
+	return bytes.Replace(hclBytes, block, comment.Bytes(), 1)
+}
+
+// Default number of resources printed at once by HclPrintStream
+const HclPrintStreamChunkSize = 1000
+
+type flusher interface {
+	Flush() error
+}
+
+// HclPrintStream prints hcl from resources channel without keeping all of them in memory
+func HclPrintStream(resources <-chan Resource, providerData map[string]interface{}, w io.Writer) error {
+	return HclPrintStreamChunks(resources, providerData, w, HclPrintStreamChunkSize)
+}
+
+// HclPrintStreamChunks prints resources in chunks of chunkSize, provider is printed with first chunk,
+// w is flushed after each chunk when it supports it
+func HclPrintStreamChunks(resources <-chan Resource, providerData map[string]interface{}, w io.Writer, chunkSize int) error {
+	if chunkSize <= 0 {
+		chunkSize = HclPrintStreamChunkSize
+	}
+	printed := map[string]struct{}{}
+	chunk := make([]Resource, 0, chunkSize)
+	printChunk := func() error {
+		if len(chunk) == 0 && len(providerData) == 0 {
+			return nil
+		}
+		hclBytes, err := HclPrintResource(chunk, providerData, "hcl")
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(hclBytes); err != nil {
+			return err
+		}
+		if f, ok := w.(flusher); ok {
+			if err := f.Flush(); err != nil {
+				return err
+			}
+		}
+		providerData = nil
+		chunk = chunk[:0]
+		return nil
+	}
+	for res := range resources {
+		key := res.Type().TFAddress(res.ResourceName)
+		if _, exist := printed[key]; exist {
+			log.Printf("[ERR]: duplicate resource found: %s", key)
+			continue
+		}
+		printed[key] = struct{}{}
+		chunk = append(chunk, res)
+		if len(chunk) == chunkSize {
+			if err := printChunk(); err != nil {
+				return err
+			}
+		}
+	}
+	return printChunk()
+}
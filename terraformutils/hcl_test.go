@@ -15,35 +15,16 @@
 package terraformutils
 
 import (
+	"bytes"
+	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 )
 
 func TestPrintResource(t *testing.T) {
-	var resources []Resource
-	var nested []map[string]interface{}
-	nested = append(nested, mapI("field1", "egg"))
-	importResource := prepare("ID1", "type1", map[string]string{
-		"type1":                  "ID2",
-		"map1.%":                 "1",
-		"map1.foo":               "bar",
-		"nested.#":               "1",
-		"nested.0.map1.#":        "1",
-		"nested.0.map1.0.field1": "egg",
-		"nested2.#":              "1",
-		"nested2.0.field1":       "spam",
-		"nested2.0.map2.%":       "1",
-		"nested2.0.map2.foo":     "bar",
-	}, map[string]interface{}{
-		"type1":   "ID2",
-		"map1":    mapI("foo", "bar"),
-		"nested":  mapI("map1", nested),
-		"nested2": map[string]interface{}{"map2": mapI("bar", "foo"), "field1": "egg"},
-	})
-	resources = append(resources, importResource)
-	providerData := map[string]interface{}{}
-	output := "hcl"
-	data, _ := HclPrintResource(resources, providerData, output)
+	resources := fixtureResources(t, "test_data/hcl/resource.json")
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
 
 	if strings.Count(string(data), "map1 = ") != 1 {
 		t.Errorf("failed to parse data %s", string(data))
@@ -52,3 +33,208 @@ func TestPrintResource(t *testing.T) {
 		t.Errorf("failed to parse data %s", string(data))
 	}
 }
+
+func TestTfSanitize(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:iam::123456789:role/MyRole": "tfer--arn-003A-aws-003A-iam-003A--003A-123456789-003A-role-002F-MyRole",
+		"arn:aws:sns:eu-west-1:123:topic":    "tfer--arn-003A-aws-003A-sns-003A-eu-002D-west-002D-1-003A-123-003A-topic",
+		"my.bucket/*":                        "tfer--my-002E-bucket-002F--002A-",
+		"plain_name":                         "tfer--plain_name",
+	}
+	for name, expected := range tests {
+		sanitized := TfSanitize(name)
+		if sanitized != expected {
+			t.Errorf("TfSanitize(%q) = %q, expected %q", name, sanitized, expected)
+		}
+		if !regexp.MustCompile(`^[A-Za-z_][0-9A-Za-z_-]*$`).MatchString(sanitized) {
+			t.Errorf("TfSanitize(%q) = %q is not valid terraform identifier", name, sanitized)
+		}
+	}
+}
+
+func TestPrintResourceWithFunctionCall(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/function_call.json")
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+
+	if !strings.Contains(string(data), `content = "${file("${path.module}/worker.js")}"`) {
+		t.Errorf("failed to unescape interpolation %s", string(data))
+	}
+	if !strings.Contains(string(data), `literal = "say \"hi\" $${name}"`) {
+		t.Errorf("failed to keep literal escaping %s", string(data))
+	}
+}
+
+func TestPrintResourceWithEscapedInterpolation(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/escaped_interpolation.json")
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+
+	for _, expected := range []string{
+		`escaped   = "echo $$${HOME} $${USER}"`,
+		`backslash = "${replace(var.path, "\\", "/")}"`,
+		`nested    = "${format("$${%s}", "a")}"`,
+	} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("failed to keep %s in %s", expected, string(data))
+		}
+	}
+}
+
+func TestPrintResourceWithTextHeredoc(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/text_heredoc.json")
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+
+	expected := "content = <<EOT\nif (req.url ~ \"^/static\") {\n\tset req.http.X-Path = \"a\\\\b\";\n}\nEOT"
+	if !strings.Contains(string(data), expected) {
+		t.Errorf("failed to keep heredoc content %s", string(data))
+	}
+}
+
+func TestPrintResourceWithHTMLCharacters(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/html_characters.json")
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+
+	expected := `message = "{{#is_alert}}<b>CPU</b> high{{/is_alert}} @slack-ops & @john.doe@example.com"`
+	if !strings.Contains(string(data), expected) {
+		t.Errorf("failed to keep message verbatim %s", string(data))
+	}
+}
+
+func TestPrintResourceWithHeredocList(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/heredoc_list.json")
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+
+	if !strings.Contains(string(data), "<<DDL\nCREATE TABLE t (\n  id INT64\n) PRIMARY KEY (id)\nDDL") {
+		t.Errorf("failed to print heredoc in list %s", string(data))
+	}
+	if strings.Index(string(data), "CREATE TABLE") > strings.Index(string(data), "CREATE INDEX") {
+		t.Errorf("failed to keep order of list %s", string(data))
+	}
+}
+
+func TestPrintResourceWithEmptyBlock(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/empty_block.json")
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+
+	if !strings.Contains(string(data), "basic {}") {
+		t.Errorf("failed to print empty block %s", string(data))
+	}
+	if !strings.Contains(string(data), "tags = {") {
+		t.Errorf("failed to print map after empty block %s", string(data))
+	}
+}
+
+func TestPrintResourceWithProviderAlias(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/provider_alias.json")
+	importResource := resources[0]
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+
+	if !regexp.MustCompile(`provider\s+= aws\.primary\n`).Match(data) {
+		t.Errorf("failed to print provider alias %s", string(data))
+	}
+	if _, ok := importResource.Item["provider"]; ok {
+		t.Errorf("provider alias leaked into resource item")
+	}
+}
+
+func TestPrintResourceWithDependsOn(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/depends_on.json")
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+
+	if !strings.Contains(string(data), "depends_on = [aws_securityhub_account.tfer--123456789012]") {
+		t.Errorf("failed to unquote depends_on references %s", string(data))
+	}
+}
+
+func TestPrintResourceWithComment(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/comment.json")
+	resources[0].Comment = "first line\nsecond line"
+	data, _ := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+
+	if !strings.HasPrefix(string(data), "# first line\n# second line\nresource \"type1\" \"tfer--name-002D-type1\" {") {
+		t.Errorf("failed to print comment %s", string(data))
+	}
+}
+
+func TestPrintResourceIdempotency(t *testing.T) {
+	resources := fixtureResources(t, "test_data/hcl/idempotency.json")
+	providerData := map[string]interface{}{"provider": map[string]interface{}{"aws": map[string]interface{}{"region": "eu-west-1", "version": "~> 3.0"}}}
+
+	expected, err := HclPrintResource(resources, providerData, "hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		data, err := HclPrintResource(resources, providerData, "hcl")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected, data) {
+			t.Fatalf("output of call %d differs:\n%s\n---\n%s", i, expected, data)
+		}
+	}
+}
+
+func TestPrintResourceStream(t *testing.T) {
+	fixture := fixtureResources(t, "test_data/hcl/stream.json")
+	resources := make(chan Resource, 3)
+	resources <- fixture[0]
+	resources <- fixture[1]
+	resources <- fixture[0]
+	close(resources)
+
+	var buffer bytes.Buffer
+	providerData := map[string]interface{}{"type1": map[string]interface{}{"version": "1.0"}}
+	if err := HclPrintStreamChunks(resources, providerData, &buffer, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	data := buffer.String()
+	if strings.Count(data, `resource "type1"`) != 2 {
+		t.Errorf("failed to print resources once %s", data)
+	}
+	if strings.Count(data, `provider "type1"`) != 1 {
+		t.Errorf("failed to print provider once %s", data)
+	}
+}
+
+func benchmarkResources(count int) []Resource {
+	resources := make([]Resource, 0, count)
+	for i := 0; i < count; i++ {
+		id := fmt.Sprintf("id-%d", i)
+		resource := NewResource(id, id, "type1", "provider", map[string]string{
+			"name":     id,
+			"tags.%":   "1",
+			"tags.foo": "bar",
+		}, []string{}, map[string]interface{}{})
+		resource.Item = map[string]interface{}{
+			"name":   id,
+			"tags":   mapI("foo", "bar"),
+			"nested": []interface{}{mapI("field1", "egg")},
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+func benchmarkHclPrint(b *testing.B, count int) {
+	resources := benchmarkResources(count)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := HclPrintResource(resources, map[string]interface{}{}, "hcl"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkHclPrint1K(b *testing.B) {
+	benchmarkHclPrint(b, 1000)
+}
+
+func BenchmarkHclPrint10K(b *testing.B) {
+	benchmarkHclPrint(b, 10000)
+}
+
+func BenchmarkHclPrint100K(b *testing.B) {
+	benchmarkHclPrint(b, 100000)
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/providers"
+)
+
+type LintSeverity string
+
+const (
+	LintWarning LintSeverity = "WARNING"
+	LintError   LintSeverity = "ERROR"
+)
+
+// LintIssue is quality issue of generated resource, Attribute is empty for issues of whole resource
+type LintIssue struct {
+	Severity     LintSeverity
+	ResourceType string
+	ResourceName string
+	Attribute    string
+	Message      string
+}
+
+func (i LintIssue) String() string {
+	address := ResourceType(i.ResourceType).TFAddress(i.ResourceName)
+	if i.Attribute != "" {
+		address += "." + i.Attribute
+	}
+	return fmt.Sprintf("%s: %s: %s", i.Severity, address, i.Message)
+}
+
+var awsAccountIDRegexp = regexp.MustCompile(`(^|[^0-9])[0-9]{12}([^0-9]|$)`)
+
+var leadingDigitRegexp = regexp.MustCompile(`^[0-9]`)
+
+// HclLint checks generated resources for common import quality issues
+func HclLint(resources []Resource) []LintIssue {
+	return HclLintWithSchema(resources, nil)
+}
+
+// HclLintWithSchema checks generated resources, with provider schema computed-only attributes are reported too
+func HclLintWithSchema(resources []Resource, schema *providers.GetSchemaResponse) []LintIssue {
+	issues := []LintIssue{}
+	names := map[string]bool{}
+	for _, r := range resources {
+		newIssue := func(severity LintSeverity, attribute, message string) LintIssue {
+			return LintIssue{
+				Severity:     severity,
+				ResourceType: r.InstanceInfo.Type,
+				ResourceName: r.ResourceName,
+				Attribute:    attribute,
+				Message:      message,
+			}
+		}
+		address := r.Type().TFAddress(r.ResourceName)
+		if names[address] {
+			issues = append(issues, newIssue(LintError, "", "duplicate resource name"))
+		}
+		names[address] = true
+		if leadingDigitRegexp.MatchString(r.ResourceName) {
+			issues = append(issues, newIssue(LintError, "", "resource name must not begin with a digit"))
+		}
+
+		keys := make([]string, 0, len(r.Item))
+		for key := range r.Item {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if isComputedOnly(schema, r.InstanceInfo.Type, key) {
+				issues = append(issues, newIssue(LintError, key, "attribute is computed by provider and can't be set"))
+			}
+			lintValue(key, r.Item[key], func(attribute, message string) {
+				issues = append(issues, newIssue(LintWarning, attribute, message))
+			})
+		}
+	}
+	return issues
+}
+
+func isComputedOnly(schema *providers.GetSchemaResponse, resourceType, attribute string) bool {
+	if schema == nil {
+		return false
+	}
+	resourceSchema, exist := schema.ResourceTypes[resourceType]
+	if !exist || resourceSchema.Block == nil {
+		return false
+	}
+	attributeSchema, exist := resourceSchema.Block.Attributes[attribute]
+	return exist && attributeSchema.Computed && !attributeSchema.Optional && !attributeSchema.Required
+}
+
+// lintValue reports empty strings and raw AWS account IDs in value and its nested blocks
+func lintValue(attribute string, value interface{}, report func(attribute, message string)) {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			report(attribute, "empty string should be null")
+		} else if !strings.Contains(v, "${") && awsAccountIDRegexp.MatchString(v) {
+			report(attribute, "contains AWS account ID, consider a variable")
+		}
+	case []interface{}:
+		for i, item := range v {
+			lintValue(fmt.Sprintf("%s.%d", attribute, i), item, report)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			lintValue(attribute+"."+key, v[key], report)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestHclLint(t *testing.T) {
+	role := prepare("role", "aws_iam_role", map[string]string{}, map[string]interface{}{
+		"arn":                "arn:aws:iam::123456789012:role/role",
+		"description":        "",
+		"assume_role_policy": "${data.aws_iam_policy_document.123456789012.json}",
+		"tags":               map[string]interface{}{"Owner": ""},
+	})
+	duplicate := prepare("role2", "aws_iam_role", map[string]string{}, map[string]interface{}{})
+	digit := prepare("1", "aws_vpc", map[string]string{}, map[string]interface{}{})
+	digit.ResourceName = "1vpc"
+	schema := &providers.GetSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"aws_iam_role": {Block: &configschema.Block{Attributes: map[string]*configschema.Attribute{
+				"arn":         {Type: cty.String, Computed: true},
+				"description": {Type: cty.String, Optional: true},
+			}}},
+		},
+	}
+
+	expected := []string{
+		"ERROR: aws_iam_role.tfer--name-002D-aws_iam_role.arn: attribute is computed by provider and can't be set",
+		"WARNING: aws_iam_role.tfer--name-002D-aws_iam_role.arn: contains AWS account ID, consider a variable",
+		"WARNING: aws_iam_role.tfer--name-002D-aws_iam_role.description: empty string should be null",
+		"WARNING: aws_iam_role.tfer--name-002D-aws_iam_role.tags.Owner: empty string should be null",
+		"ERROR: aws_iam_role.tfer--name-002D-aws_iam_role: duplicate resource name",
+		"ERROR: aws_vpc.1vpc: resource name must not begin with a digit",
+	}
+	issues := HclLintWithSchema([]Resource{role, duplicate, digit}, schema)
+	if len(issues) != len(expected) {
+		t.Fatalf("expected %d issues, got %v", len(expected), issues)
+	}
+	for i, issue := range issues {
+		if issue.String() != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], issue.String())
+		}
+	}
+	if issues := HclLint([]Resource{role}); len(issues) != 3 {
+		t.Errorf("expected computed-only check to be skipped without schema, got %v", issues)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ProviderMock is provider returning fixture resources instead of listing them from cloud API,
+// it lets provider agnostic pipeline code be tested without cloud account
+type ProviderMock struct {
+	Provider
+	Name         string
+	ProviderData map[string]interface{}
+	Connections  map[string]map[string][]string
+}
+
+// serviceMock has its resources set already, listing them is no-op
+type serviceMock struct {
+	Service
+}
+
+func (s *serviceMock) InitResources() error {
+	return nil
+}
+
+func NewProviderMock(resources []Resource) *ProviderMock {
+	service := &serviceMock{}
+	service.SetName("mock")
+	service.SetProviderName("mock")
+	service.SetResources(resources)
+	return &ProviderMock{
+		Provider:     Provider{Service: service, Config: cty.EmptyObjectVal},
+		Name:         "mock",
+		ProviderData: map[string]interface{}{},
+		Connections:  map[string]map[string][]string{},
+	}
+}
+
+// fixtureJSON is part of fixture which isn't serialized by Resource.ToJSON, refreshed state attributes in flatmap form
+type fixtureJSON struct {
+	Attributes map[string]string `json:"attributes"`
+}
+
+// FromJSONFixture sets resources of mock from JSON array of resources serialized by Resource.ToJSON,
+// each resource can also have flatmap state "attributes", id attribute is set from id as in refreshed state
+func (p *ProviderMock) FromJSONFixture(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var fixtures []json.RawMessage
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return fmt.Errorf("error unmarshalling fixture %s: %v", path, err)
+	}
+	resources := []Resource{}
+	for i, fixture := range fixtures {
+		resource, err := FromJSON(fixture)
+		if err != nil {
+			return fmt.Errorf("fixture %s resource %d: %v", path, i, err)
+		}
+		var state fixtureJSON
+		if err := json.Unmarshal(fixture, &state); err != nil {
+			return fmt.Errorf("fixture %s resource %d: %v", path, i, err)
+		}
+		for key, value := range state.Attributes {
+			resource.InstanceState.Attributes[key] = value
+		}
+		resource.InstanceState.Attributes["id"] = resource.InstanceState.ID
+		if resource.Item == nil {
+			resource.Item = map[string]interface{}{}
+		}
+		resources = append(resources, resource)
+	}
+	p.Service.SetResources(resources)
+	return nil
+}
+
+func (p *ProviderMock) Init(args []string) error {
+	return nil
+}
+
+func (p *ProviderMock) InitService(serviceName string, verbose bool) error {
+	p.Service.SetName(serviceName)
+	p.Service.SetVerbose(verbose)
+	return nil
+}
+
+func (p *ProviderMock) GetName() string {
+	return p.Name
+}
+
+func (p *ProviderMock) GetSupportedService() map[string]ServiceGenerator {
+	return map[string]ServiceGenerator{p.Service.GetName(): p.Service}
+}
+
+func (p *ProviderMock) GenerateFiles() {}
+
+func (p *ProviderMock) GenerateOutputPath() error {
+	return nil
+}
+
+func (p *ProviderMock) GetProviderData(arg ...string) map[string]interface{} {
+	return p.ProviderData
+}
+
+func (p *ProviderMock) GetResourceConnections() map[string]map[string][]string {
+	return p.Connections
+}
+
+// GetResources returns fixture resources as modified by pipeline so far
+func (p *ProviderMock) GetResources() []Resource {
+	return p.Service.GetResources()
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var _ ProviderGenerator = &ProviderMock{}
+
+func TestProviderMockFromJSONFixture(t *testing.T) {
+	provider := NewProviderMock(nil)
+	if err := provider.FromJSONFixture("test_data/provider_mock.json"); err != nil {
+		t.Fatal(err)
+	}
+	resources := provider.GetResources()
+	if len(resources) != 2 || resources[1].InstanceInfo.Id != "aws_subnet.tfer--private" {
+		t.Fatalf("unexpected fixture resources %v", resources)
+	}
+
+	data, err := HclPrintResource(resources, map[string]interface{}{}, "hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `vpc_id     = "${aws_vpc.tfer--main.id}"`) {
+		t.Errorf("unexpected HCL:\n%s", data)
+	}
+}
+
+func TestProviderMockFromJSONFixtureInvalid(t *testing.T) {
+	if err := NewProviderMock(nil).FromJSONFixture("test_data/missing.json"); err == nil {
+		t.Error("expected error for missing fixture")
+	}
+}
+
+// fixtureResources loads resources of JSON fixture through ProviderMock
+func fixtureResources(t *testing.T, path string) []Resource {
+	t.Helper()
+	provider := NewProviderMock(nil)
+	if err := provider.FromJSONFixture(path); err != nil {
+		t.Fatal(err)
+	}
+	return provider.GetResources()
+}
+
+// fixtureServices loads resources of each service from <dir>/<service>.json fixtures
+func fixtureServices(t *testing.T, dir string) map[string][]Resource {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("no fixtures in %s: %v", dir, err)
+	}
+	services := map[string][]Resource{}
+	for _, path := range paths {
+		services[strings.TrimSuffix(filepath.Base(path), ".json")] = fixtureResources(t, path)
+	}
+	return services
+}
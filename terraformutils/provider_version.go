@@ -0,0 +1,122 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	version "github.com/hashicorp/go-version"
+)
+
+// providerVersionMatrix maps provider name to minimum provider version supporting resource type,
+// types missing in matrix are supported by all versions
+var providerVersionMatrix = map[string]map[string]string{
+	"aws": {
+		"aws_cloudfront_function":                         "3.41.0",
+		"aws_cloudwatch_event_bus":                        "3.8.0",
+		"aws_opensearch_domain":                           "4.10.0",
+		"aws_ssoadmin_account_assignment":                 "3.24.0",
+		"aws_ssoadmin_instance_access_control_attributes": "3.70.0",
+		"aws_ssoadmin_managed_policy_attachment":          "3.24.0",
+		"aws_ssoadmin_permission_set":                     "3.24.0",
+		"aws_wafv2_ip_set":                                "2.70.0",
+		"aws_wafv2_regex_pattern_set":                     "2.70.0",
+		"aws_wafv2_rule_group":                            "2.70.0",
+		"aws_wafv2_web_acl":                               "2.70.0",
+		"aws_wafv2_web_acl_association":                   "2.70.0",
+	},
+	"azurerm": {
+		"azurerm_linux_virtual_machine":   "1.43.0",
+		"azurerm_windows_virtual_machine": "1.43.0",
+		"azurerm_linux_web_app":           "3.0.0",
+		"azurerm_windows_web_app":         "3.0.0",
+	},
+	"google": {
+		"google_iam_workload_identity_pool":          "4.11.0",
+		"google_iam_workload_identity_pool_provider": "4.11.0",
+	},
+}
+
+// VersionWarning is resource type which requires newer provider version than detected one
+type VersionWarning struct {
+	ProviderName    string
+	ResourceType    string
+	MinimumVersion  string
+	DetectedVersion string
+}
+
+func (w VersionWarning) String() string {
+	return fmt.Sprintf("%s requires %s provider %s or newer, detected %s", w.ResourceType, w.ProviderName, w.MinimumVersion, w.DetectedVersion)
+}
+
+// ProviderVersionChecker compares detected provider version with minimum versions of imported resource types
+type ProviderVersionChecker struct {
+	Matrix map[string]map[string]string
+}
+
+func NewProviderVersionChecker() ProviderVersionChecker {
+	return ProviderVersionChecker{Matrix: providerVersionMatrix}
+}
+
+// Check returns warning for each resource type newer than detectedVersion, detectedVersion can be constraint
+// as returned by providerwrapper.GetProviderVersion, e.g. "~> 2.40.0". Unparsable version produces no warnings
+func (c ProviderVersionChecker) Check(providerName, detectedVersion string, resources []Resource) []VersionWarning {
+	warnings := []VersionWarning{}
+	detected, err := version.NewVersion(strings.TrimLeft(detectedVersion, "~>=< "))
+	if err != nil {
+		return warnings
+	}
+	checked := map[string]bool{}
+	for _, r := range resources {
+		resourceType := r.InstanceInfo.Type
+		if checked[resourceType] {
+			continue
+		}
+		checked[resourceType] = true
+		minimumVersion, exist := c.Matrix[providerName][resourceType]
+		if !exist {
+			continue
+		}
+		if detected.LessThan(version.Must(version.NewVersion(minimumVersion))) {
+			warnings = append(warnings, VersionWarning{
+				ProviderName:    providerName,
+				ResourceType:    resourceType,
+				MinimumVersion:  minimumVersion,
+				DetectedVersion: detected.String(),
+			})
+		}
+	}
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i].ResourceType < warnings[j].ResourceType
+	})
+	return warnings
+}
+
+// SuggestedConstraint returns provider version constraint satisfying all warnings, empty without warnings
+func (c ProviderVersionChecker) SuggestedConstraint(warnings []VersionWarning) string {
+	var highest *version.Version
+	for _, w := range warnings {
+		v := version.Must(version.NewVersion(w.MinimumVersion))
+		if highest == nil || v.GreaterThan(highest) {
+			highest = v
+		}
+	}
+	if highest == nil {
+		return ""
+	}
+	return ">= " + highest.String()
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"testing"
+)
+
+func TestProviderVersionChecker(t *testing.T) {
+	checker := ProviderVersionChecker{Matrix: map[string]map[string]string{
+		"azurerm": {
+			"azurerm_linux_virtual_machine": "1.43.0",
+			"azurerm_linux_web_app":         "3.0.0",
+		},
+	}}
+	resources := []Resource{
+		NewSimpleResource("1", "vm1", "azurerm_linux_virtual_machine", "azurerm", []string{}),
+		NewSimpleResource("2", "vm2", "azurerm_linux_virtual_machine", "azurerm", []string{}),
+		NewSimpleResource("3", "app", "azurerm_linux_web_app", "azurerm", []string{}),
+		NewSimpleResource("4", "rg", "azurerm_resource_group", "azurerm", []string{}),
+	}
+
+	warnings := checker.Check("azurerm", "~> 1.30.0", resources)
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", warnings)
+	}
+	if warnings[0].String() != "azurerm_linux_virtual_machine requires azurerm provider 1.43.0 or newer, detected 1.30.0" {
+		t.Errorf("unexpected warning %s", warnings[0])
+	}
+	if constraint := checker.SuggestedConstraint(warnings); constraint != ">= 3.0.0" {
+		t.Errorf("expected >= 3.0.0, got %s", constraint)
+	}
+
+	warnings = checker.Check("azurerm", "~> 2.40.0", resources)
+	if len(warnings) != 1 || warnings[0].ResourceType != "azurerm_linux_web_app" {
+		t.Errorf("expected warning for azurerm_linux_web_app, got %v", warnings)
+	}
+	if warnings := checker.Check("azurerm", "3.0.0", resources); len(warnings) != 0 {
+		t.Errorf("expected no warnings, got %v", warnings)
+	}
+	if warnings := checker.Check("azurerm", "", resources); len(warnings) != 0 {
+		t.Errorf("expected no warnings for unknown version, got %v", warnings)
+	}
+	if constraint := checker.SuggestedConstraint(nil); constraint != "" {
+		t.Errorf("expected empty constraint, got %s", constraint)
+	}
+}
@@ -15,6 +15,7 @@
 package terraformutils
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -37,6 +38,12 @@ type Resource struct {
 	AllowEmptyValues  []string               `json:",omitempty"`
 	AdditionalFields  map[string]interface{} `json:",omitempty"`
 	SlowQueryRequired bool
+	// DataFiles are written next to generated HCL files, keyed by file name
+	DataFiles map[string][]byte `json:",omitempty"`
+	// ProviderAlias is alias of provider configuration resource is imported with, empty for default provider
+	ProviderAlias string `json:",omitempty"`
+	// Comment is printed above resource block in HCL output, each line prefixed with #
+	Comment string `json:",omitempty"`
 }
 
 type ApplicableFilter interface {
@@ -51,7 +58,7 @@ type ResourceFilter struct {
 }
 
 func (rf *ResourceFilter) Filter(resource Resource) bool {
-	if !rf.IsApplicable(strings.TrimPrefix(resource.InstanceInfo.Type, resource.Provider+"_")) {
+	if !rf.IsApplicable(resource.ServiceName()) {
 		return true
 	}
 	var vals []interface{}
@@ -95,13 +102,57 @@ func NewResource(id, resourceName, resourceType, provider string,
 		},
 		InstanceInfo: &terraform.InstanceInfo{
 			Type: resourceType,
-			Id:   fmt.Sprintf("%s.%s", resourceType, TfSanitize(resourceName)),
+			Id:   ResourceType(resourceType).TFAddress(TfSanitize(resourceName)),
 		},
 		AdditionalFields: additionalFields,
 		AllowEmptyValues: allowEmptyValues,
 	}
 }
 
+// resourceJSON is self-describing serialized form of Resource
+type resourceJSON struct {
+	Type          string                 `json:"type"`
+	Name          string                 `json:"name"`
+	Item          map[string]interface{} `json:"item"`
+	ID            string                 `json:"id"`
+	Provider      string                 `json:"provider"`
+	ProviderAlias string                 `json:"provider_alias,omitempty"`
+}
+
+// ToJSON serializes resource without HCL round-trip, inverse of FromJSON
+func (r Resource) ToJSON() ([]byte, error) {
+	data := resourceJSON{
+		Name:          r.ResourceName,
+		Item:          r.Item,
+		Provider:      r.Provider,
+		ProviderAlias: r.ProviderAlias,
+	}
+	if r.InstanceInfo != nil {
+		data.Type = r.InstanceInfo.Type
+	}
+	if r.InstanceState != nil {
+		data.ID = r.InstanceState.ID
+	}
+	return json.Marshal(data)
+}
+
+func FromJSON(data []byte) (Resource, error) {
+	var parsed resourceJSON
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return Resource{}, fmt.Errorf("error unmarshalling resource from json: %v", err)
+	}
+	if parsed.Type == "" || parsed.Name == "" {
+		return Resource{}, fmt.Errorf("resource json must contain type and name")
+	}
+	resource := NewSimpleResource(parsed.ID, parsed.Name, parsed.Type, parsed.Provider, []string{})
+	// name was sanitized on serialization already
+	resource.ResourceName = parsed.Name
+	resource.InstanceInfo.Id = ResourceType(parsed.Type).TFAddress(parsed.Name)
+	resource.Item = parsed.Item
+	resource.ProviderAlias = parsed.ProviderAlias
+	return resource, nil
+}
+
 func NewSimpleResource(id, resourceName, resourceType, provider string, allowEmptyValues []string) Resource {
 	return NewResource(
 		id,
@@ -169,5 +220,5 @@ func (r *Resource) ConvertTFstate(provider *providerwrapper.ProviderWrapper) err
 }
 
 func (r *Resource) ServiceName() string {
-	return strings.TrimPrefix(r.InstanceInfo.Type, r.Provider+"_")
+	return strings.TrimPrefix(string(r.Type()), r.Provider+"_")
 }
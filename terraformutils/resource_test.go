@@ -0,0 +1,59 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceJSONRoundTrip(t *testing.T) {
+	resource := NewSimpleResource("vpc-123", "my vpc", "aws_vpc", "aws", []string{})
+	resource.ProviderAlias = "eu-west-1"
+	resource.Item = map[string]interface{}{
+		"cidr_block": "10.0.0.0/16",
+		"tags": map[string]interface{}{
+			"Name": "my vpc",
+		},
+	}
+
+	data, err := resource.ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := FromJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.ResourceName != resource.ResourceName {
+		t.Errorf("expected name %s, got %s", resource.ResourceName, parsed.ResourceName)
+	}
+	if parsed.InstanceInfo.Id != resource.InstanceInfo.Id || parsed.InstanceInfo.Type != "aws_vpc" {
+		t.Errorf("unexpected instance info %v", parsed.InstanceInfo)
+	}
+	if parsed.InstanceState.ID != "vpc-123" || parsed.Provider != "aws" || parsed.ProviderAlias != "eu-west-1" {
+		t.Errorf("unexpected id %s, provider %s or provider alias %s", parsed.InstanceState.ID, parsed.Provider, parsed.ProviderAlias)
+	}
+	if !reflect.DeepEqual(parsed.Item, resource.Item) {
+		t.Errorf("expected item %v, got %v", resource.Item, parsed.Item)
+	}
+}
+
+func TestResourceFromJSONWithoutType(t *testing.T) {
+	if _, err := FromJSON([]byte(`{"name": "tfer--vpc"}`)); err == nil {
+		t.Error("expected error for resource without type")
+	}
+}
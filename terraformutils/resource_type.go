@@ -0,0 +1,109 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/providers"
+)
+
+const dataPrefix = "data."
+
+// ResourceType is terraform resource type e.g. aws_iam_role, data sources are prefixed with data.
+type ResourceType string
+
+func (t ResourceType) IsData() bool {
+	return strings.HasPrefix(string(t), dataPrefix)
+}
+
+func (t ResourceType) components() []string {
+	return strings.Split(strings.TrimPrefix(string(t), dataPrefix), "_")
+}
+
+// Provider returns prefix before first _, aws for aws_iam_role
+func (t ResourceType) Provider() string {
+	return t.components()[0]
+}
+
+// Category returns second component, iam for aws_iam_role
+func (t ResourceType) Category() string {
+	components := t.components()
+	if len(components) < 2 {
+		return ""
+	}
+	return components[1]
+}
+
+// TFAddress returns address of resource with given name e.g. aws_iam_role.name
+func (t ResourceType) TFAddress(name string) string {
+	return string(t) + "." + name
+}
+
+func (r Resource) Type() ResourceType {
+	return ResourceType(r.InstanceInfo.Type)
+}
+
+// ProviderReference returns provider configuration of resource, aws.<alias> when it's imported with alias
+func (r Resource) ProviderReference() string {
+	provider := r.Provider
+	if provider == "" {
+		provider = r.Type().Provider()
+	}
+	if r.ProviderAlias == "" {
+		return provider
+	}
+	return provider + "." + r.ProviderAlias
+}
+
+// ResourceTypeDescriptor describes capabilities of resource type from provider schema
+type ResourceTypeDescriptor struct {
+	Type     ResourceType
+	taggable bool
+}
+
+func NewResourceTypeDescriptor(resourceType ResourceType, schema *providers.GetSchemaResponse) ResourceTypeDescriptor {
+	descriptor := ResourceTypeDescriptor{Type: resourceType}
+	if s, exist := schema.ResourceTypes[string(resourceType)]; exist && s.Block != nil {
+		_, descriptor.taggable = s.Block.Attributes["tags"]
+	}
+	return descriptor
+}
+
+// Taggable returns true when resource type has tags argument
+func (d ResourceTypeDescriptor) Taggable() bool {
+	return d.taggable
+}
+
+// FilterTaggableResources drops resources of types without tags argument
+func FilterTaggableResources(resources []Resource, schema *providers.GetSchemaResponse) []Resource {
+	descriptors := map[ResourceType]ResourceTypeDescriptor{}
+	filteredResources := []Resource{}
+	for _, resource := range resources {
+		descriptor, exist := descriptors[resource.Type()]
+		if !exist {
+			descriptor = NewResourceTypeDescriptor(resource.Type(), schema)
+			descriptors[resource.Type()] = descriptor
+			if !descriptor.Taggable() {
+				log.Println("Skipping not taggable resource type " + string(resource.Type()))
+			}
+		}
+		if descriptor.Taggable() {
+			filteredResources = append(filteredResources, resource)
+		}
+	}
+	return filteredResources
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestResourceType(t *testing.T) {
+	cases := []struct {
+		resourceType ResourceType
+		provider     string
+		category     string
+		isData       bool
+		address      string
+	}{
+		{"aws_iam_role", "aws", "iam", false, "aws_iam_role.name"},
+		{"google_compute_instance", "google", "compute", false, "google_compute_instance.name"},
+		{"data.aws_iam_policy_document", "aws", "iam", true, "data.aws_iam_policy_document.name"},
+		{"heroku", "heroku", "", false, "heroku.name"},
+	}
+	for _, c := range cases {
+		if c.resourceType.Provider() != c.provider {
+			t.Errorf("%s: expected provider %s, got %s", c.resourceType, c.provider, c.resourceType.Provider())
+		}
+		if c.resourceType.Category() != c.category {
+			t.Errorf("%s: expected category %s, got %s", c.resourceType, c.category, c.resourceType.Category())
+		}
+		if c.resourceType.IsData() != c.isData {
+			t.Errorf("%s: expected data %t", c.resourceType, c.isData)
+		}
+		if c.resourceType.TFAddress("name") != c.address {
+			t.Errorf("%s: expected address %s, got %s", c.resourceType, c.address, c.resourceType.TFAddress("name"))
+		}
+	}
+}
+
+func TestFilterTaggableResources(t *testing.T) {
+	schema := &providers.GetSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"aws_vpc": {Block: &configschema.Block{Attributes: map[string]*configschema.Attribute{
+				"tags": {Type: cty.Map(cty.String), Optional: true},
+			}}},
+			"aws_route": {Block: &configschema.Block{Attributes: map[string]*configschema.Attribute{
+				"route_table_id": {Type: cty.String, Required: true},
+			}}},
+		},
+	}
+	if !NewResourceTypeDescriptor("aws_vpc", schema).Taggable() {
+		t.Errorf("aws_vpc must be taggable")
+	}
+	if NewResourceTypeDescriptor("aws_unknown", schema).Taggable() {
+		t.Errorf("type missing in schema must not be taggable")
+	}
+	resources := []Resource{
+		prepareNoAttrs("vpc-1", "aws_vpc"),
+		prepareNoAttrs("r-1", "aws_route"),
+		prepareNoAttrs("vpc-2", "aws_vpc"),
+	}
+	filtered := FilterTaggableResources(resources, schema)
+	if len(filtered) != 2 || filtered[0].InstanceState.ID != "vpc-1" || filtered[1].InstanceState.ID != "vpc-2" {
+		t.Errorf("unexpected filtered resources %v", filtered)
+	}
+}
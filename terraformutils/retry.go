@@ -0,0 +1,95 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryTransport retries requests throttled (429) or failed with temporary server error (502, 503, 504),
+// it waits as long as Retry-After or X-RateLimit-Reset header asks or with exponential backoff without them
+type RetryTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+	// MinBackoff is first wait without rate limit headers, it's doubled with each retry up to MaxBackoff
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+func NewRetryTransport(base http.RoundTripper) *RetryTransport {
+	return &RetryTransport{
+		Base:       base,
+		MaxRetries: 5,
+		MinBackoff: time.Second,
+		MaxBackoff: time.Minute,
+	}
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := t.MinBackoff
+	for attempt := 0; ; attempt++ {
+		resp, err := t.Base.RoundTrip(req)
+		if err != nil || !isRetryableStatus(resp.StatusCode) || attempt >= t.MaxRetries {
+			return resp, err
+		}
+		// request body was read already, it can be retried only when it can be recreated
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+		wait := t.retryAfter(resp, backoff)
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		backoff *= 2
+	}
+}
+
+// retryAfter returns wait requested by rate limit headers of response, backoff without them
+func (t *RetryTransport) retryAfter(resp *http.Response, backoff time.Duration) time.Duration {
+	wait := backoff
+	for _, header := range []string{"Retry-After", "X-RateLimit-Reset"} {
+		if seconds, err := strconv.Atoi(resp.Header.Get(header)); err == nil && seconds >= 0 {
+			wait = time.Duration(seconds) * time.Second
+			break
+		}
+	}
+	if wait > t.MaxBackoff {
+		wait = t.MaxBackoff
+	}
+	return wait
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -46,6 +46,7 @@ type Service struct {
 	ProviderName string
 	Args         map[string]interface{}
 	Filter       []ResourceFilter
+	FilterChain  FilterChain
 	Verbose      bool
 }
 
@@ -63,7 +64,12 @@ func (s *Service) SetVerbose(verbose bool) {
 
 func (s *Service) ParseFilters(rawFilters []string) {
 	s.Filter = []ResourceFilter{}
+	s.FilterChain = FilterChain{}
 	for _, rawFilter := range rawFilters {
+		if chain, ok := ParseFilterChain(rawFilter); ok {
+			s.FilterChain = append(s.FilterChain, chain...)
+			continue
+		}
 		filters := s.ParseFilter(rawFilter)
 		s.Filter = append(s.Filter, filters...)
 	}
@@ -114,14 +120,29 @@ func (s *Service) GetName() string {
 	return s.Name
 }
 
+// InitialCleanup applies id and type filters before refresh, so filtered out resources aren't refreshed
 func (s *Service) InitialCleanup() {
 	FilterCleanup(s, true)
 }
 
+// PostRefreshCleanup applies filters which need refreshed attributes
 func (s *Service) PostRefreshCleanup() {
-	if len(s.Filter) != 0 {
-		FilterCleanup(s, false)
+	FilterCleanup(s, false)
+}
+
+// filterChain returns filters of service for one phase, filters parsed by ParseFilter come first
+func (s *Service) filterChain(isInitial bool) FilterChain {
+	chain := FilterChain{}
+	var filters resourceFilters
+	for _, filter := range s.Filter {
+		if filter.isInitial() == isInitial {
+			filters = append(filters, filter)
+		}
+	}
+	if len(filters) != 0 {
+		chain = append(chain, filters)
 	}
+	return append(chain, s.FilterChain.Phase(isInitial)...)
 }
 
 func (s *Service) GetArgs() map[string]interface{} {
@@ -0,0 +1,38 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import "sort"
+
+// ResourceSorter orders resources canonically so re-imports of same infrastructure produce same output
+// regardless of order cloud APIs list resources in
+type ResourceSorter struct{}
+
+// Sort returns copy of resources ordered by type, then by name, resources with same type and name
+// are ordered by ID. Names are compared as printed, NewResource sanitizes them already
+func (ResourceSorter) Sort(resources []Resource) []Resource {
+	sorted := make([]Resource, len(resources))
+	copy(sorted, resources)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if typeI, typeJ := sorted[i].InstanceInfo.Type, sorted[j].InstanceInfo.Type; typeI != typeJ {
+			return typeI < typeJ
+		}
+		if sorted[i].ResourceName != sorted[j].ResourceName {
+			return sorted[i].ResourceName < sorted[j].ResourceName
+		}
+		return sorted[i].InstanceState.ID < sorted[j].InstanceState.ID
+	})
+	return sorted
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResourceSorterSort(t *testing.T) {
+	resources := []Resource{
+		NewSimpleResource("subnet-2", "b", "aws_subnet", "aws", []string{}),
+		NewSimpleResource("vpc-1", "main", "aws_vpc", "aws", []string{}),
+		NewSimpleResource("subnet-1", "a", "aws_subnet", "aws", []string{}),
+		NewSimpleResource("sg-2", "default", "aws_security_group", "aws", []string{}),
+		NewSimpleResource("sg-1", "default", "aws_security_group", "aws", []string{}),
+	}
+	sorted := ResourceSorter{}.Sort(resources)
+
+	expected := []string{"sg-1", "sg-2", "subnet-1", "subnet-2", "vpc-1"}
+	for i, r := range sorted {
+		if r.InstanceState.ID != expected[i] {
+			t.Errorf("expected %s at %d, got %s", expected[i], i, r.InstanceState.ID)
+		}
+	}
+	if resources[0].InstanceState.ID != "subnet-2" {
+		t.Errorf("sort shouldn't reorder input resources")
+	}
+}
+
+func TestHclPrintResourceOrderIndependent(t *testing.T) {
+	first := prepare("ID1", "type1", map[string]string{}, map[string]interface{}{"name": "first"})
+	second := prepare("ID2", "type1", map[string]string{}, map[string]interface{}{"name": "second"})
+	third := prepare("ID3", "type2", map[string]string{}, map[string]interface{}{"name": "third"})
+
+	forward, err := HclPrintResource([]Resource{first, second, third}, map[string]interface{}{}, "hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	backward, err := HclPrintResource([]Resource{third, second, first}, map[string]interface{}{}, "hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(forward) != string(backward) {
+		t.Errorf("output depends on order of resources:\n%s\n%s", forward, backward)
+	}
+	if !strings.Contains(string(forward), `"first"`) {
+		t.Errorf("expected duplicate with lowest ID to be printed:\n%s", forward)
+	}
+}
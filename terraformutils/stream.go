@@ -0,0 +1,96 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+)
+
+// maxStreamLineSize limits size of one resource in stream, large policies and schemas fit in it
+const maxStreamLineSize = 16 * 1024 * 1024
+
+// StreamResources writes resources received from resources channel as newline-delimited JSON (see Resource.ToJSON)
+// to w as soon as they arrive. Post refresh cleanup and PostConvertHook of current service run on each resource alone,
+// so hooks linking resources to each other leave values as they are. Stops when channel is closed or ctx is done
+func (p *Provider) StreamResources(ctx context.Context, w io.Writer, resources <-chan Resource) error {
+	for {
+		var resource Resource
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case r, ok := <-resources:
+			if !ok {
+				return nil
+			}
+			resource = r
+		}
+		p.Service.SetResources([]Resource{resource})
+		p.Service.PostRefreshCleanup()
+		if len(p.Service.GetResources()) == 0 {
+			continue
+		}
+		if err := p.Service.PostConvertHook(); err != nil {
+			return err
+		}
+		for _, resource := range p.Service.GetResources() {
+			data, err := resource.ToJSON()
+			if err != nil {
+				return err
+			}
+			if _, err := w.Write(append(data, '\n')); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ReadResourceStream decodes newline-delimited JSON resources written by StreamResources, resources channel
+// is closed at end of stream, first error stops reading and is sent to errors channel before it's closed.
+// Reading stops with ctx error when ctx is done, so reader goroutine doesn't outlive consumer
+func ReadResourceStream(ctx context.Context, r io.Reader) (<-chan Resource, <-chan error) {
+	resources := make(chan Resource)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(resources)
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), maxStreamLineSize)
+		line := 0
+		for scanner.Scan() {
+			line++
+			if len(scanner.Bytes()) == 0 {
+				continue
+			}
+			resource, err := FromJSON(scanner.Bytes())
+			if err != nil {
+				errs <- fmt.Errorf("line %d: %v", line, err)
+				return
+			}
+			select {
+			case resources <- resource:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			errs <- err
+		}
+	}()
+	return resources, errs
+}
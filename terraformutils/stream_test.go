@@ -0,0 +1,98 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestStreamResources(t *testing.T) {
+	vpc := NewSimpleResource("vpc-1", "vpc", "aws_vpc", "aws", []string{})
+	vpc.Item = map[string]interface{}{"cidr_block": "10.0.0.0/16"}
+	subnet := NewSimpleResource("subnet-1", "subnet", "aws_subnet", "aws", []string{})
+	subnet.Item = map[string]interface{}{"vpc_id": "${aws_vpc.tfer--vpc.id}"}
+	provider := NewProviderMock([]Resource{})
+	input := make(chan Resource, 2)
+	input <- vpc
+	input <- subnet
+	close(input)
+
+	var b bytes.Buffer
+	if err := provider.StreamResources(context.Background(), &b, input); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimSpace(b.String()), "\n"); len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %s", len(lines), b.String())
+	}
+
+	resources, errs := ReadResourceStream(context.Background(), &b)
+	var types []string
+	for resource := range resources {
+		types = append(types, resource.InstanceInfo.Type)
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(types, ",") != "aws_vpc,aws_subnet" {
+		t.Errorf("unexpected resources %v", types)
+	}
+}
+
+func TestStreamResourcesCanceled(t *testing.T) {
+	provider := NewProviderMock([]Resource{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var b bytes.Buffer
+	// nothing is ever sent, stream has to end on canceled context
+	if err := provider.StreamResources(ctx, &b, make(chan Resource)); err == nil || b.Len() != 0 {
+		t.Errorf("expected canceled stream without output, got %v and %q", err, b.String())
+	}
+}
+
+func TestReadResourceStreamError(t *testing.T) {
+	resources, errs := ReadResourceStream(context.Background(), strings.NewReader(`{"type": "aws_vpc", "name": "tfer--vpc", "id": "vpc-1"}
+
+{"name": "tfer--subnet"}
+`))
+	count := 0
+	for range resources {
+		count++
+	}
+	if count != 1 {
+		t.Errorf("expected 1 resource before error, got %d", count)
+	}
+	if err := <-errs; err == nil || !strings.HasPrefix(err.Error(), "line 3:") {
+		t.Errorf("expected error on line 3, got %v", err)
+	}
+}
+
+func TestReadResourceStreamCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	resources, errs := ReadResourceStream(ctx, strings.NewReader(`{"type": "aws_vpc", "name": "tfer--vpc", "id": "vpc-1"}
+{"type": "aws_vpc", "name": "tfer--vpc2", "id": "vpc-2"}
+`))
+	<-resources
+	cancel()
+	// reader stops without second resource being received
+	if err := <-errs; err != context.Canceled {
+		t.Errorf("expected canceled error, got %v", err)
+	}
+	if _, ok := <-resources; ok {
+		t.Error("expected closed resources channel")
+	}
+}
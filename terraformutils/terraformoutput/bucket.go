@@ -46,7 +46,9 @@ func (b BucketState) BucketPrefix(path string) string {
 	return strings.TrimSuffix(path, "/")
 }
 
-func (b BucketState) BucketUpload(path string, file []byte) error {
+// BucketUpload uploads state, compressed state is stored with gzip content encoding
+// so GCS decompresses it for terraform backend
+func (b BucketState) BucketUpload(path string, file []byte, isCompressed bool) error {
 	ctx := context.Background()
 	client, err := storage.NewClient(ctx)
 	if err != nil {
@@ -54,10 +56,14 @@ func (b BucketState) BucketUpload(path string, file []byte) error {
 	}
 	name := strings.ReplaceAll(b.Name, "gs://", "")
 	wc := client.Bucket(name).Object(b.BucketPrefix(path) + "/default.tfstate").NewWriter(ctx)
-	if _, err = wc.Write(file); err != nil {
+	if isCompressed {
+		wc.ContentEncoding = "gzip"
+	}
+	writer := NewCompressedWriter(wc, "default.tfstate", isCompressed)
+	if _, err = writer.Write(file); err != nil {
 		return err
 	}
-	if err := wc.Close(); err != nil {
+	if err := writer.Close(); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,76 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// GzipExtension is added to names of compressed files
+const GzipExtension = ".gz"
+
+// CompressedWriter gzip-compresses data written to underlying writer when compression is enabled,
+// otherwise data are written as is
+type CompressedWriter struct {
+	w  io.Writer
+	gz *gzip.Writer
+}
+
+// NewCompressedWriter compresses output when compress is set or fileName ends with .gz
+func NewCompressedWriter(w io.Writer, fileName string, compress bool) *CompressedWriter {
+	writer := &CompressedWriter{w: w}
+	if compress || strings.HasSuffix(fileName, GzipExtension) {
+		writer.gz = gzip.NewWriter(w)
+	}
+	return writer
+}
+
+func (c *CompressedWriter) IsCompressed() bool {
+	return c.gz != nil
+}
+
+func (c *CompressedWriter) Write(p []byte) (int, error) {
+	if c.gz != nil {
+		return c.gz.Write(p)
+	}
+	return c.w.Write(p)
+}
+
+// Close flushes compressed data and closes underlying writer when it's closable
+func (c *CompressedWriter) Close() error {
+	if c.gz != nil {
+		if err := c.gz.Close(); err != nil {
+			return err
+		}
+	}
+	if closer, ok := c.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// DecompressReader returns reader of uncompressed data, gzip compressed input is detected by its header
+func DecompressReader(r io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(r)
+	header, err := buffered.Peek(2)
+	if err == nil && header[0] == 0x1f && header[1] == 0x8b {
+		return gzip.NewReader(buffered)
+	}
+	return ioutil.NopCloser(buffered), nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func TestCompressedWriterRoundTrip(t *testing.T) {
+	for fileName, compress := range map[string]bool{
+		"resources.tf":    false,
+		"resources.tf.gz": false,
+		"instance.tf":     true,
+	} {
+		var buffer bytes.Buffer
+		writer := NewCompressedWriter(&buffer, fileName, compress)
+		if _, err := writer.Write([]byte(`resource "type" "name" {}`)); err != nil {
+			t.Fatal(err)
+		}
+		if err := writer.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if writer.IsCompressed() == bytes.HasPrefix(buffer.Bytes(), []byte("resource")) {
+			t.Errorf("%s: unexpected compression of %q", fileName, buffer.String())
+		}
+
+		reader, err := DecompressReader(&buffer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `resource "type" "name" {}` {
+			t.Errorf("%s: failed to decompress, got %q", fileName, string(data))
+		}
+	}
+}
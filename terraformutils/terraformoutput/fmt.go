@@ -0,0 +1,52 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"errors"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// TerraformFmt runs terraform fmt -recursive on path and returns files which were re-formatted,
+// error wraps exec.ErrNotFound when terraform binary isn't installed
+func TerraformFmt(path string) ([]string, error) {
+	output, err := exec.Command("terraform", "fmt", "-recursive", "-list=true", path).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, errors.New("terraform fmt: " + strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, err
+	}
+	return parseFmtOutput(path, string(output)), nil
+}
+
+// parseFmtOutput returns files listed by terraform fmt, paths are relative to directory fmt was run in
+func parseFmtOutput(path, output string) []string {
+	files := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		file := strings.TrimSpace(line)
+		if file == "" {
+			continue
+		}
+		if !filepath.IsAbs(file) && !strings.HasPrefix(filepath.Clean(file), filepath.Clean(path)) {
+			file = filepath.Join(path, file)
+		}
+		files = append(files, file)
+	}
+	return files
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFmtOutput(t *testing.T) {
+	output := "generated/aws/vpc/vpc.tf\n\ngenerated/aws/vpc/provider.tf\n"
+	expected := []string{"generated/aws/vpc/vpc.tf", "generated/aws/vpc/provider.tf"}
+	if files := parseFmtOutput("generated/aws/vpc/", output); !reflect.DeepEqual(files, expected) {
+		t.Errorf("expected %v, got %v", expected, files)
+	}
+	if files := parseFmtOutput("generated/aws/vpc", "subnet.tf\n"); !reflect.DeepEqual(files, []string{"generated/aws/vpc/subnet.tf"}) {
+		t.Errorf("expected file joined with path, got %v", files)
+	}
+	if files := parseFmtOutput("generated", ""); len(files) != 0 {
+		t.Errorf("expected no files, got %v", files)
+	}
+}
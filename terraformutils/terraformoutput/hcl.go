@@ -17,6 +17,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
@@ -25,19 +26,35 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
-func OutputHclFiles(resources []terraformutils.Resource, provider terraformutils.ProviderGenerator, path string, serviceName string, isCompact bool, output string) error {
+const sensitiveVariablesRequiredVersion = ">= 0.14"
+
+var sensitiveVariableRegexp = regexp.MustCompile(`(?m)^\s*sensitive\s*=\s*true\s*$`)
+
+func OutputHclFiles(resources []terraformutils.Resource, provider terraformutils.ProviderGenerator, path string, serviceName string, isCompact bool, output string, isCompressed bool, providerAlias string) error {
 	if err := os.MkdirAll(path, os.ModePerm); err != nil {
 		return err
 	}
 	// create provider file
 	providerData := provider.GetProviderData()
-	providerData["terraform"] = map[string]interface{}{
+	if providerConfigs, ok := providerData["provider"].(map[string]interface{}); ok && providerAlias != "" {
+		for _, providerConfig := range providerConfigs {
+			if config, ok := providerConfig.(map[string]interface{}); ok {
+				config["alias"] = providerAlias
+			}
+		}
+	}
+	terraformBlock := map[string]interface{}{
 		"required_providers": []map[string]interface{}{{
 			provider.GetName(): []map[string]interface{}{{
 				"version": providerwrapper.GetProviderVersion(provider.GetName()),
 			}},
 		}},
 	}
+	if hasSensitiveVariables(resources) {
+		// sensitive argument of variables is supported since terraform 0.14
+		terraformBlock["required_version"] = sensitiveVariablesRequiredVersion
+	}
+	providerData["terraform"] = terraformBlock
 
 	providerDataFile, err := terraformutils.Print(providerData, map[string]struct{}{}, output)
 	if err != nil {
@@ -52,7 +69,7 @@ func OutputHclFiles(resources []terraformutils.Resource, provider terraformutils
 	for i, r := range resources {
 		outputState := map[string]*terraform.OutputState{}
 		outputsByResource[r.InstanceInfo.Type+"_"+r.ResourceName+"_"+r.GetIDKey()] = map[string]interface{}{
-			"value": r.InstanceInfo.Type + "." + r.ResourceName + "." + r.GetIDKey(),
+			"value": r.Type().TFAddress(r.ResourceName) + "." + r.GetIDKey(),
 		}
 		outputState[r.InstanceInfo.Type+"_"+r.ResourceName+"_"+r.GetIDKey()] = &terraform.OutputState{
 			Type:  "string",
@@ -61,18 +78,19 @@ func OutputHclFiles(resources []terraformutils.Resource, provider terraformutils
 		for _, v := range provider.GetResourceConnections() {
 			for k, ids := range v {
 				if (serviceName != "" && k == serviceName) || (serviceName == "" && k == r.ServiceName()) {
-					if _, exist := r.InstanceState.Attributes[ids[1]]; exist {
-						key := ids[1]
-						if ids[1] == "self_link" || ids[1] == "id" {
+					_, attribute := terraformutils.ConnectionKey(ids[1])
+					if _, exist := r.InstanceState.Attributes[attribute]; exist {
+						key := attribute
+						if attribute == "self_link" || attribute == "id" {
 							key = r.GetIDKey()
 						}
 						linkKey := r.InstanceInfo.Type + "_" + r.ResourceName + "_" + key
 						outputsByResource[linkKey] = map[string]interface{}{
-							"value": r.InstanceInfo.Type + "." + r.ResourceName + "." + key,
+							"value": r.Type().TFAddress(r.ResourceName) + "." + key,
 						}
 						outputState[linkKey] = &terraform.OutputState{
 							Type:  "string",
-							Value: r.InstanceState.Attributes[ids[1]],
+							Value: r.InstanceState.Attributes[attribute],
 						}
 					}
 				}
@@ -94,15 +112,20 @@ func OutputHclFiles(resources []terraformutils.Resource, provider terraformutils
 	for _, r := range resources {
 		typeOfServices[r.InstanceInfo.Type] = append(typeOfServices[r.InstanceInfo.Type], r)
 	}
+	for _, r := range resources {
+		for fileName, data := range r.DataFiles {
+			printDataFile(path, fileName, data)
+		}
+	}
 	if isCompact {
-		err := printFile(resources, "resources", path, output)
+		err := printFile(resources, "resources", path, output, isCompressed)
 		if err != nil {
 			return err
 		}
 	} else {
 		for k, v := range typeOfServices {
 			fileName := strings.ReplaceAll(k, strings.Split(k, "_")[0]+"_", "")
-			err := printFile(v, fileName, path, output)
+			err := printFile(v, fileName, path, output, isCompressed)
 			if err != nil {
 				return err
 			}
@@ -111,16 +134,25 @@ func OutputHclFiles(resources []terraformutils.Resource, provider terraformutils
 	return nil
 }
 
-func printFile(v []terraformutils.Resource, fileName, path, output string) error {
+func printFile(v []terraformutils.Resource, fileName, path, output string, isCompressed bool) error {
 	tfFile, err := terraformutils.HclPrintResource(v, map[string]interface{}{}, output)
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(path+"/"+fileName+"."+GetFileExtension(output), tfFile, os.ModePerm)
+	filePath := path + "/" + fileName + "." + GetFileExtension(output)
+	if isCompressed {
+		filePath += GzipExtension
+	}
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.ModePerm)
 	if err != nil {
 		return err
 	}
-	return nil
+	writer := NewCompressedWriter(file, filePath, isCompressed)
+	if _, err := writer.Write(tfFile); err != nil {
+		_ = writer.Close()
+		return err
+	}
+	return writer.Close()
 }
 
 func PrintFile(path string, data []byte) {
@@ -131,9 +163,38 @@ func PrintFile(path string, data []byte) {
 	}
 }
 
+// secretDataFileExtension marks data files with values of variables, they are readable by owner only
+const secretDataFileExtension = ".tfvars"
+
+func printDataFile(path, fileName string, data []byte) {
+	if !strings.HasSuffix(fileName, secretDataFileExtension) {
+		PrintFile(path+"/"+fileName, data)
+		return
+	}
+	// file mode isn't changed by WriteFile when file exists already
+	if err := ioutil.WriteFile(path+"/"+fileName, data, 0600); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.Chmod(path+"/"+fileName, 0600); err != nil {
+		log.Fatal(err)
+	}
+}
+
 func GetFileExtension(outputFormat string) string {
 	if outputFormat == "json" {
 		return "tf.json"
 	}
 	return "tf"
 }
+
+// hasSensitiveVariables reports if resources declare sensitive variables in their data files
+func hasSensitiveVariables(resources []terraformutils.Resource) bool {
+	for _, resource := range resources {
+		for fileName, data := range resource.DataFiles {
+			if strings.HasPrefix(fileName, "variables_") && sensitiveVariableRegexp.Match(data) {
+				return true
+			}
+		}
+	}
+	return false
+}
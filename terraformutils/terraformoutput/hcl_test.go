@@ -0,0 +1,65 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestPrintDataFileSecretMode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datafiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// existing file keeps its mode on write, it has to be changed too
+	if err := ioutil.WriteFile(dir+"/values.tfvars", []byte{}, 0644); err != nil {
+		t.Fatal(err)
+	}
+	printDataFile(dir, "values.tfvars", []byte("password = \"secret\"\n"))
+	printDataFile(dir, "variables_password.tf", []byte("variable \"password\" {}\n"))
+
+	info, err := os.Stat(dir + "/values.tfvars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected tfvars file to be readable by owner only, got %v", info.Mode().Perm())
+	}
+	info, err = os.Stat(dir + "/variables_password.tf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm()&0044 == 0 {
+		t.Errorf("expected variables file to keep default mode, got %v", info.Mode().Perm())
+	}
+}
+
+func TestHasSensitiveVariables(t *testing.T) {
+	user := terraformutils.NewSimpleResource("db-1", "db", "google_sql_user", "google", []string{})
+	user.AddSensitiveVariable("db_password", "password of user db")
+	network := terraformutils.NewSimpleResource("default", "default", "google_compute_network", "google", []string{})
+	network.AddVariable(terraformutils.Variable{Name: "network_name", Description: "name of network"})
+	if hasSensitiveVariables([]terraformutils.Resource{network}) {
+		t.Error("expected variables not to be sensitive")
+	}
+	if !hasSensitiveVariables([]terraformutils.Resource{network, user}) {
+		t.Error("expected sensitive variable to be found")
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// referencesFile declares variables and outputs module uses to reference resources of other modules
+const referencesFile = "references"
+
+var referenceRegexp = regexp.MustCompile(`\$\{((?:data\.)?\w+\.[\w-]+)\.([\w-]+)\}`)
+
+// OutputModules writes each group of resources to its own directory with its own provider file,
+// root main file in path calls each group as module. Variables of resources are declared in root too
+// and passed to modules. References between resources of different groups are rewritten
+// to variables of module passed from outputs of module with referenced resource
+func OutputModules(groups map[string][]terraformutils.Resource, provider terraformutils.ProviderGenerator, path string, serviceName string, isCompact bool, output string, isCompressed bool, providerAlias string) error {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	inputs, outputs := rewriteModuleReferences(groups)
+	modules := map[string]interface{}{}
+	for _, name := range names {
+		if err := OutputHclFiles(groups[name], provider, path+"/"+name, serviceName, isCompact, output, isCompressed, providerAlias); err != nil {
+			return err
+		}
+		if err := printReferencesFile(inputs[name], outputs[name], path+"/"+name, output); err != nil {
+			return err
+		}
+		module := map[string]interface{}{
+			"source": "./" + name,
+		}
+		for _, variable := range moduleVariables(groups[name]) {
+			module[variable] = "${var." + variable + "}"
+		}
+		for variable, group := range inputs[name] {
+			module[variable] = "${module." + group + "." + variable + "}"
+		}
+		for _, resource := range groups[name] {
+			for fileName, data := range resource.DataFiles {
+				if strings.HasPrefix(fileName, "variables_") {
+					PrintFile(path+"/"+fileName, data)
+				}
+			}
+		}
+		modules[name] = module
+	}
+	mainData := map[string]interface{}{"module": modules}
+	for _, name := range names {
+		if hasSensitiveVariables(groups[name]) {
+			// root declares variables of modules too
+			mainData["terraform"] = map[string]interface{}{"required_version": sensitiveVariablesRequiredVersion}
+			break
+		}
+	}
+	mainFile, err := terraformutils.Print(mainData, map[string]struct{}{}, output)
+	if err != nil {
+		return err
+	}
+	PrintFile(path+"/main."+GetFileExtension(output), mainFile)
+	return nil
+}
+
+// rewriteModuleReferences replaces references to resources of other groups in items with variables,
+// it returns group of output passed to each variable by group and references exported as outputs by group
+func rewriteModuleReferences(groups map[string][]terraformutils.Resource) (map[string]map[string]string, map[string]map[string]string) {
+	groupOf := map[string]string{}
+	for name, resources := range groups {
+		for _, resource := range resources {
+			groupOf[resource.Type().TFAddress(resource.ResourceName)] = name
+		}
+	}
+	inputs := map[string]map[string]string{}
+	outputs := map[string]map[string]string{}
+	for name, resources := range groups {
+		for i := range resources {
+			resources[i].Item = rewriteReferences(resources[i].Item, func(reference string) string {
+				match := referenceRegexp.FindStringSubmatch(reference)
+				group, exist := groupOf[match[1]]
+				if !exist || group == name {
+					return reference
+				}
+				variable := strings.ReplaceAll(strings.ReplaceAll(match[1], "tfer--", ""), ".", "_") + "_" + match[2]
+				if inputs[name] == nil {
+					inputs[name] = map[string]string{}
+				}
+				inputs[name][variable] = group
+				if outputs[group] == nil {
+					outputs[group] = map[string]string{}
+				}
+				outputs[group][variable] = reference
+				return "${var." + variable + "}"
+			}).(map[string]interface{})
+		}
+	}
+	return inputs, outputs
+}
+
+// rewriteReferences returns value with references in its strings replaced by rewrite
+func rewriteReferences(value interface{}, rewrite func(string) string) interface{} {
+	switch value := value.(type) {
+	case string:
+		return referenceRegexp.ReplaceAllStringFunc(value, rewrite)
+	case []string:
+		for i := range value {
+			value[i] = referenceRegexp.ReplaceAllStringFunc(value[i], rewrite)
+		}
+	case []interface{}:
+		for i := range value {
+			value[i] = rewriteReferences(value[i], rewrite)
+		}
+	case map[string]interface{}:
+		for key := range value {
+			value[key] = rewriteReferences(value[key], rewrite)
+		}
+	}
+	return value
+}
+
+// printReferencesFile writes variables module gets from other modules and outputs it passes to them
+func printReferencesFile(inputs map[string]string, outputs map[string]string, path string, output string) error {
+	if len(inputs) == 0 && len(outputs) == 0 {
+		return nil
+	}
+	data := map[string]interface{}{}
+	if len(inputs) > 0 {
+		variables := map[string]interface{}{}
+		for variable, group := range inputs {
+			variables[variable] = map[string]interface{}{
+				"description": "output " + variable + " of module " + group,
+			}
+		}
+		data["variable"] = variables
+	}
+	if len(outputs) > 0 {
+		values := map[string]interface{}{}
+		for variable, reference := range outputs {
+			values[variable] = map[string]interface{}{
+				"value": reference,
+			}
+		}
+		data["output"] = values
+	}
+	file, err := terraformutils.Print(data, map[string]struct{}{}, output)
+	if err != nil {
+		return err
+	}
+	PrintFile(path+"/"+referencesFile+"."+GetFileExtension(output), file)
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+func TestOutputModulesCrossGroupReferences(t *testing.T) {
+	dir, err := ioutil.TempDir("", "modules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	vpc := terraformutils.NewSimpleResource("vpc-1", "main", "aws_vpc", "aws", []string{})
+	vpc.Item = map[string]interface{}{"cidr_block": "10.0.0.0/16"}
+	subnet := terraformutils.NewSimpleResource("subnet-1", "app", "aws_subnet", "aws", []string{})
+	subnet.Item = map[string]interface{}{
+		"vpc_id":     "${aws_vpc.tfer--main.id}",
+		"cidr_block": "10.0.1.0/24",
+	}
+	route := terraformutils.NewSimpleResource("rtb-1", "app", "aws_route_table", "aws", []string{})
+	route.Item = map[string]interface{}{
+		"vpc_id": "${aws_vpc.tfer--main.id}",
+		"route":  []interface{}{map[string]interface{}{"cidr_block": "${aws_subnet.tfer--app.cidr_block}"}},
+	}
+	groups := map[string][]terraformutils.Resource{
+		"network": {vpc},
+		"app":     {subnet, route},
+	}
+	if err := OutputModules(groups, terraformutils.NewProviderMock(nil), dir, "", false, "hcl", false, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if subnet.Item["vpc_id"] != "${var.aws_vpc_main_id}" {
+		t.Errorf("expected reference to other module to be variable, got %v", subnet.Item["vpc_id"])
+	}
+	cidr := route.Item["route"].([]interface{})[0].(map[string]interface{})["cidr_block"]
+	if cidr != "${aws_subnet.tfer--app.cidr_block}" {
+		t.Errorf("expected reference in same module to be kept, got %v", cidr)
+	}
+	for file, expected := range map[string][]string{
+		"main.tf":               {`aws_vpc_main_id = "${module.network.aws_vpc_main_id}"`},
+		"app/references.tf":     {`variable "aws_vpc_main_id"`},
+		"network/references.tf": {`output "aws_vpc_main_id"`, `value = "${aws_vpc.tfer--main.id}"`},
+		"app/subnet.tf":         {`vpc_id     = "${var.aws_vpc_main_id}"`},
+		"app/route_table.tf":    {`vpc_id = "${var.aws_vpc_main_id}"`},
+	} {
+		data, err := ioutil.ReadFile(dir + "/" + file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range expected {
+			if !strings.Contains(string(data), line) {
+				t.Errorf("expected %s to contain %s, got:\n%s", file, line, data)
+			}
+		}
+	}
+}
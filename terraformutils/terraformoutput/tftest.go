@@ -0,0 +1,138 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// TestsDir is directory of generated files, terraform test discovers test files in it
+const TestsDir = "tests"
+
+const TestFileExtension = ".tftest.hcl"
+
+var variableNameRegexp = regexp.MustCompile(`variable\s+"([^"]+)"`)
+
+// TestGenerator renders Terraform native tests (terraform 1.6+) with plan run asserting
+// that literal attributes of imported resource keep their values
+type TestGenerator struct{}
+
+// Generate returns test file of resource, terraform test plans whole module so all variables of module get
+// empty values in variables block
+func (TestGenerator) Generate(resource terraformutils.Resource, variables []string) []byte {
+	var b bytes.Buffer
+	if len(variables) > 0 {
+		width := 0
+		for _, variable := range variables {
+			if len(variable) > width {
+				width = len(variable)
+			}
+		}
+		// names are padded to width of longest name as terraform fmt aligns them
+		b.WriteString("variables {\n")
+		for _, variable := range variables {
+			fmt.Fprintf(&b, "  %-*s = \"\"\n", width, variable)
+		}
+		b.WriteString("}\n\n")
+	}
+	address := resource.Type().TFAddress(resource.ResourceName)
+	fmt.Fprintf(&b, "run \"%s\" {\n  command = plan\n", resource.InstanceInfo.Type+"_"+resource.ResourceName)
+	attributes := literalAttributes(resource)
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&b, `
+  assert {
+    condition     = tostring(%s.%s) == %s
+    error_message = "%s has unexpected %s"
+  }
+`, address, key, attributes[key], address, key)
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+// OutputFiles writes test file for each resource to tests directory under path
+func (g TestGenerator) OutputFiles(resources []terraformutils.Resource, path string) error {
+	testsPath := path + "/" + TestsDir
+	if err := os.MkdirAll(testsPath, os.ModePerm); err != nil {
+		return err
+	}
+	variables := moduleVariables(resources)
+	for _, resource := range resources {
+		fileName := testsPath + "/" + resource.InstanceInfo.Type + "_" + resource.ResourceName + TestFileExtension
+		if err := ioutil.WriteFile(fileName, g.Generate(resource, variables), os.ModePerm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// literalAttributes returns top level string, number and bool attributes of resource as HCL string literals,
+// item can hold numbers and bools as strings so both sides of assert are compared with tostring,
+// references, templates and heredocs can't be compared with plan values
+func literalAttributes(resource terraformutils.Resource) map[string]string {
+	attributes := map[string]string{}
+	for key, value := range resource.Item {
+		switch value := value.(type) {
+		case string:
+			if !strings.Contains(value, "${") && !strings.Contains(value, "%{") && !strings.HasPrefix(value, "<<") {
+				attributes[key] = terraformutils.HCLString(value)
+			}
+		case bool:
+			attributes[key] = terraformutils.HCLString(strconv.FormatBool(value))
+		case int:
+			attributes[key] = terraformutils.HCLString(strconv.Itoa(value))
+		case int64:
+			attributes[key] = terraformutils.HCLString(strconv.FormatInt(value, 10))
+		case float64:
+			attributes[key] = terraformutils.HCLString(strconv.FormatFloat(value, 'f', -1, 64))
+		}
+	}
+	return attributes
+}
+
+// moduleVariables returns sorted names of variables declared in data files of resources
+func moduleVariables(resources []terraformutils.Resource) []string {
+	names := map[string]bool{}
+	for _, resource := range resources {
+		for fileName, data := range resource.DataFiles {
+			if !strings.HasPrefix(fileName, "variables_") {
+				continue
+			}
+			for _, match := range variableNameRegexp.FindAllSubmatch(data, -1) {
+				names[string(match[1])] = true
+			}
+		}
+	}
+	variables := make([]string, 0, len(names))
+	for name := range names {
+		variables = append(variables, name)
+	}
+	sort.Strings(variables)
+	return variables
+}
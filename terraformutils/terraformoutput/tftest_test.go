@@ -0,0 +1,102 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+func TestTestGeneratorGenerate(t *testing.T) {
+	resource := terraformutils.NewSimpleResource("db-1", "db", "google_sql_user", "google", []string{})
+	resource.Item = map[string]interface{}{
+		"name":            "db",
+		"instance":        "${google_sql_database_instance.tfer--main.name}",
+		"password":        "${var.db_password}",
+		"deletion_policy": "ABANDON",
+		"port":            5432,
+		"enabled":         true,
+		"settings":        []interface{}{map[string]interface{}{"tier": "db-f1-micro"}},
+	}
+	expected := `variables {
+  api_key     = ""
+  db_password = ""
+}
+
+run "google_sql_user_tfer--db" {
+  command = plan
+
+  assert {
+    condition     = tostring(google_sql_user.tfer--db.deletion_policy) == "ABANDON"
+    error_message = "google_sql_user.tfer--db has unexpected deletion_policy"
+  }
+
+  assert {
+    condition     = tostring(google_sql_user.tfer--db.enabled) == "true"
+    error_message = "google_sql_user.tfer--db has unexpected enabled"
+  }
+
+  assert {
+    condition     = tostring(google_sql_user.tfer--db.name) == "db"
+    error_message = "google_sql_user.tfer--db has unexpected name"
+  }
+
+  assert {
+    condition     = tostring(google_sql_user.tfer--db.port) == "5432"
+    error_message = "google_sql_user.tfer--db has unexpected port"
+  }
+}
+`
+	if data := string(TestGenerator{}.Generate(resource, []string{"api_key", "db_password"})); data != expected {
+		t.Errorf("unexpected test file:\n%s", data)
+	}
+}
+
+func TestTestGeneratorOutputFilesError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tftest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	resource := terraformutils.NewSimpleResource("db-1", "db", "google_sql_user", "google", []string{})
+	// directory in place of test file makes write fail
+	if err := os.MkdirAll(dir+"/"+TestsDir+"/google_sql_user_tfer--db"+TestFileExtension, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := (TestGenerator{}).OutputFiles([]terraformutils.Resource{resource}, dir); err == nil {
+		t.Error("expected error writing test file")
+	}
+}
+
+func TestModuleVariables(t *testing.T) {
+	user := terraformutils.NewSimpleResource("db-1", "db", "google_sql_user", "google", []string{})
+	user.DataFiles = map[string][]byte{
+		"variables_db_password.tf": []byte("variable \"db_password\" {\n  type      = string\n  sensitive = true\n}\n"),
+	}
+	instance := terraformutils.NewSimpleResource("main", "main", "google_sql_database_instance", "google", []string{})
+	instance.DataFiles = map[string][]byte{
+		"variables_root_password.tf": []byte("variable \"root_password\" {\n  type      = string\n  sensitive = true\n}\n"),
+		"variables_db_password.tf":   []byte("variable \"db_password\" {\n  type      = string\n  sensitive = true\n}\n"),
+	}
+	network := terraformutils.NewSimpleResource("default", "default", "google_compute_network", "google", []string{})
+	variables := moduleVariables([]terraformutils.Resource{user, instance, network})
+	if !reflect.DeepEqual(variables, []string{"db_password", "root_password"}) {
+		t.Errorf("unexpected module variables %v", variables)
+	}
+}
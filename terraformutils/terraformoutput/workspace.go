@@ -0,0 +1,263 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"bytes"
+	"crypto/md5" //nolint
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+const DefaultTFCHostname = "app.terraform.io"
+
+// WorkspaceWriter writes resources of each Terraform Cloud workspace to directory of its own
+// with remote backend configuration, state is uploaded to workspace when Token is set
+// and written next to files otherwise
+type WorkspaceWriter struct {
+	Provider     terraformutils.ProviderGenerator
+	ServiceName  string
+	Output       string
+	Organization string
+	Hostname     string
+	Token        string
+	HTTPClient   *http.Client
+	// ReplaceState uploads state to workspaces that already have state, resources managed
+	// there which aren't imported are dropped from state
+	ReplaceState bool
+}
+
+func (w WorkspaceWriter) Write(assignments map[string][]terraformutils.Resource, outBase string) error {
+	names := make([]string, 0, len(assignments))
+	for name := range assignments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := outBase + "/" + name
+		if err := OutputHclFiles(assignments[name], w.Provider, path, w.ServiceName, false, w.Output, false, ""); err != nil {
+			return err
+		}
+		backendFile, err := terraformutils.Print(w.backendData(name), map[string]struct{}{}, w.Output)
+		if err != nil {
+			return err
+		}
+		PrintFile(path+"/backend."+GetFileExtension(w.Output), backendFile)
+		tfStateFile, err := terraformutils.PrintTfState(assignments[name])
+		if err != nil {
+			return err
+		}
+		if w.Token == "" {
+			log.Printf("no Terraform Cloud token, save tfstate of workspace %s", name)
+			PrintFile(path+"/terraform.tfstate", tfStateFile)
+			continue
+		}
+		log.Printf("upload tfstate to Terraform Cloud workspace %s/%s", w.Organization, name)
+		if err := w.upload(name, tfStateFile); err != nil {
+			return fmt.Errorf("failed to upload state of workspace %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func (w WorkspaceWriter) hostname() string {
+	if w.Hostname == "" {
+		return DefaultTFCHostname
+	}
+	return w.Hostname
+}
+
+func (w WorkspaceWriter) backendData(workspace string) map[string]interface{} {
+	return map[string]interface{}{
+		"terraform": map[string]interface{}{
+			"backend": []map[string]interface{}{{
+				"remote": map[string]interface{}{
+					"hostname":     w.hostname(),
+					"organization": w.Organization,
+					"workspaces": []map[string]interface{}{{
+						"name": workspace,
+					}},
+				},
+			}},
+		},
+	}
+}
+
+// upload creates workspace if it doesn't exist and creates state version in it, workspace with state is
+// refused unless ReplaceState is set, then state is uploaded with next serial of current state,
+// workspace is locked while state is uploaded as Terraform Cloud API requires
+func (w WorkspaceWriter) upload(workspace string, tfStateFile []byte) error {
+	workspaceID, err := w.workspaceID(workspace)
+	if err != nil {
+		return err
+	}
+	if err := w.request(http.MethodPost, "/workspaces/"+workspaceID+"/actions/lock", map[string]interface{}{
+		"reason": "terraformer import",
+	}, nil); err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.request(http.MethodPost, "/workspaces/"+workspaceID+"/actions/unlock", nil, nil); err != nil {
+			log.Printf("failed to unlock workspace %s: %v", workspace, err)
+		}
+	}()
+	current, err := w.currentState(workspaceID)
+	if err != nil {
+		return err
+	}
+	if current != nil && !w.ReplaceState {
+		return fmt.Errorf("workspace %s already has state, it would be replaced by imported resources", workspace)
+	}
+	if current != nil {
+		// new state version has to continue lineage of current state
+		tfStateFile, err = continueState(tfStateFile, current.Serial+1, current.Lineage)
+		if err != nil {
+			return err
+		}
+	}
+	var state tfStateVersion
+	if err := json.Unmarshal(tfStateFile, &state); err != nil {
+		return err
+	}
+	return w.request(http.MethodPost, "/workspaces/"+workspaceID+"/state-versions", map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "state-versions",
+			"attributes": map[string]interface{}{
+				"serial":  state.Serial,
+				"lineage": state.Lineage,
+				"md5":     fmt.Sprintf("%x", md5.Sum(tfStateFile)), //nolint
+				"state":   base64.StdEncoding.EncodeToString(tfStateFile),
+			},
+		},
+	}, nil)
+}
+
+type tfStateVersion struct {
+	Serial  int64  `json:"serial"`
+	Lineage string `json:"lineage"`
+}
+
+// currentState returns serial and lineage of current state of workspace, nil if workspace has no state yet
+func (w WorkspaceWriter) currentState(workspaceID string) (*tfStateVersion, error) {
+	var response struct {
+		Data struct {
+			Attributes struct {
+				DownloadURL string `json:"hosted-state-download-url"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	err := w.request(http.MethodGet, "/workspaces/"+workspaceID+"/current-state-version", nil, &response)
+	if err == errTFCNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &tfStateVersion{}
+	if err := w.send(http.MethodGet, response.Data.Attributes.DownloadURL, nil, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// continueState sets serial and lineage of state file
+func continueState(tfStateFile []byte, serial int64, lineage string) ([]byte, error) {
+	state := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(tfStateFile))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return nil, err
+	}
+	state["serial"] = serial
+	state["lineage"] = lineage
+	return json.MarshalIndent(state, "", "    ")
+}
+
+func (w WorkspaceWriter) workspaceID(workspace string) (string, error) {
+	var response struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	path := "/organizations/" + url.PathEscape(w.Organization) + "/workspaces"
+	err := w.request(http.MethodGet, path+"/"+url.PathEscape(workspace), nil, &response)
+	if err == errTFCNotFound {
+		err = w.request(http.MethodPost, path, map[string]interface{}{
+			"data": map[string]interface{}{
+				"type": "workspaces",
+				"attributes": map[string]interface{}{
+					"name": workspace,
+				},
+			},
+		}, &response)
+	}
+	return response.Data.ID, err
+}
+
+var errTFCNotFound = fmt.Errorf("not found")
+
+func (w WorkspaceWriter) request(method, path string, body interface{}, response interface{}) error {
+	return w.send(method, "https://"+w.hostname()+"/api/v2"+path, body, response)
+}
+
+func (w WorkspaceWriter) send(method, requestURL string, body interface{}, response interface{}) error {
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader([]byte{})
+	}
+	req, err := http.NewRequest(method, requestURL, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+w.Token)
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+	client := w.HTTPClient
+	if client == nil {
+		client = &http.Client{Transport: terraformutils.NewRetryTransport(http.DefaultTransport)}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return errTFCNotFound
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s %s: %s %s", method, requestURL, resp.Status, data)
+	}
+	if response != nil {
+		return json.Unmarshal(data, response)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+func TestWorkspaceWriterWriteLocal(t *testing.T) {
+	provider := terraformutils.NewProviderMock(nil)
+	if err := provider.FromJSONFixture("../test_data/provider_mock.json"); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "workspaces")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	assignments := terraformutils.NewWorkspaceSelector(terraformutils.WorkspaceByType()).Assign(provider.GetResources())
+	writer := WorkspaceWriter{Provider: provider, Output: "hcl", Organization: "acme"}
+	if err := writer.Write(assignments, dir); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"aws_vpc/vpc.tf", "aws_vpc/backend.tf", "aws_vpc/terraform.tfstate", "aws_subnet/subnet.tf"} {
+		if _, err := os.Stat(dir + "/" + file); err != nil {
+			t.Errorf("expected %s to be written: %v", file, err)
+		}
+	}
+	backend, _ := ioutil.ReadFile(dir + "/aws_vpc/backend.tf")
+	if !strings.Contains(string(backend), `name = "aws_vpc"`) || !strings.Contains(string(backend), `organization = "acme"`) {
+		t.Errorf("unexpected backend:\n%s", backend)
+	}
+}
+
+func TestWorkspaceWriterUpload(t *testing.T) {
+	var calls []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/organizations/acme/workspaces/network":
+			w.WriteHeader(http.StatusNotFound)
+		case "POST /api/v2/organizations/acme/workspaces":
+			_, _ = w.Write([]byte(`{"data":{"id":"ws-1","type":"workspaces"}}`))
+		case "GET /api/v2/workspaces/ws-1/current-state-version":
+			w.WriteHeader(http.StatusNotFound)
+		case "POST /api/v2/workspaces/ws-1/state-versions":
+			body, _ := ioutil.ReadAll(r.Body)
+			var request struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(body, &request); err != nil || request.Data.Attributes["serial"] != 1.0 || request.Data.Attributes["state"] == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	writer := WorkspaceWriter{
+		Organization: "acme",
+		Hostname:     strings.TrimPrefix(server.URL, "https://"),
+		Token:        "token",
+		HTTPClient:   server.Client(),
+	}
+	if err := writer.upload("network", []byte(`{"version":3,"serial":1,"lineage":"abc"}`)); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"GET /api/v2/organizations/acme/workspaces/network",
+		"POST /api/v2/organizations/acme/workspaces",
+		"POST /api/v2/workspaces/ws-1/actions/lock",
+		"GET /api/v2/workspaces/ws-1/current-state-version",
+		"POST /api/v2/workspaces/ws-1/state-versions",
+		"POST /api/v2/workspaces/ws-1/actions/unlock",
+	}
+	if strings.Join(calls, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected API calls:\n%s", strings.Join(calls, "\n"))
+	}
+}
+
+func TestWorkspaceWriterUploadContinuesCurrentState(t *testing.T) {
+	var attributes map[string]interface{}
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/organizations/acme/workspaces/network":
+			_, _ = w.Write([]byte(`{"data":{"id":"ws-1","type":"workspaces"}}`))
+		case "GET /api/v2/workspaces/ws-1/current-state-version":
+			_, _ = w.Write([]byte(`{"data":{"id":"sv-1","attributes":{"serial":5,"hosted-state-download-url":"` + server.URL + `/state/sv-1"}}}`))
+		case "GET /state/sv-1":
+			_, _ = w.Write([]byte(`{"version":3,"serial":5,"lineage":"current"}`))
+		case "POST /api/v2/workspaces/ws-1/state-versions":
+			body, _ := ioutil.ReadAll(r.Body)
+			var request struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+			_ = json.Unmarshal(body, &request)
+			attributes = request.Data.Attributes
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	writer := WorkspaceWriter{
+		Organization: "acme",
+		Hostname:     strings.TrimPrefix(server.URL, "https://"),
+		Token:        "token",
+		HTTPClient:   server.Client(),
+		ReplaceState: true,
+	}
+	if err := writer.upload("network", []byte(`{"version":3,"serial":1,"lineage":"new"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if attributes["serial"] != 6.0 || attributes["lineage"] != "current" {
+		t.Fatalf("expected serial 6 of lineage current, got %v", attributes)
+	}
+	state, err := base64.StdEncoding.DecodeString(attributes["state"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var uploaded tfStateVersion
+	if err := json.Unmarshal(state, &uploaded); err != nil {
+		t.Fatal(err)
+	}
+	if uploaded.Serial != 6 || uploaded.Lineage != "current" {
+		t.Errorf("unexpected uploaded state: %s", state)
+	}
+}
+
+func TestWorkspaceWriterUploadRefusesExistingState(t *testing.T) {
+	var calls []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/organizations/acme/workspaces/network":
+			_, _ = w.Write([]byte(`{"data":{"id":"ws-1","type":"workspaces"}}`))
+		case "GET /api/v2/workspaces/ws-1/current-state-version":
+			_, _ = w.Write([]byte(`{"data":{"id":"sv-1","attributes":{"serial":5,"hosted-state-download-url":"https://` + r.Host + `/state/sv-1"}}}`))
+		case "GET /state/sv-1":
+			_, _ = w.Write([]byte(`{"version":3,"serial":5,"lineage":"current"}`))
+		case "POST /api/v2/workspaces/ws-1/state-versions":
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	writer := WorkspaceWriter{
+		Organization: "acme",
+		Hostname:     strings.TrimPrefix(server.URL, "https://"),
+		Token:        "token",
+		HTTPClient:   server.Client(),
+	}
+	err := writer.upload("network", []byte(`{"version":3,"serial":1,"lineage":"new"}`))
+	if err == nil || !strings.Contains(err.Error(), "already has state") {
+		t.Fatalf("expected upload to workspace with state to be refused, got %v", err)
+	}
+	for _, call := range calls {
+		if call == "POST /api/v2/workspaces/ws-1/state-versions" {
+			t.Errorf("state was uploaded to workspace with state")
+		}
+	}
+	if calls[len(calls)-1] != "POST /api/v2/workspaces/ws-1/actions/unlock" {
+		t.Errorf("expected workspace to be unlocked, calls:\n%s", strings.Join(calls, "\n"))
+	}
+}
@@ -16,7 +16,9 @@ package terraformutils
 
 import (
 	"bytes"
+	"context"
 	"log"
+	"sort"
 	"sync"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils/providerwrapper"
@@ -34,28 +36,45 @@ func NewTfState(resources []Resource) *terraform.State {
 		TFVersion: terraform.VersionString(), //nolint
 		Serial:    1,
 	}
+	tfstate.Modules = []*terraform.ModuleState{newModuleState([]string{"root"}, resources)}
+	return tfstate
+}
+
+// NewModulesTfState returns state of root module calling module for each group of resources
+func NewModulesTfState(groups map[string][]Resource) *terraform.State {
+	tfstate := NewTfState([]Resource{})
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		tfstate.Modules = append(tfstate.Modules, newModuleState([]string{"root", name}, groups[name]))
+	}
+	return tfstate
+}
+
+func newModuleState(path []string, resources []Resource) *terraform.ModuleState {
 	outputs := map[string]*terraform.OutputState{}
 	for _, r := range resources {
 		for k, v := range r.Outputs {
 			outputs[k] = v
 		}
 	}
-	tfstate.Modules = []*terraform.ModuleState{
-		{
-			Path:      []string{"root"},
-			Resources: map[string]*terraform.ResourceState{},
-			Outputs:   outputs,
-		},
+	moduleState := &terraform.ModuleState{
+		Path:      path,
+		Resources: map[string]*terraform.ResourceState{},
+		Outputs:   outputs,
 	}
 	for _, resource := range resources {
 		resourceState := &terraform.ResourceState{
 			Type:     resource.InstanceInfo.Type,
 			Primary:  resource.InstanceState,
-			Provider: "provider." + resource.Provider,
+			Provider: "provider." + resource.ProviderReference(),
 		}
-		tfstate.Modules[0].Resources[resource.InstanceInfo.Type+"."+resource.ResourceName] = resourceState
+		moduleState.Resources[resource.InstanceInfo.Type+"."+resource.ResourceName] = resourceState
 	}
-	return tfstate
+	return moduleState
 }
 
 func PrintTfState(resources []Resource) ([]byte, error) {
@@ -65,6 +84,13 @@ func PrintTfState(resources []Resource) ([]byte, error) {
 	return buf.Bytes(), err
 }
 
+func PrintModulesTfState(groups map[string][]Resource) ([]byte, error) {
+	state := NewModulesTfState(groups)
+	var buf bytes.Buffer
+	err := terraform.WriteState(state, &buf)
+	return buf.Bytes(), err
+}
+
 func RefreshResources(resources []Resource, provider *providerwrapper.ProviderWrapper) ([]Resource, error) {
 	refreshedResources := []Resource{}
 	input := make(chan *Resource, 100)
@@ -92,6 +118,56 @@ func RefreshResources(resources []Resource, provider *providerwrapper.ProviderWr
 	return refreshedResources, nil
 }
 
+// RefreshResourcesStream refreshes and converts resources like RefreshResources followed by ConvertTFstate,
+// each resource is sent to returned channel as soon as it's converted. Channel is closed after last resource
+// or when ctx is done
+func RefreshResourcesStream(ctx context.Context, resources []Resource, provider *providerwrapper.ProviderWrapper) <-chan Resource {
+	input := make(chan *Resource)
+	output := make(chan Resource)
+	var wg sync.WaitGroup
+	poolSize := 15
+	if slowProcessingRequired(resources) {
+		poolSize = 1
+	}
+	for i := 0; i < poolSize; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range input {
+				log.Println("Refreshing state...", r.InstanceInfo.Id)
+				r.Refresh(provider)
+				if r.InstanceState == nil || r.InstanceState.ID == "" {
+					log.Printf("ERROR: Unable to refresh resource %s", r.ResourceName)
+					continue
+				}
+				if err := r.ConvertTFstate(provider); err != nil {
+					log.Printf("ERROR: Unable to convert resource %s: %v", r.ResourceName, err)
+					continue
+				}
+				select {
+				case output <- *r:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+	go func() {
+		defer close(input)
+		for i := range resources {
+			select {
+			case input <- &resources[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(output)
+	}()
+	return output
+}
+
 func slowProcessingRequired(resources []Resource) bool {
 	for _, r := range resources {
 		if r.SlowQueryRequired {
@@ -149,22 +225,11 @@ func ParseFilterValues(value string) []string {
 }
 
 func FilterCleanup(s *Service, isInitial bool) {
-	if len(s.Filter) == 0 {
+	chain := s.filterChain(isInitial)
+	if len(chain) == 0 {
 		return
 	}
-	var newListOfResources []Resource
-	for _, resource := range s.Resources {
-		allPredicatesTrue := true
-		for _, filter := range s.Filter {
-			if filter.isInitial() == isInitial {
-				allPredicatesTrue = allPredicatesTrue && filter.Filter(resource)
-			}
-		}
-		if allPredicatesTrue && !ContainsResource(newListOfResources, resource) {
-			newListOfResources = append(newListOfResources, resource)
-		}
-	}
-	s.Resources = newListOfResources
+	s.Resources = chain.Filter(s.Resources)
 }
 
 func ContainsResource(s []Resource, e Resource) bool {
@@ -0,0 +1,56 @@
+// Copyright 2018 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package terraformutils
+
+import (
+	"strings"
+)
+
+// Variable is string variable generated resource refers to instead of value Terraformer can't
+// or mustn't write to generated files, like passwords and keys
+type Variable struct {
+	Name        string
+	Description string
+	Sensitive   bool
+}
+
+// VariableName returns name of variable declared for name, tfer-- prefix of resource names is left out
+// so names of variables don't depend on how resource name was sanitized
+func VariableName(name string) string {
+	return strings.TrimPrefix(name, "tfer--")
+}
+
+// AddVariable declares variable in variables_<name>.tf data file of resource and returns reference to it,
+// name is passed through VariableName
+func (r *Resource) AddVariable(variable Variable) string {
+	name := VariableName(variable.Name)
+	var declaration strings.Builder
+	declaration.WriteString("variable " + HCLString(name) + " {\n")
+	declaration.WriteString("  description = " + HCLString(variable.Description) + "\n")
+	declaration.WriteString("  type        = string\n")
+	if variable.Sensitive {
+		declaration.WriteString("  sensitive   = true\n")
+	}
+	declaration.WriteString("}\n")
+	if r.DataFiles == nil {
+		r.DataFiles = map[string][]byte{}
+	}
+	r.DataFiles["variables_"+name+".tf"] = []byte(declaration.String())
+	return "${var." + name + "}"
+}
+
+// AddSensitiveVariable declares sensitive variable in data files of resource and returns reference to it
+func (r *Resource) AddSensitiveVariable(name, description string) string {
+	return r.AddVariable(Variable{Name: name, Description: description, Sensitive: true})
+}
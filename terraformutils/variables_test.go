@@ -0,0 +1,51 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package terraformutils
+
+import (
+	"testing"
+)
+
+func TestAddSensitiveVariable(t *testing.T) {
+	r := NewSimpleResource("id", "name", "type", "provider", []string{})
+	reference := r.AddSensitiveVariable("name_password", `Password of "name"`)
+	if reference != "${var.name_password}" {
+		t.Errorf("unexpected reference %s", reference)
+	}
+	expected := `variable "name_password" {
+  description = "Password of \"name\""
+  type        = string
+  sensitive   = true
+}
+`
+	if actual := string(r.DataFiles["variables_name_password.tf"]); actual != expected {
+		t.Errorf("unexpected declaration:\n%s", actual)
+	}
+}
+
+func TestAddVariableLeavesOutResourceNamePrefix(t *testing.T) {
+	r := NewSimpleResource("id", "tfer--key", "type", "provider", []string{})
+	reference := r.AddVariable(Variable{Name: "tfer--key_public_key", Description: "Public key"})
+	if reference != "${var.key_public_key}" {
+		t.Errorf("unexpected reference %s", reference)
+	}
+	expected := `variable "key_public_key" {
+  description = "Public key"
+  type        = string
+}
+`
+	if actual := string(r.DataFiles["variables_key_public_key.tf"]); actual != expected {
+		t.Errorf("unexpected declaration:\n%s", actual)
+	}
+}
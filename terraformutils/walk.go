@@ -99,6 +99,9 @@ func walkAndOverride(pathSegments []string, oldValue, newValue string, data inte
 						}
 					case oldValue == v.Interface().(string):
 						val.Interface().(map[string]interface{})[pathSegments[0]] = newValue
+					case strings.HasPrefix(v.Interface().(string), "<<"):
+						// heredoc documents like policies contain values as quoted JSON strings
+						val.Interface().(map[string]interface{})[pathSegments[0]] = strings.ReplaceAll(v.Interface().(string), `"`+oldValue+`"`, `"`+newValue+`"`)
 					}
 				}
 			}
@@ -113,6 +113,17 @@ func TestSimpleWalkAndNotOverride(t *testing.T) {
 	}
 }
 
+func TestHeredocWalkAndOverride(t *testing.T) {
+	structure := map[string]interface{}{
+		"attr1": "<<POLICY\n{\"Principal\": \"value\", \"Resource\": \"value/*\"}\nPOLICY",
+	}
+	WalkAndOverride("attr1", "value", "newValue", structure)
+
+	if structure["attr1"] != "<<POLICY\n{\"Principal\": \"newValue\", \"Resource\": \"value/*\"}\nPOLICY" {
+		t.Errorf("failed to set value %v", structure["attr1"])
+	}
+}
+
 func TestNonExistentWalkAndOverride(t *testing.T) {
 	structure := map[string]interface{}{
 		"attr1": "value",
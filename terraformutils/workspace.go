@@ -0,0 +1,47 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+// WorkspaceSelector assigns resources to Terraform Cloud workspaces, each workspace manages its resources
+// with state of its own
+type WorkspaceSelector struct {
+	// Strategy returns workspace name of resource, resources with empty name are assigned to UngroupedName workspace
+	Strategy func(Resource) string
+}
+
+func NewWorkspaceSelector(strategy func(Resource) string) WorkspaceSelector {
+	return WorkspaceSelector{Strategy: strategy}
+}
+
+// Assign returns resources by workspace name, names are sanitized same way as module names
+// to be valid workspace names
+func (s WorkspaceSelector) Assign(resources []Resource) map[string][]Resource {
+	return NewResourceGrouper(s.Strategy).Group(resources)
+}
+
+// WorkspaceByType assigns resources of each type to workspace of its own
+func WorkspaceByType() func(Resource) string {
+	return func(r Resource) string {
+		if r.InstanceInfo == nil {
+			return ""
+		}
+		return r.InstanceInfo.Type
+	}
+}
+
+// WorkspaceByTag assigns resources by value of tag, e.g. Environment or Team
+func WorkspaceByTag(tag string) func(Resource) string {
+	return GroupByAttribute("tags." + tag)
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWorkspaceSelectorByType(t *testing.T) {
+	resources := []Resource{
+		NewSimpleResource("vpc-1", "main", "aws_vpc", "aws", []string{}),
+		NewSimpleResource("subnet-1", "a", "aws_subnet", "aws", []string{}),
+		NewSimpleResource("subnet-2", "b", "aws_subnet", "aws", []string{}),
+	}
+	assignments := NewWorkspaceSelector(WorkspaceByType()).Assign(resources)
+
+	if len(assignments) != 2 || len(assignments["aws_vpc"]) != 1 || len(assignments["aws_subnet"]) != 2 {
+		t.Errorf("unexpected assignments %v", assignments)
+	}
+}
+
+func TestWorkspaceSelectorByTag(t *testing.T) {
+	resources := []Resource{
+		NewResource("i-1", "web", "aws_instance", "aws", map[string]string{"tags.Environment": "prod"}, []string{}, map[string]interface{}{}),
+		NewResource("i-2", "worker", "aws_instance", "aws", map[string]string{"tags.Environment": "stage env"}, []string{}, map[string]interface{}{}),
+		NewResource("i-3", "bastion", "aws_instance", "aws", map[string]string{}, []string{}, map[string]interface{}{}),
+	}
+	assignments := NewWorkspaceSelector(WorkspaceByTag("Environment")).Assign(resources)
+
+	names := map[string]string{}
+	for workspace, workspaceResources := range assignments {
+		for _, r := range workspaceResources {
+			names[r.ResourceName] = workspace
+		}
+	}
+	expected := map[string]string{"tfer--web": "prod", "tfer--worker": "stage_env", "tfer--bastion": UngroupedName}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
+
+func TestWorkspaceSelectorCustomStrategy(t *testing.T) {
+	resources := []Resource{
+		NewSimpleResource("sg-1", "default", "aws_security_group", "aws", []string{}),
+	}
+	assignments := NewWorkspaceSelector(func(r Resource) string { return "network" }).Assign(resources)
+
+	if len(assignments["network"]) != 1 {
+		t.Errorf("unexpected assignments %v", assignments)
+	}
+}